@@ -31,6 +31,10 @@ type Config struct {
 	// the workers will stop adding new elements to the queue, so a slow client will
 	// potentially fall behind the kinesis stream.
 	bufferSize int
+
+	// If set, records are only checkpointed when the function returned alongside them by
+	// NextWithCheckpointer is called, rather than as soon as they are handed out.
+	manualCheckpoints bool
 }
 
 // NewConfig returns a default Config struct
@@ -75,6 +79,12 @@ func (c Config) WithBufferSize(bufferSize int) Config {
 	return c
 }
 
+// WithManualCheckpoints returns a Config with modified manual checkpointing
+func (c Config) WithManualCheckpoints(manualCheckpoints bool) Config {
+	c.manualCheckpoints = manualCheckpoints
+	return c
+}
+
 // WithStats returns a Config with a modified stats
 func (c Config) WithStats(stats StatReceiver) Config {
 	c.stats = stats
@@ -337,7 +337,9 @@ func (k *Kinsumer) Run() error {
 				return
 			case record = <-input:
 			case output <- record:
-				record.checkpointer.update(aws.StringValue(record.record.SequenceNumber))
+				if !k.config.manualCheckpoints {
+					record.checkpointer.update(aws.StringValue(record.record.SequenceNumber))
+				}
 				record = nil
 			case se := <-k.shardErrors:
 				k.errors <- fmt.Errorf("shard error (%s) in %s: %s", se.shardID, se.action, se.err)
@@ -376,15 +378,30 @@ func (k *Kinsumer) Stop() {
 // if err is non nil an error occurred in the system.
 // if err is nil and data is nil then kinsumer has been stopped
 func (k *Kinsumer) Next() (data []byte, err error) {
+	data, _, _, err = k.NextWithCheckpointer()
+	return data, err
+}
+
+// NextWithCheckpointer is like Next, but also returns the ID of the record's shard and a
+// function which checkpoints the record. If the Config was created WithManualCheckpoints,
+// records are only checkpointed by calling that function; callers must call it in the order
+// the records of each shard were returned.
+func (k *Kinsumer) NextWithCheckpointer() (data []byte, shardID string, checkpointer func(), err error) {
 	select {
 	case err = <-k.errors:
-		return nil, err
+		return nil, "", nil, err
 	case record, ok := <-k.output:
 		if ok {
 			k.config.stats.EventToClient(*record.record.ApproximateArrivalTimestamp, record.retrievedAt)
 			data = record.record.Data
+			cp := record.checkpointer
+			shardID = cp.shardID
+			sequenceNumber := aws.StringValue(record.record.SequenceNumber)
+			checkpointer = func() {
+				cp.update(sequenceNumber)
+			}
 		}
 	}
 
-	return data, err
+	return data, shardID, checkpointer, err
 }
@@ -33,6 +33,9 @@ type KinesisWriterEventConfig struct {
 	FilterParameters  []*KinesisEventFilterConfig
 	SkipDefaultFilter bool
 	AllFields         bool
+	// FieldTransforms maps fields (before renaming) to the transforms, such as hmac or
+	// ipAnonymize, which must be applied to them before they're written.
+	FieldTransforms map[string]string
 }
 
 // FilterOperator represents the types of filter operations supported by KinesisEventFilterConfig.
@@ -291,6 +294,10 @@ type GlobberConfig struct {
 	// buffer can cause stalls, and increasing the size can increase
 	// shutdown time
 	BufferLength int
+
+	// Codec is the name of the codec to compress globs with;
+	// flate if empty
+	Codec string
 }
 
 // Validate returns an error if the config is invalid, nil otherwise.
@@ -51,6 +51,8 @@ const (
 	EDGE_TYPE  EventMetadataType = "edge_type"
 	DATASTORES EventMetadataType = "datastores"
 	BIRTH      EventMetadataType = "birth"
+	EXPLODE    EventMetadataType = "explode"
+	STRICT     EventMetadataType = "strict"
 )
 
 type EventMetadataRow struct {
@@ -1,20 +1,104 @@
 package transformer
 
+import (
+	"strings"
+	"time"
+)
+
 var (
 	// ValidTransforms lists the types that a column in an event is allowed to have.
 	ValidTransforms = []string{
 		"bigint",
 		"bool",
+		"date",
+		"decimal",
 		"float",
+		"hmac",
 		"int",
+		"ipAnonymize",
 		"ipAsn",
 		"ipAsnInteger",
 		"ipCity",
 		"ipCountry",
 		"ipRegion",
+		"json",
+		"mask",
+		"smallint",
+		"super",
+		"uaBrowser",
+		"uaBrowserVersion",
+		"uaDeviceType",
+		"uaIsBot",
+		"uaOS",
+		"uuid",
 		"varchar",
 		"f@timestamp@unix",
 		"f@timestamp@unix-utc",
+		"f@timestamp@unix-ms",
+		"f@timestamp@iso8601",
+		"f@url@host",
+		"f@url@domain",
+		"f@url@path",
+		"f@url@fragment",
 		"userIDWithMapping",
+		"d@add",
+		"d@coalesce",
+		"d@concat",
+		"d@div",
+		"d@lower",
+		"d@mul",
+		"d@sub",
+		"d@upper",
+	}
+
+	// ValidTransformPrefixes lists the types which take parameters, as the prefix the
+	// parameters follow. A column is allowed any type starting with one of them.
+	ValidTransformPrefixes = []string{
+		timestampPrefix,
+		"f@mask@",
+		"f@urlParam@",
+		"d@concat@",
+		"d@date_trunc@",
+		"d@default@",
+		"d@substr@",
 	}
 )
+
+// timestampPrefix starts timestamp types, whose parameter is their format, optionally
+// followed by @ and an IANA timezone, e.g. f@timestamp@unix@America/New_York.
+const timestampPrefix = "f@timestamp@"
+
+// IsValidTransform returns whether a column in an event is allowed to have the type: one of
+// ValidTransforms, or one of ValidTransformPrefixes followed by parameters.
+func IsValidTransform(tType string) bool {
+	for _, t := range ValidTransforms {
+		if tType == t {
+			return true
+		}
+	}
+	for _, prefix := range ValidTransformPrefixes {
+		if !strings.HasPrefix(tType, prefix) || len(tType) == len(prefix) {
+			continue
+		}
+		if prefix == timestampPrefix {
+			return validTimestampParams(tType[len(prefix):])
+		}
+		return true
+	}
+	return false
+}
+
+// validTimestampParams returns whether a timestamp's format is followed by a timezone this
+// host knows, if it's followed by one at all. unix-utc predates timezones and can't have one.
+func validTimestampParams(params string) bool {
+	i := strings.LastIndexByte(params, '@')
+	if i < 0 {
+		return true
+	}
+	format, zone := params[:i], params[i+1:]
+	if format == "" || format == "unix-utc" || zone == "" || zone == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(zone)
+	return err == nil
+}
@@ -0,0 +1,8973 @@
+// generated by go run gen.go; DO NOT EDIT
+
+package publicsuffix
+
+const version = "publicsuffix.org's public_suffix_list.dat, git revision fb4a6bce72a86feaf6c38f0a43cd05baf97a9258 (2016-07-07T00:50:50Z)"
+
+const (
+	nodesBitsChildren   = 9
+	nodesBitsICANN      = 1
+	nodesBitsTextOffset = 15
+	nodesBitsTextLength = 6
+
+	childrenBitsWildcard = 1
+	childrenBitsNodeType = 2
+	childrenBitsHi       = 14
+	childrenBitsLo       = 14
+)
+
+const (
+	nodeTypeNormal     = 0
+	nodeTypeException  = 1
+	nodeTypeParentOnly = 2
+)
+
+// numTLD is the number of top level domains.
+const numTLD = 1552
+
+// Text is the combined text of all labels.
+const text = "biellaakesvuemieleccebieszczadygeyachimataipeigersundrangedalivo" +
+	"rnoddabievatmallorcafederationikonantanangerbifukagawalmartatesh" +
+	"inanomachintaijinfolldalomzansimagicasadelamonedatsunanjoetsuwan" +
+	"ouchikujogaszkoladbrokesamsclubindalorenskogliwicebihorologyusui" +
+	"sserveexchangebikedagestangeorgeorgiabilbaogakievenesamsunglobal" +
+	"ashovhachinohedmarkhangelskatowicebillustrationinohekinannestadr" +
+	"ivelandrobaknoluoktainaikawachinaganoharamcoalaheadjudaicable-mo" +
+	"dembetsukuintuitateyamabiomutashinainuyamanouchikuhokuryugasakit" +
+	"ashiobarabirdartcenterprisesakikonaircraftraeumtgeradealstahauge" +
+	"sundunloppacificaseihichisobetsuitairabirkenesoddtangenovaravenn" +
+	"agatorockartuzyuudmurtiabirthplacebjarkoyuzawabjerkreimdbalatino" +
+	"rdkappgafanpachigasakidsmynasperschlesisches3-sa-east-1bjugniezn" +
+	"ordre-landunsandvikcoromantovalle-d-aostatoilotenkawablockbuster" +
+	"nidupontariobloombergbauernrtatsunobloxcmsanfranciscofreakunemur" +
+	"orangeiseiyoichiropracticasertaishinomakikuchikuseikarugaulardal" +
+	"ottebluedaplierneuesangobmoattachmentsanjotattoolsztynsettlersan" +
+	"naninomiyakonojoshkar-olayangroupaleobmsannohelplfinancialottoko" +
+	"namegatakatorinvestmentsanokatsushikabeeldengeluidurbanamexhibit" +
+	"ionirasakis-a-candidatebmweirbnpparibaselburglobodoes-itverranza" +
+	"nquannefrankfurtaxihuanishiazais-a-catererbomloansantabarbarabon" +
+	"durhamburglogowfarmsteadvrcambridgestonewspaperbonnishigotsukiso" +
+	"fukushimaritimodenakanojohanamakinoharabookingloppenzaogashimada" +
+	"chicagoboatsantacruzsantafedextraspace-to-rentalstomakomaibarabo" +
+	"otsanukis-a-celticsfanishiharaboschaefflerdalouvreitgoryuzhno-sa" +
+	"khalinskatsuyamaseratis-a-chefarsundvrdnsfor-better-thandabostik" +
+	"aufenishiizunazukis-a-conservativefsncfdwgmbhartiffanybostonakij" +
+	"insekikogentingminakamichiharabotanicalgardenishikatakazakis-a-c" +
+	"padoval-daostavalleybotanicgardenishikatsuragithubusercontentjel" +
+	"dsundyndns-ipalermomasvuotnakatombetsupplybotanybouncemerckautok" +
+	"einobounty-fullensakerrypropertiesaotomeloyalistockholmestrandyn" +
+	"dns-mailowiczest-le-patrondheimperiaboutiquebecngmodellingmxfini" +
+	"tybozentsujiiebradescorporationishikawazukanazawabrandywinevalle" +
+	"ybrasiliabresciabrindisibenikebristolgapartmentsapodhalewismille" +
+	"rbritishcolumbialowiezaganishimerabroadcastleclercasinore-og-uvd" +
+	"alucaniabroadwaybroke-itjmaxxxjaworznobrokerbronnoysundyndns-off" +
+	"ice-on-the-webcampobassociatesapporobrothermesaverdeatnuorogersv" +
+	"palmspringsakerbrumunddaluccapitalonewhollandyndns-picsaratovall" +
+	"eaostavernishinomiyashironobrunelblagdenesnaaseralingenkainanaej" +
+	"rietisalatinabenoboribetsucksardegnamsosnowiecateringebudejjuedi" +
+	"schesapeakebayernurembergrimstadyndns-remotegildeskalmykiabrusse" +
+	"lsardiniabruxellesarlucernebryanskjervoyagebryneustarhubalestran" +
+	"dabergamoarekemrbuskerudinewhampshirechtrainingripebuzenishinoom" +
+	"otegotvalled-aostavropolitiendabuzzgorzeleccolognewmexicoldwarmi" +
+	"amiastaplesarpsborgriwataraidyndns-servercellikes-piedmontblanco" +
+	"meeresarufutsunomiyawakasaikaitakoenigrondarbwhalingrongabzhitom" +
+	"irkutskleppamperedchefashionishinoshimatta-varjjatjometlifeinsur" +
+	"ancecomputerhistoryofscience-fictioncomsecuritytacticsavonamssko" +
+	"ganeis-a-designerimarumorimachidacondoshichinohealthcareersaxoco" +
+	"nferenceconstructionconsuladoharuhrconsultanthropologyconsulting" +
+	"volluzerncontactoyosatoyokawacontemporaryarteducationalchikugojo" +
+	"medio-campidano-mediocampidanomediocontractorskenconventureshino" +
+	"desashibetsuikimobetsuliguriacookingchannelveruminamibosogndalvi" +
+	"vano-frankivskfhappoumuenchencoolkuszgradcooperaunitemasekhabaro" +
+	"vskhakassiacopenhagencyclopedichernihivanovosibirskydivingrosset" +
+	"ouchijiwadeloittevadsoccertificationissandnessjoenissayokoshibah" +
+	"ikariwanumataketomisatomobellevuelosangelesjaguarchitecturealtyc" +
+	"hyattorneyagawalbrzycharternopilawalesundyndns-wikinderoycorsica" +
+	"hcesuolocalhistorybnikahokutoeiheijis-a-doctoraycorvettenrightat" +
+	"homegoodsbschokoladencosenzakopanerairguardcostumedizinhistorisc" +
+	"hescholarshipschoolcouchpotatofrieschulezajskharkivgucciprianiig" +
+	"ataiwanairforcertmgretachikawakuyabukicks-assedichernivtsiciliac" +
+	"ouncilcouponschwarzgwangjuifminamidaitomangotembaixadacourseschw" +
+	"eizippodlasiellakasamatsudovre-eikercq-acranbrookuwanalyticscien" +
+	"cecentersciencehistorycreditcardcreditunioncremonashorokanaiecre" +
+	"wildlifedjejuegoshikiminokamoenairlinedre-eikercricketrzyncrimea" +
+	"crotonewportlligatewaycrownprovidercrscientistor-elvdalcruisescj" +
+	"ohnsoncryptonomichigangwoncuisinellahppiacenzamamibuilderscotlan" +
+	"dculturalcentertainmentoyotaris-a-financialadvisor-aurdalcuneocu" +
+	"pcakecxn--1ctwolominamatambovalledaostamayukis-a-geekgalaxycymru" +
+	"ovatoyotomiyazakis-a-greencyonabarussiacyouthdfcbankzjcbnlfieldf" +
+	"iguerestaurantoyotsukaidownloadfilateliafilminamiechizenfinalfin" +
+	"ancefineartscrappinguovdageaidnulsandoyfinlandfinnoyfirebaseappa" +
+	"raglidingushikamifuranoshiroomurafirenzefirestonextdirectoyouraf" +
+	"irmdaleirfjordfishingolffanserveftparisor-fronfitjarqhachiojiyah" +
+	"ikobeatservegame-serverisignfitnessettlementoystre-slidrettozawa" +
+	"fjalerflesbergxn--1lqs71dflickragerotikamakurazakinkobayashiksha" +
+	"cknetnedalflightservehalflifestyleflirumannortonsbergzlgfloginto" +
+	"gurafloraflorencefloridafloristanohatakaharulvikhmelnitskiyamasf" +
+	"jordenfloromskoguchikuzenflowerservehttparliamentozsdeflsmidthru" +
+	"heredstonexus-east-1flynnhubalsfjordishakotankarumaifarmerseinew" +
+	"yorkshirecreationaturbruksgymnaturhistorisches3-us-gov-west-1fnd" +
+	"foodnetworkshoppingfor-ourfor-someetranbyfor-theaterforexrothach" +
+	"irogatakamoriokamikitayamatotakadaforgotdnservehumourforli-cesen" +
+	"a-forlicesenaforlikescandyndns-at-workinggrouparmaforsaleirvikhm" +
+	"elnytskyivalleeaosteigenforsandasuoloftrani-andria-barletta-tran" +
+	"i-andriafortmissoulan-udefenseljordfortworthadanotaireserveirche" +
+	"rnovtsykkylvenetogakushimotoganewjerseyforuminamifuranofosneserv" +
+	"eminecraftraniandriabarlettatraniandriafotaruis-a-gurunzenfoxfor" +
+	"degreefreeboxostrowiechiryukyuragifudaigodoesntexistanbullensvan" +
+	"guardyndns-workisboringroundhandlingroznyfreemasonryfreiburgfrei" +
+	"ghtcmwilliamhillfreseniuscountryestateofdelawaredumbrellajollame" +
+	"ricanexpressexyzparocherkasyzrankoshigayaltaikis-a-hard-workerfr" +
+	"ibourgfriuli-v-giuliafriuli-ve-giuliafriuli-vegiuliafriuli-venez" +
+	"ia-giuliafriuli-veneziagiuliafriuli-vgiuliafriuliv-giuliafriuliv" +
+	"e-giuliafriulivegiuliafriulivenezia-giuliafriuliveneziagiuliafri" +
+	"ulivgiuliafrlfroganservemp3utilitiesquarezzoologicalvinklein-add" +
+	"rammenuernbergdyniabcn-north-1kappleaseating-organicbcg12000emma" +
+	"fanconagawakayamadridvagsoyericsson-aptibleangaviikadenaamesjevu" +
+	"emielno-ip6frognfrolandfrom-akrehamnfrom-alfrom-arfrom-azwinbalt" +
+	"imore-og-romsdalimitedunetbankasaokamisatokamachippubetsubetsuga" +
+	"ruconnectarumizusawaukraanghkebinagisochildrensgardenasushiobara" +
+	"bruzzoologyeongbuk-uralsk12from-capetownnews-stagingfrom-collect" +
+	"ionfrom-ctranoyfrom-dchitachinakagawassamukawataricohdavvenjarga" +
+	"usdalukowhoswhokksundynnsasayamafrom-dell-ogliastrakhanawatchese" +
+	"rvep2parservepicservequakefrom-flanderservesarcasmatartanddesign" +
+	"from-gafrom-higashiagatsumagoirminamiiselectransportrapaniimimat" +
+	"akatsukis-a-hunterfrom-iafrom-idfrom-ilfrom-incheonfrom-kservice" +
+	"settsurfastlyfrom-kyotobetsumidatlantichitosetogitsuldaluroyfrom" +
+	"-lanbibaidarfrom-mansionsevastopolefrom-mdfrom-megurorostrowwlkp" +
+	"mgfrom-microsoftbankhvanylvenicefrom-mnfrom-mochizukirafrom-msev" +
+	"enassisicilyfrom-mtnfrom-nchloefrom-ndfrom-nefrom-nhktravelchann" +
+	"elfrom-njcpartis-a-knightravelersinsurancefrom-nminamiizukamiton" +
+	"dabayashiogamagoriziafrom-nvaolbia-tempio-olbiatempioolbialystok" +
+	"kemerovodkagoshimaizurubtsovskjakdnepropetrovskiervaapsteiermark" +
+	"labudhabikinokawabarthadselfipartnersewindmillfrom-nyfrom-ohkura" +
+	"from-oketohmanxn--1qqw23afrom-orfrom-paderbornfrom-pratohnoshooo" +
+	"shikamaishimofusartsfranziskanerdpolicefrom-rivnefrom-schoenbrun" +
+	"nfrom-sdnipropetrovskypescaravantaafrom-tnfrom-txn--2m4a15efrom-" +
+	"utazuerichardlillehammerfest-mon-blogueurovisionfrom-vaksdalfrom" +
+	"-vtrdfrom-wafrom-wielunnerfrom-wvareserveblogspotrentino-a-adige" +
+	"from-wyfrosinonefrostalowa-wolawafroyahabaghdadultrentino-aadige" +
+	"fstcgroupartshangrilangevagrarboretumbriamallamagentositelefonic" +
+	"aaarborteaches-yogasawaracingroks-theatreefujiiderafujikawaguchi" +
+	"konefujiminohtawaramotoineppugliafujinomiyadafujiokayamaoris-a-l" +
+	"andscaperugiafujisatoshonairportland-4-salernogatagajobojis-a-la" +
+	"wyerfujisawafujishiroishidakabiratoridellogliastraderfujitsuruga" +
+	"shimamateramodalenfujixeroxn--30rr7yfujiyoshidafukayabeardubaidu" +
+	"ckdnsdojoburgfukuchiyamadafukudominichocolatelevisionissedalutsk" +
+	"azimierz-dolnyfukuis-a-liberalfukumitsubishigakirkenesharis-a-li" +
+	"bertarianfukuokazakirovogradoyfukuroishikarikaturindalfukusakiry" +
+	"uohaebaruminamimakis-a-linux-useranishiaritabashikaoizumizakitau" +
+	"rayasudafukuyamagatakahashimamakisarazurewebsiteshikagamiishibuk" +
+	"awafunabashiriuchinadafunagatakahatakaishimoichinosekigaharafuna" +
+	"hashikamiamakusatsumasendaisennangonohejis-a-llamarylandfundacio" +
+	"fuoiskujukuriyamarburgfuosskoczowindowsharpartyfurnitureggio-cal" +
+	"abriafurubiraquarellebesbyglandfurudonostiafurukawairtelecityeat" +
+	"shawaiijimarugame-hostingfusodegaurafussaikishiwadafutabayamaguc" +
+	"hinomigawafutboldlygoingnowhere-for-moregontrailroadfuttsurugimi" +
+	"namiminowafvgfyis-a-musicianfylkesbiblackfridayfyresdalhannovarg" +
+	"gatrentino-alto-adigehanyuzenhapmirhareidsbergenharstadharvestce" +
+	"lebrationhasamarahasaminami-alpssells-itrentino-altoadigehashban" +
+	"ghasudahasura-appassagenshimokitayamahasvikmshimonitayanagivestb" +
+	"ytomaritimekeepinghatogayahoohatoyamazakitahatakanabeautydalhats" +
+	"ukaichikaiseis-a-painteractivegarsheis-a-patsfanhattfjelldalhaya" +
+	"shimamotobuildinghazuminobusellsyourhomeipassenger-associationhb" +
+	"oehringerikehelsinkitahiroshimarriottrentino-s-tirollagrigentomo" +
+	"logyhembygdsforbundhemneshimonosekikawahemsedalhepforgeherokussl" +
+	"dheroyhgtvaroyhigashichichibungotakadatinghigashihiroshimanehiga" +
+	"shiizumozakitakamiizumisanofidelityumenhigashikagawahigashikagur" +
+	"asoedahigashikawakitaaikitakatakanezawahigashikurumeiwamarshalls" +
+	"tatebankokonoehigashimatsushimarinehigashimatsuyamakitaakitadait" +
+	"oigawahigashimurayamalatvuopmidoris-a-personaltrainerhigashinaru" +
+	"sembokukitakyushuaiahigashinehigashiomihachimanchesterhigashiosa" +
+	"kasayamamotorcycleshimosuwalkis-a-photographerokuappaviancarboni" +
+	"a-iglesias-carboniaiglesiascarboniahigashishirakawamatakaokamiko" +
+	"aniikappulawyhigashisumiyoshikawaminamiaikitamidsundhigashitsuno" +
+	"tteroyhigashiurausukitamotosumitakaginankokubunjis-a-playerhigas" +
+	"hiyamatokoriyamanakakogawahigashiyodogawahigashiyoshinogaris-a-r" +
+	"epublicancerresearchaeologicaliforniahiraizumisatohobby-sitehira" +
+	"katashinagawahiranairtraffichonanbugattipschmidtre-gauldaluxuryh" +
+	"irarahiratsukagawahirayaitakarazukamiminershimotsukehistorichous" +
+	"eshimotsumahitachiomiyaginowaniihamatamakawajimarcheapfizerhitac" +
+	"hiotagooglecodespotrentino-stirolhitoyoshimifunehitradinghjartda" +
+	"lhjelmelandholeckobierzyceholidayhomelinuxn--32vp30hagebostadhom" +
+	"esecuritymaceratakasagopocznosegawahomesecuritypccwinnershinichi" +
+	"nanhomesenseminehomeunixn--3bst00minamiogunicomcastresistancehon" +
+	"dahonefosshinjournalismailillesandefjordhoneywellhongorgehonjyoi" +
+	"takasakitanakagusukumoduminamisanrikubetsupplieshinjukumanohorni" +
+	"ndalhorseoulminamitanehortendofinternetrentino-sud-tirolhotelesh" +
+	"inkamigotoyohashimototalhotmailhoyangerhoylandetroitskolobrzeger" +
+	"sundhumanitieshinshinotsurgeonshalloffamemergencyberlevagangavii" +
+	"kanonjis-a-rockstarachowicehurdalhurumajis-a-socialistmeindianap" +
+	"olis-a-bloggerhyllestadhyogoris-a-soxfanhyugawarahyundaiwafunehz" +
+	"choseirouterjgorajlchoyodobashichikashukujitawarajlljmpgfoggiajn" +
+	"jelenia-gorajoyokaichibahcavuotnagaraholtaleniwaizumiotsukumiyam" +
+	"azonawsadodgemologicallyngenvironmentalconservationjpmorganjpnch" +
+	"ristmasakikugawatchandclockazojprshioyamemorialjuniperjurkristia" +
+	"nsundkrodsheradkrokstadelvaldaostarostwodzislawioshirakofuelkrym" +
+	"inamiyamashirokawanabelgorodeokumatorinokumejimassa-carrara-mass" +
+	"acarraramassabunkyonanaoshimageandsoundandvisionkumenanyokkaichi" +
+	"rurgiens-dentistes-en-francekunisakis-an-anarchistoricalsocietyk" +
+	"unitachiarailwaykunitomigusukumamotoyamasoykunneppupharmacyshira" +
+	"nukaniepcekunstsammlungkunstunddesignkuokgrouphiladelphiaareadmy" +
+	"blogsitekureisenkurgankurobelaudibleborkdalvdalaskanittedallasal" +
+	"leasingleshiraois-an-artisteinkjerusalembroiderykurogimilitaryku" +
+	"roisoftwarendalenugkuromatsunais-an-engineeringkurotakikawasakis" +
+	"-an-entertainerkurskomitamamurakushirogawakustanais-bykusuperspo" +
+	"rtrentinoaadigekutchanelkutnokuzbassnillfjordkuzumakis-certified" +
+	"ekakudamatsuekvafjordkvalsundkvamfamberkeleykvanangenkvinesdalkv" +
+	"innheradkviteseidskogkvitsoykwpspiegelkyowariasahikawamitourismo" +
+	"lanciamitoyoakemiuramiyazustkarasjokommunemiyotamanomjondalenmlb" +
+	"fanmonmouthaibarakisosakitagawamonstermonticellombardiamondshira" +
+	"okanmakiwakunigamihamadamontrealestatefarmequipmentrentinoalto-a" +
+	"digemonza-brianzaporizhzheguris-into-animelbournemonza-e-della-b" +
+	"rianzaporizhzhiamonzabrianzapposhiratakahagivingmonzaebrianzapto" +
+	"kuyamatsunomonzaedellabrianzaramoparachutingmordoviajessheiminan" +
+	"omoriyamatsusakahoginozawaonsenmoriyoshiokamitsuemormoneymoroyam" +
+	"atsushigemortgagemoscowitdkomonomoseushistorymosjoenmoskeneshish" +
+	"ikuis-into-carshintomikasaharamosshisognemosvikomorotsukamisunag" +
+	"awamoviemovistargardmtpchromedicaltanissettaitogliattiresaskatch" +
+	"ewanggouvicenzamtranakatsugawamuenstermugithubcloudusercontentre" +
+	"ntinoaltoadigemuikamogawamukochikushinonsenergymulhouservebeermu" +
+	"ltichoicemunakatanemuncieszynmuosattemuphilatelymurmanskomvuxn--" +
+	"3ds443gmurotorcraftrentinos-tirolmusashimurayamatsuuramusashinoh" +
+	"aramuseetrentinostirolmuseumverenigingmutsuzawamutuellevangermyd" +
+	"robofagemydshisuifuettertdasnetzmyeffectrentinosud-tirolmyfritzm" +
+	"yftphilipsymykolaivbarcelonagasakijobserverdalimoliserniaurskog-" +
+	"holandroverhalla-speziaeroportalabamagasakishimabarackmaze12myme" +
+	"diapchryslermyokohamamatsudamypepsonyoursidedyn-o-saurecipesaro-" +
+	"urbino-pesarourbinopesaromalvikongsbergmypetshitaramamyphotoshib" +
+	"ahccavuotnagareyamakeupowiathletajimabariakepnord-odalpharmacien" +
+	"snasaarlandmypsxn--3e0b707emysecuritycamerakermyshopblockshizuku" +
+	"ishimogosenmytis-a-bookkeepermincommbankommunalforbundmyvnchungb" +
+	"ukazunopictureshizuokannamiharupiemontepilotshoujis-into-cartoon" +
+	"shinyoshitomiokaneyamaxunusualpersonpimientakinouepinkongsvinger" +
+	"pioneerpippupiszpittsburghofauskedsmokorsetagayasells-for-ufcfan" +
+	"piwatepizzapkoninjamisonplanetariuminnesotaketakayamatsumaebashi" +
+	"modateplantationplantshowaplatformintelligenceplaystationplazapl" +
+	"chungnamdalseidfjordynv6plombardyndns-blogdnsiskinkyknethnologyp" +
+	"lumbingovtrentinosued-tirolplusterpmnpodzonepohlpointtomskonskow" +
+	"olancashireggioemiliaromagnakasatsunais-a-techietis-a-studentalp" +
+	"oivronpokerpokrovskonsulatrobeepilepsydneypolkowicepoltavalle-ao" +
+	"stathellexusdecorativeartshowtimeteorapphotographysiopomorzeszow" +
+	"ithgoogleapisa-hockeynutrentinosuedtirolpordenonepornporsangerpo" +
+	"rsanguideltajimicrolightingporsgrunnanpoznanpraxis-a-bruinsfanpr" +
+	"dpreservationpresidioprgmrprimelhusgardenprincipeprivatizehealth" +
+	"insuranceprochowiceproductionshriramlidlugolekagaminogiessenebak" +
+	"keshibechambagriculturennebudapest-a-la-masionthewifiat-band-cam" +
+	"paniaprofbsbxn--1lqs03nprogressivegaskimitsubatamicadaquesienapl" +
+	"esigdalprojectrentoyonakagyokutoyakokamishihoronobeokaminoyamats" +
+	"uris-into-gamessinashikitchenpromombetsupportrevisohughesilkonyv" +
+	"elolpropertyprotectionprudentialpruszkowithyoutubeneventodayprze" +
+	"worskogptzpvtroandinosaurlandesimbirskooris-a-therapistoiapwchur" +
+	"chaseljeepostfoldnavyatkakamigaharapzqldqponqslgbtrogstadquicksy" +
+	"tesimple-urlqvchuvashiaspreadbettingspydebergsrlsrtromsojavald-a" +
+	"ostarnbergsrvdonskoseis-an-accountantshinshirostoragestordalstor" +
+	"enburgstorfjordstpetersburgstreamsterdamnserverbaniastudiostudyn" +
+	"dns-homeftpaccesslingstuff-4-salestufftoread-booksneslupskopervi" +
+	"komatsushimashikestuttgartrusteesurnadalsurreysusakis-not-certif" +
+	"iedogawarabikomaezakirunorthwesternmutualsusonosuzakanrasuzukanu" +
+	"mazurysuzukis-saveducatorahimeshimakanegasakindleikangersvalbard" +
+	"udinkakegawasveiosvelvikosherbrookegawasvizzeraswedenswidnicargo" +
+	"daddyndns-at-homednshomebuiltrvenneslaskerrylogisticsmolenskoryo" +
+	"lasiteswiebodzindianmarketingswiftcoveronaritakurashikis-slickom" +
+	"aganeswinoujscienceandhistoryswisshikis-uberleetrentino-sued-tir" +
+	"olvestnesokndalvestre-slidreamhostersolarssonvestre-totennishiaw" +
+	"akuravestvagoyvevelstadvibo-valentiavibovalentiavideovillaskoyab" +
+	"earalvahkihokumakogengerdalipayufuchukotkafjordvinnicarriervinny" +
+	"tsiavipsinaappiagetmyiphoenixn--3oq18vl8pn36avirginiavirtualvirt" +
+	"ueeldomeindustriesteambulancevirtuelvisakatakkoelnvistaprinterna" +
+	"tionalfirearmsologneviterboltrysiljan-mayenvivoldavladikavkazanv" +
+	"ladimirvladivostokaizukarasuyamazoevlogoipictetrentinosudtirolvo" +
+	"lkenkunderseaportulansnoasaitamatsukuris-leetrentino-sudtirolvol" +
+	"kswagentsolundbeckosaigawavologdanskoshunantokigawavolvolgogradv" +
+	"olyngdalvoronezhytomyrvossevangenvotevotingvotoyonezawavrnworse-" +
+	"thangglidingwowiwatsukiyonowtversaillesokanoyakagewritesthisblog" +
+	"sytewroclawloclawekostromahachijorpelandwtcirclegnicagliaridagaw" +
+	"alterwtfbx-oslodingenwuozuwwworldwzmiuwajimaxn--4gq48lf9jeonname" +
+	"rikawauexn--4it168dxn--4it797kotohiradomainsurehabmerxn--4pvxsol" +
+	"utionsirdalxn--54b7fta0cciticatholicheltenham-radio-openair-traf" +
+	"fic-controlleyxn--55qw42gxn--55qx5dxn--5js045dxn--5rtp49civilavi" +
+	"ationisshingugexn--5rtq34kotouraxn--5su34j936bgsgxn--5tzm5gxn--6" +
+	"btw5axn--6frz82gxn--6orx2rxn--6qq986b3xlxn--7t0a264civilisationi" +
+	"yodogawaxn--80adxhksomaxn--80ao21axn--80aqecdr1axn--80asehdbarcl" +
+	"aycardstvedestrandiskstationatuurwetenschappenaumburgladelmenhor" +
+	"stalbans3-us-west-1xn--80aswgxn--80audnedalnxn--8ltr62kouhokutam" +
+	"akizunokunimilanoxn--8pvr4uxn--8y0a063axn--90a3academyactivedire" +
+	"ctoryazannakadomari-elasticbeanstalkounosunndalxn--90aishobaraom" +
+	"origuchiharagusabaerobaticketsaritsynologyeongnamegawakeisenbahn" +
+	"xn--90azhair-surveillancexn--9dbhblg6dietcimmobilienxn--9dbq2axn" +
+	"--9et52uxn--9krt00axn--andy-iraxn--aroport-byanagawaxn--asky-ira" +
+	"xn--aurskog-hland-jnbarclays3-us-west-2xn--avery-yuasakegawaxn--" +
+	"b-5gaxn--b4w605ferdxn--bck1b9a5dre4civilizationrwiiheyaizuwakama" +
+	"tsubushikusakadogawaxn--bdddj-mrabdxn--bearalvhki-y4axn--berlevg" +
+	"-jxaxn--bhcavuotna-s4axn--bhccavuotna-k7axn--bidr-5nachikatsuura" +
+	"xn--bievt-0qa2xn--bjarky-fyanaizuxn--bjddar-ptaobaokinawashirosa" +
+	"tobishimaintenancexn--blt-elaborxn--bmlo-graingerxn--bod-2naroyx" +
+	"n--brnny-wuaccident-investigationjukudoyamagadancebetsukubabia-g" +
+	"oracleaningatlantabusebastopologyeonggiehtavuoatnadexeterimo-i-r" +
+	"anagahamaroygardendoftheinternetflixilovecollegefantasyleaguerns" +
+	"eyxn--brnnysund-m8accident-preventionlineat-urlxn--brum-voagatun" +
+	"esnzxn--btsfjord-9zaxn--c1avgxn--c2br7gxn--c3s14misasaguris-gone" +
+	"xn--cck2b3barefootballangenoamishirasatochigiftsakuragawaustevol" +
+	"lavangenativeamericanantiques3-eu-central-1xn--cg4bkis-very-bada" +
+	"ddjamalborkangerxn--ciqpnxn--clchc0ea0b2g2a9gcdn77-sslattumisawa" +
+	"xn--comunicaes-v6a2oxn--correios-e-telecomunicaes-ghc29axn--czr6" +
+	"94bargainstitutelekommunikationaustdalindasiaustinnaturalhistory" +
+	"museumcentereportarnobrzegyptianaturalsciencesnaturelles3-eu-wes" +
+	"t-1xn--czrs0tunkoshimizumakiyosumydissentrentinoa-adigexn--czru2" +
+	"dxn--czrw28barreauctionaval-d-aosta-valleyonagoyaustraliaisondri" +
+	"odejaneirochestereviewskrakowebhoppdalaziobihirosakikamijimattel" +
+	"edatabaseballooningjesdalillyokosukareliancebinorilskariyakumold" +
+	"evennodessagaeroclubmedecincinnationwidealerhcloudcontrolledds3-" +
+	"external-1xn--d1acj3barrel-of-knowledgeologyonaguniversityoriika" +
+	"shibatakashimarylhurstjordalshalsenavigationavuotnakayamatsuzaki" +
+	"bigawaustrheimatunduhrennesoyokotebizenakamuratakahamaniwakurate" +
+	"xasdaburyatiaarpagefrontappagespeedmobilizerobiraetnagaivuotnaga" +
+	"okakyotambabydgoszczecinemailavagiske164xn--d1alfaromeoxn--d1atu" +
+	"rystykarasjohkamiokaminokawanishiaizubangexn--d5qv7z876civilwarm" +
+	"anagementkmaxxn--11b4c3dyroyrvikinguitarsassaris-a-democratmpana" +
+	"sonichelyabinskodjeffersonishiokoppegardyndns-weberlincolnishito" +
+	"sashimizunaminamiashigaraxn--davvenjrga-y4axn--djrs72d6uyxn--djt" +
+	"y4kouyamashikis-an-actorxn--dnna-grajewolterskluwerxn--drbak-wua" +
+	"xn--dyry-iraxn--e1a4claimsatxn--1ck2e1balsanagochihayaakasakawah" +
+	"araumalopolskanlandiscoveryokamikawanehonbetsurutaharaugustowada" +
+	"egubs3-ap-southeast-2xn--eckvdtc9dxn--efvn9somnarashinoxn--efvy8" +
+	"8hakatanotogawaxn--ehqz56nxn--elqq16hakodatexn--estv75gxn--eveni" +
+	"-0qa01gaxn--f6qx53axn--fct429kouzushimashikokuchuoxn--fhbeiarnxn" +
+	"--finny-yuaxn--fiq228c5hsooxn--fiq64barrell-of-knowledgeometre-e" +
+	"xperts-comptablesakuraibmditchyouripalaceu-1xn--fiqs8sopotromsak" +
+	"akinokiaxn--fiqz9sor-odalxn--fjord-lraxn--fjq720axn--fl-ziaxn--f" +
+	"lor-jraxn--flw351exn--fpcrj9c3dxn--frde-grandrapidsor-varangerxn" +
+	"--frna-woaraisaijosoyrovigorlicexn--frya-hraxn--fzc2c9e2clickddi" +
+	"elddanuorrikuzentakatajirissagamiharaxn--fzys8d69uvgmailxn--g2xx" +
+	"48clinichernigovernmentjxn--0trq7p7nnishiwakis-a-cubicle-slavell" +
+	"inowruzhgorodoyxn--gckr3f0fbxostrolekaluganskharkovallee-aostero" +
+	"yxn--gecrj9cliniquenoharaxn--ggaviika-8ya47hakonexn--gildeskl-g0" +
+	"axn--givuotna-8yandexn--3pxu8kosugexn--gjvik-wuaxn--gk3at1exn--g" +
+	"ls-elacaixaxn--gmq050is-very-evillagexn--gmqw5axn--h-2failxn--h1" +
+	"aeghakubankmpspacekitagatakasugais-a-nascarfanxn--h2brj9clintono" +
+	"shoesaudaxn--hbmer-xqaxn--hcesuolo-7ya35bashkiriauthordalandroid" +
+	"gcanonoichinomiyakehimejibestadigitalimanowarudagroks-thisamitsu" +
+	"kembuchikumagayagawakkanaibetsubamericanfamilydscloudappspotager" +
+	"epairbusantiquest-a-la-maisondre-landebusinessebyklefrakkestaddn" +
+	"skingjerdrumckinseyekaterinburgjerstadotsuruokamchatkameokameyam" +
+	"ashinatsukigatakamatsukawabogadocscbggfareastcoastaldefence-burg" +
+	"jemnes3-ap-northeast-1xn--hery-iraxn--hgebostad-g3axn--hmmrfeast" +
+	"a-s4acctuscanyxn--hnefoss-q1axn--hobl-iraxn--holtlen-hxaxn--hpmi" +
+	"r-xqaxn--hxt814exn--hyanger-q1axn--hylandet-54axn--i1b6b1a6a2exn" +
+	"--imr513nxn--indery-fyaotsurgutsiracusaitoshimaxn--io0a7is-very-" +
+	"goodhandsonxn--j1aefermobilyxn--j1amhakuis-a-nurservebbshellaspe" +
+	"ziaxn--j6w193gxn--jlq61u9w7basilicataniautomotivecodynaliascoli-" +
+	"picenoipirangamvikarlsoyokozemersongdalenviknakaniikawatanaguram" +
+	"usementargets-itargi234xn--jlster-byaroslavlaanderenxn--jrpeland" +
+	"-54axn--jvr189misconfusedxn--k7yn95exn--karmy-yuaxn--kbrq7oxn--k" +
+	"crx77d1x4axn--kfjord-iuaxn--klbu-woaxn--klt787dxn--kltp7dxn--klt" +
+	"x9axn--klty5xn--42c2d9axn--koluokta-7ya57hakusandiegoodyearthaga" +
+	"khanamigawaxn--kprw13dxn--kpry57dxn--kpu716ferraraxn--kput3is-ve" +
+	"ry-nicexn--krager-gyasakaiminatoyonoxn--kranghke-b0axn--krdshera" +
+	"d-m8axn--krehamn-dxaxn--krjohka-hwab49jetztrentino-suedtirolxn--" +
+	"ksnes-uuaxn--kvfjord-nxaxn--kvitsy-fyasugis-very-sweetpepperxn--" +
+	"kvnangen-k0axn--l-1fairwindsorfoldxn--l1accentureklamborghiniiza" +
+	"xn--laheadju-7yasuokaratexn--langevg-jxaxn--lcvr32dxn--ldingen-q" +
+	"1axn--leagaviika-52basketballfinanzgorautoscanadaejeonbukarmoyom" +
+	"itanobninskarpaczeladz-1xn--lesund-huaxn--lgbbat1ad8jevnakershus" +
+	"cultureggiocalabriaxn--lgrd-poacoachampionshiphoptobamagazinebra" +
+	"skaunjargallupinbatochiokinoshimalselvendrellindesnesakyotanabel" +
+	"lunordlandivtasvuodnaharimamurogawawegroweibolzanordreisa-geekas" +
+	"hiharaveroykenglandiscountysvardolls3-external-2xn--lhppi-xqaxn-" +
+	"-linds-pramericanartushuissier-justicexn--lns-qlanxessorreisahay" +
+	"akawakamiichikawamisatottoris-lostre-toteneis-a-teacherkassymant" +
+	"echnologyxn--loabt-0qaxn--lrdal-sraxn--lrenskog-54axn--lt-liaclo" +
+	"thingujolsterxn--lten-granexn--lury-iraxn--mely-iraxn--merker-ku" +
+	"axn--mgb2ddesortlandxn--mgb9awbferrarittogoldpoint2thisayamanash" +
+	"iibadajozorahkkeravjudygarlandxn--mgba3a3ejtuvalle-daostavangerx" +
+	"n--mgba3a4f16axn--mgba3a4franamizuholdingsmileksvikozagawaxn--mg" +
+	"ba7c0bbn0axn--mgbaakc7dvferreroticapebretonamiasakuchinotsuchiur" +
+	"akawarszawashingtondclkhersonxn--mgbaam7a8haldenxn--mgbab2bdxn--" +
+	"mgbai9a5eva00batsfjordivttasvuotnakaiwamizawavocatanzaroweddingj" +
+	"ovikaruizawasnesoddenmarkets3-ap-northeast-2xn--mgbai9azgqp6jewe" +
+	"lryxn--mgbayh7gpaduaxn--mgbb9fbpobanazawaxn--mgbbh1a71exn--mgbc0" +
+	"a9azcgxn--mgbca7dzdoxn--mgberp4a5d4a87gxn--mgberp4a5d4arxn--mgbi" +
+	"4ecexposedxn--mgbpl2fhskozakis-an-actresshintokushimaxn--mgbqly7" +
+	"c0a67fbcloudfrontdoorxn--mgbqly7cvafredrikstadtvsorumisakis-foun" +
+	"dationxn--mgbt3dhdxn--mgbtf8flatangerxn--mgbtx2bauhausposts-and-" +
+	"telecommunicationsupdatelemarkashiwaravoues3-fips-us-gov-west-1x" +
+	"n--mgbx4cd0abbottuxfamilyxn--mix082fetsundxn--mix891fgunmarnarda" +
+	"lxn--mjndalen-64axn--mk0axinfinitis-with-thebandoomdnsaliascolip" +
+	"icenord-aurdalceshiojirishirifujiedaxn--mk1bu44cloudfunctionsauh" +
+	"eradxn--mkru45isleofmandalxn--mlatvuopmi-s4axn--mli-tlapyatigors" +
+	"kpnxn--mlselv-iuaxn--moreke-juaxn--mori-qsakuhokkaidontexisteing" +
+	"eekppspbananarepublicartierxn--mosjen-eyatominamiawajikissmarter" +
+	"thanyouslivinghistoryxn--mot-tlaquilancasterxn--mre-og-romsdal-q" +
+	"qbbcartoonartdecoffeedbackashiwazakiyokawaraxastronomycdn77-secu" +
+	"rebungoonord-frontierepbodyndns-freebox-oskolegokasells-for-less" +
+	"3-ap-southeast-1xn--msy-ula0halsaintlouis-a-anarchistoireggio-em" +
+	"ilia-romagnakanotoddenxn--mtta-vrjjat-k7afamilycompanycntoyookan" +
+	"zakiwienxn--muost-0qaxn--mxtq1mishimatsumotofukexn--ngbc5azdxn--" +
+	"ngbe9e0axn--ngbrxn--45brj9circus-2xn--nit225krasnodarxn--nmesjev" +
+	"uemie-tcbajddarchaeologyxn--nnx388axn--nodexn--nqv7fs00emaxn--nr" +
+	"y-yla5gxn--ntso0iqx3axn--ntsq17gxn--nttery-byaeservecounterstrik" +
+	"exn--nvuotna-hwaxn--nyqy26axn--o1achattanooganorfolkebiblegalloc" +
+	"us-1xn--o3cw4hammarfeastafricamagichofunatorientexpressaseboknow" +
+	"sitalluxembourgrpanamaxn--od0algxn--od0aq3bbtatamotorsalangenayo" +
+	"roceanographicsalondonetskasukabedzin-the-bandaioiraseeklogesura" +
+	"nceoceanographiqueu-2xn--ogbpf8flekkefjordxn--oppegrd-ixaxn--ost" +
+	"ery-fyatsukaratsuginamikatagamihoboleslawiecolonialwilliamsburgu" +
+	"lenxn--osyro-wuaxn--p1acfhvalerxn--p1aiwchoshibuyachiyodavvesiid" +
+	"azaifuefukihaborokunohealth-carereformitakeharaxn--pbt977colorad" +
+	"oplateaudioxn--pgbs0dhlxn--porsgu-sta26fidonnakamagayachtscrappe" +
+	"r-sitexn--pssu33lxn--pssy2uxn--q9jyb4columbusheyxn--qcka1pmcdona" +
+	"ldsouthcarolinazawaxn--qqqt11missilelxn--qxamurskiptveterinairea" +
+	"ltorlandxn--rady-iraxn--rdal-poaxn--rde-ularvikrasnoyarskomforba" +
+	"mblebtimnetz-2xn--rdy-0nabarixn--rennesy-v1axn--rhkkervju-01afla" +
+	"kstadaokagakibichuoxn--rholt-mragowoodsidexn--rhqv96gxn--rht27zx" +
+	"n--rht3dxn--rht61exn--risa-5narusawaxn--risr-iraxn--rland-uuaxn-" +
+	"-rlingen-mxaxn--rmskog-byatsushiroxn--rny31hamurakamigoriginshim" +
+	"okawaxn--rovu88bbvacationswatch-and-clockerxn--rros-granvindafjo" +
+	"rdxn--rskog-uuaxn--rst-0narutokyotangotpantheonsitextileitungsen" +
+	"xn--rsta-francaiseharaxn--ryken-vuaxn--ryrvik-byawaraxn--s-1fait" +
+	"heguardianxn--s9brj9communitysnesavannahgaxn--sandnessjen-ogbizh" +
+	"evskredirectmeldalxn--sandy-yuaxn--seral-lraxn--ses554gxn--sgne-" +
+	"gratangenxn--skierv-utazaskvolloabathsbcomobaraxn--skjervy-v1axn" +
+	"--skjk-soaxn--sknit-yqaxn--sknland-fxaxn--slat-5narviikananporov" +
+	"noxn--slt-elabourxn--smla-hraxn--smna-gratis-a-bulls-fanxn--snas" +
+	"e-nraxn--sndre-land-0cbremangerxn--snes-poaxn--snsa-roaxn--sr-au" +
+	"rdal-l8axn--sr-fron-q1axn--sr-odal-q1axn--sr-varanger-ggbentleyu" +
+	"kuhashimojiinetatarstanflfanfshostrodawaraxn--srfold-byawatahama" +
+	"xn--srreisa-q1axn--srum-grazxn--stfold-9xaxn--stjrdal-s1axn--stj" +
+	"rdalshalsen-sqbeppubolognagasukeverbankasumigaurawa-mazowszexbox" +
+	"enapponazure-mobilevje-og-hornnesaltdalinkasuyakutiaxn--stre-tot" +
+	"en-zcbsouthwestfalenxn--t60b56axn--tckweatherchannelxn--tiq49xqy" +
+	"jewishartgalleryxn--tjme-hraxn--tn0agrinet-freaksowaxn--tnsberg-" +
+	"q1axn--tor131oxn--trany-yuaxn--trgstad-r1axn--trna-woaxn--troms-" +
+	"zuaxn--tysvr-vraxn--uc0atversicherungxn--uc0ay4axn--uist22hangou" +
+	"tsystemscloudcontrolappasadenaklodzkodairaxn--uisz3gxn--unjrga-r" +
+	"tarantourspjelkavikosakaerodromegalsacechirealminamiuonumasudaxn" +
+	"--unup4yxn--uuwu58axn--vads-jraxn--vard-jraxn--vegrshei-c0axn--v" +
+	"ermgensberater-ctberndiyurihonjournalistjohnhlfanhsalvadordaliba" +
+	"baikaliszczytnorddalinzaiitatebayashijonawatexn--vermgensberatun" +
+	"g-pwbeskidynathomedepotenzachpomorskienikiiyamanobeauxartsandcra" +
+	"ftsalzburglassassinationalheritagematsubarakawagoexn--vestvgy-ix" +
+	"a6oxn--vg-yiabbvieeexn--vgan-qoaxn--vgsy-qoa0jfkomakiyosatokashi" +
+	"kiyosemitexn--vgu402comparemarkerryhotelsaves-the-whalessandria-" +
+	"trani-barletta-andriatranibarlettaandriaxn--vhquvestfoldxn--vler" +
+	"-qoaxn--vre-eiker-k8axn--vrggt-xqadxn--vry-yla5gxn--vuq861bestbu" +
+	"yshousesamegawaxn--w4r85el8fhu5dnraxn--w4rs40lxn--wcvs22dxn--wgb" +
+	"h1compute-1xn--wgbl6axn--xhq521betainaboxfusejnynysafetysfjordnp" +
+	"alanakhodkanagawaxn--xkc2al3hye2axn--xkc2dl3a5ee0hannanmokuizumo" +
+	"dernxn--y9a3aquariumisugitokorozawaxn--yer-znarvikristiansandcat" +
+	"shirahamatonbetsurgeryxn--yfro4i67oxn--ygarden-p1axn--ygbi2ammxn" +
+	"--45q11citadeliveryggeelvinckchristiansburgruexn--ystre-slidre-u" +
+	"jbieidsvollipetskaszubyusuharaxn--zbx025dxn--zf0ao64axn--zf0avxn" +
+	"--4gbriminingxn--zfr164bielawallonieruchomoscienceandindustrynik" +
+	"koebenhavnikolaeventsamnangerxperiaxz"
+
+// nodes is the list of nodes. Each node is represented as a uint32, which
+// encodes the node's children, wildcard bit and node type (as an index into
+// the children array), ICANN bit and text.
+//
+// If the table was generated with the -comments flag, there is a //-comment
+// after each node's data. In it is the nodes-array indexes of the children,
+// formatted as (n0x1234-n0x1256), with * denoting the wildcard bit. The
+// nodeType is printed as + for normal, ! for exception, and o for parent-only
+// nodes that have children but don't match a domain label in their own right.
+// An I denotes an ICANN domain.
+//
+// The layout within the uint32, from MSB to LSB, is:
+//	[ 1 bits] unused
+//	[ 9 bits] children index
+//	[ 1 bits] ICANN bit
+//	[15 bits] text index
+//	[ 6 bits] text length
+var nodes = [...]uint32{
+	0x27a003,
+	0x328304,
+	0x272406,
+	0x36e2c3,
+	0x36e2c6,
+	0x3a6306,
+	0x260483,
+	0x206e44,
+	0x345647,
+	0x272048,
+	0x1a00882,
+	0x30abc7,
+	0x355a09,
+	0x2eb6ca,
+	0x2eb6cb,
+	0x22f803,
+	0x28f606,
+	0x232a05,
+	0x1e00702,
+	0x215f44,
+	0x236483,
+	0x278b45,
+	0x2208ac2,
+	0x330fc3,
+	0x26cf584,
+	0x328c05,
+	0x2a014c2,
+	0x378a0e,
+	0x24d0c3,
+	0x37e606,
+	0x37e60b,
+	0x2e01c42,
+	0x350f47,
+	0x235446,
+	0x3200a42,
+	0x258943,
+	0x258944,
+	0x343086,
+	0x23c1c8,
+	0x287c86,
+	0x2717c4,
+	0x3600ec2,
+	0x329b89,
+	0x3a2ec7,
+	0x2f7646,
+	0x357689,
+	0x295f08,
+	0x2af504,
+	0x3a0846,
+	0x216b86,
+	0x3a02a82,
+	0x25af4f,
+	0x34280e,
+	0x211dc4,
+	0x2bc7c5,
+	0x2e4bc5,
+	0x2ec7c9,
+	0x23ecc9,
+	0x340e47,
+	0x212fc6,
+	0x212f03,
+	0x3e04a42,
+	0x270703,
+	0x22098a,
+	0x20b0c3,
+	0x2607c5,
+	0x287302,
+	0x287309,
+	0x4201e02,
+	0x208184,
+	0x206986,
+	0x237bc5,
+	0x34ea44,
+	0x4a86a04,
+	0x201e03,
+	0x231a44,
+	0x4e02902,
+	0x328044,
+	0x31ea44,
+	0x22350a,
+	0x52009c2,
+	0x2d2687,
+	0x238088,
+	0x5a08f02,
+	0x321407,
+	0x2b7744,
+	0x2b7747,
+	0x385185,
+	0x36ccc7,
+	0x340c06,
+	0x21cec4,
+	0x357985,
+	0x299d07,
+	0x6a01cc2,
+	0x2af103,
+	0x213402,
+	0x375ac3,
+	0x6e136c2,
+	0x283905,
+	0x7204a02,
+	0x329244,
+	0x27eec5,
+	0x211d07,
+	0x3731ce,
+	0x2e3e04,
+	0x245c04,
+	0x208143,
+	0x2ce4c9,
+	0x307ecb,
+	0x30f508,
+	0x31a288,
+	0x31e088,
+	0x323148,
+	0x3574ca,
+	0x36cbc7,
+	0x2272c6,
+	0x769ed02,
+	0x375043,
+	0x37fa83,
+	0x38d3c4,
+	0x260d03,
+	0x2604c3,
+	0x1711602,
+	0x7a070c2,
+	0x24a0c5,
+	0x28ecc6,
+	0x2c9e84,
+	0x396e07,
+	0x32cc06,
+	0x341644,
+	0x3a9e87,
+	0x2070c3,
+	0x7ebefc2,
+	0x8305b42,
+	0x8619ac2,
+	0x219ac6,
+	0x8a00002,
+	0x37dd45,
+	0x312b43,
+	0x204384,
+	0x2db0c4,
+	0x2db0c5,
+	0x2075c3,
+	0x8f27883,
+	0x920a882,
+	0x28a905,
+	0x28a90b,
+	0x22be86,
+	0x20cd4b,
+	0x276844,
+	0x20d309,
+	0x20f104,
+	0x960f602,
+	0x210943,
+	0x2125c3,
+	0x1612742,
+	0x245dc3,
+	0x21274a,
+	0x9a12bc2,
+	0x2161c5,
+	0x290fca,
+	0x2cd084,
+	0x213a83,
+	0x213f44,
+	0x2159c3,
+	0x2159c4,
+	0x2159c7,
+	0x216f85,
+	0x217785,
+	0x218e86,
+	0x219d86,
+	0x21a783,
+	0x21e908,
+	0x258283,
+	0x9e03482,
+	0x21f388,
+	0x21474b,
+	0x222208,
+	0x222986,
+	0x223907,
+	0x227e88,
+	0xa63a242,
+	0xaa715c2,
+	0x2e3688,
+	0x29f847,
+	0x242c85,
+	0x242c88,
+	0x343988,
+	0x383b83,
+	0x22a7c4,
+	0x38d402,
+	0xae2cb02,
+	0xb2519c2,
+	0xba2ce42,
+	0x22ce43,
+	0xbe01482,
+	0x206e03,
+	0x201484,
+	0x21a903,
+	0x2af4c4,
+	0x25fc4b,
+	0x214683,
+	0x2d3946,
+	0x223384,
+	0x29e18e,
+	0x341045,
+	0x265808,
+	0x2246c7,
+	0x2246ca,
+	0x22fd03,
+	0x275647,
+	0x308085,
+	0x22fd04,
+	0x22fd06,
+	0x22fd07,
+	0x2c62c4,
+	0x373507,
+	0x2028c4,
+	0x2093c4,
+	0x2093c6,
+	0x2dc104,
+	0x221c46,
+	0x2138c3,
+	0x226b48,
+	0x303708,
+	0x245bc3,
+	0x245d83,
+	0x395544,
+	0x39b103,
+	0xc200482,
+	0xc707ac2,
+	0x2004c3,
+	0x208406,
+	0x381043,
+	0x228584,
+	0xca19942,
+	0x2d7f03,
+	0x219943,
+	0x21b682,
+	0xce008c2,
+	0x2bb486,
+	0x233907,
+	0x2e9005,
+	0x344c04,
+	0x2a1c45,
+	0x2021c7,
+	0x26e685,
+	0x2aff89,
+	0x2c75c6,
+	0x2d0108,
+	0x2e8f06,
+	0xd2092c2,
+	0x23bd88,
+	0x300a86,
+	0x20dc05,
+	0x3af1c7,
+	0x303604,
+	0x303605,
+	0x287e44,
+	0x287e48,
+	0xd60a1c2,
+	0xda036c2,
+	0x32f506,
+	0x3160c8,
+	0x338e05,
+	0x33a086,
+	0x33c2c8,
+	0x35ff48,
+	0xdec8b85,
+	0x2036c4,
+	0x324407,
+	0xe20d9c2,
+	0xe61eb82,
+	0xfa06a82,
+	0x3597c5,
+	0x2a22c5,
+	0x3753c6,
+	0x317a47,
+	0x22aac7,
+	0x1022bf83,
+	0x2a5b07,
+	0x2d4808,
+	0x390509,
+	0x378bc7,
+	0x3a7507,
+	0x22e108,
+	0x22e906,
+	0x22f846,
+	0x23020c,
+	0x230d8a,
+	0x231247,
+	0x2328cb,
+	0x233747,
+	0x23374e,
+	0x234744,
+	0x234a44,
+	0x238e47,
+	0x25a647,
+	0x23d186,
+	0x23d187,
+	0x23dd87,
+	0x13208942,
+	0x23f586,
+	0x23f58a,
+	0x23f80b,
+	0x240bc7,
+	0x241585,
+	0x2418c3,
+	0x241dc6,
+	0x241dc7,
+	0x23ee83,
+	0x1362ea42,
+	0x24268a,
+	0x13b56b42,
+	0x13ea4c42,
+	0x14244142,
+	0x14635542,
+	0x244ec5,
+	0x2459c4,
+	0x14e00682,
+	0x3280c5,
+	0x278b03,
+	0x315b45,
+	0x2124c4,
+	0x293906,
+	0x202bc6,
+	0x28ab03,
+	0x3654c4,
+	0x324ec3,
+	0x15201582,
+	0x208d04,
+	0x324986,
+	0x208d05,
+	0x258006,
+	0x3af2c8,
+	0x21ecc4,
+	0x236248,
+	0x2e01c5,
+	0x32bcc8,
+	0x2dce46,
+	0x2b4247,
+	0x22f244,
+	0x22f246,
+	0x323fc3,
+	0x385503,
+	0x2bfd08,
+	0x30d944,
+	0x341787,
+	0x248cc6,
+	0x30af09,
+	0x35c488,
+	0x330488,
+	0x24f8c4,
+	0x3a2543,
+	0x206c82,
+	0x1560b042,
+	0x15a05382,
+	0x3abf43,
+	0x15e12c42,
+	0x345784,
+	0x2af205,
+	0x29d8c3,
+	0x2306c4,
+	0x302947,
+	0x344943,
+	0x2465c8,
+	0x205f85,
+	0x308144,
+	0x36bb43,
+	0x27ee45,
+	0x27ef84,
+	0x2090c6,
+	0x20c244,
+	0x20d086,
+	0x211c46,
+	0x261504,
+	0x2199c3,
+	0x162b1a02,
+	0x350e05,
+	0x223cc3,
+	0x16600442,
+	0x2bbf85,
+	0x231b03,
+	0x231b09,
+	0x16a04142,
+	0x172110c2,
+	0x329605,
+	0x21cd46,
+	0x34c707,
+	0x2c9a46,
+	0x2b9908,
+	0x2b990b,
+	0x20844b,
+	0x2e9205,
+	0x2d07c5,
+	0x2c0a89,
+	0x1600bc2,
+	0x2616c8,
+	0x20cf84,
+	0x17a00202,
+	0x25f883,
+	0x1825a806,
+	0x380ec8,
+	0x18602e42,
+	0x226088,
+	0x18a08b02,
+	0x27698a,
+	0x228bc3,
+	0x3b23c6,
+	0x3997c8,
+	0x204188,
+	0x334fc6,
+	0x36a0c7,
+	0x25b147,
+	0x21670a,
+	0x2cd104,
+	0x33ed84,
+	0x3554c9,
+	0x38ff05,
+	0x342a06,
+	0x20b203,
+	0x249604,
+	0x2143c4,
+	0x24fd07,
+	0x22d547,
+	0x26b1c4,
+	0x216645,
+	0x375488,
+	0x3617c7,
+	0x364607,
+	0x18e09342,
+	0x2e3cc4,
+	0x2946c8,
+	0x3859c4,
+	0x246a04,
+	0x246e05,
+	0x246f47,
+	0x210c49,
+	0x247d04,
+	0x248a09,
+	0x248fc8,
+	0x249384,
+	0x249387,
+	0x249b83,
+	0x24a707,
+	0x1649242,
+	0x17a6b82,
+	0x24b646,
+	0x24c287,
+	0x24c884,
+	0x24d607,
+	0x24e647,
+	0x24ed88,
+	0x24f503,
+	0x23d6c2,
+	0x202442,
+	0x251003,
+	0x251004,
+	0x25100b,
+	0x31a388,
+	0x257f44,
+	0x251d05,
+	0x253c87,
+	0x2569c5,
+	0x36bf0a,
+	0x257e83,
+	0x1920db02,
+	0x258184,
+	0x25a409,
+	0x25f283,
+	0x25f347,
+	0x36b309,
+	0x376348,
+	0x208a03,
+	0x27dd47,
+	0x27e489,
+	0x2840c3,
+	0x285e04,
+	0x286bc9,
+	0x289286,
+	0x28a343,
+	0x201c82,
+	0x244d43,
+	0x39c247,
+	0x37de85,
+	0x35a206,
+	0x24a304,
+	0x2e6285,
+	0x220943,
+	0x21a9c6,
+	0x20d502,
+	0x390ec4,
+	0x225902,
+	0x2daa43,
+	0x196007c2,
+	0x247643,
+	0x21a204,
+	0x21a207,
+	0x204686,
+	0x24cdc2,
+	0x19a53a02,
+	0x3af4c4,
+	0x19e39ec2,
+	0x1a202842,
+	0x31aac4,
+	0x31aac5,
+	0x28de45,
+	0x2c2a86,
+	0x1a603ac2,
+	0x308d05,
+	0x3a5245,
+	0x29a0c3,
+	0x204e86,
+	0x212b05,
+	0x219a42,
+	0x339cc5,
+	0x219a44,
+	0x21ec03,
+	0x21ee43,
+	0x1aa0be82,
+	0x2f1f87,
+	0x361a44,
+	0x361a49,
+	0x249504,
+	0x23a103,
+	0x34a009,
+	0x350cc8,
+	0x2a2144,
+	0x2a2146,
+	0x2a4543,
+	0x214dc3,
+	0x22a0c4,
+	0x250cc3,
+	0x1aee0682,
+	0x301c42,
+	0x1b210702,
+	0x314a48,
+	0x3801c8,
+	0x394986,
+	0x245545,
+	0x229b85,
+	0x210705,
+	0x224242,
+	0x1b6931c2,
+	0x1633602,
+	0x390088,
+	0x23bcc5,
+	0x3052c4,
+	0x2e0105,
+	0x32b887,
+	0x257c84,
+	0x23d4c2,
+	0x1ba03e82,
+	0x30d204,
+	0x2140c7,
+	0x39ee87,
+	0x36cc84,
+	0x290f83,
+	0x245b04,
+	0x245b08,
+	0x22fb86,
+	0x22fb8a,
+	0x210b04,
+	0x291308,
+	0x24f004,
+	0x223a06,
+	0x293184,
+	0x359ac6,
+	0x341dc9,
+	0x266587,
+	0x235903,
+	0x1be10442,
+	0x26ecc3,
+	0x20f802,
+	0x1c217e82,
+	0x2df3c6,
+	0x363888,
+	0x2a3687,
+	0x3a4389,
+	0x23a009,
+	0x2a3f45,
+	0x2a50c9,
+	0x2a5f45,
+	0x2a6a09,
+	0x2a8105,
+	0x288484,
+	0x288487,
+	0x2998c3,
+	0x2a8e07,
+	0x3a78c6,
+	0x2a9607,
+	0x2a0f05,
+	0x2ab543,
+	0x1c630842,
+	0x392904,
+	0x1ca29982,
+	0x25a043,
+	0x1ce134c2,
+	0x2e6cc6,
+	0x238005,
+	0x2acc47,
+	0x335583,
+	0x260c84,
+	0x203bc3,
+	0x2e33c3,
+	0x1d20b542,
+	0x1da00042,
+	0x3a6404,
+	0x23d683,
+	0x397285,
+	0x2aafc5,
+	0x1de04982,
+	0x1e600942,
+	0x27e086,
+	0x20ad44,
+	0x30da84,
+	0x30da8a,
+	0x1ee02002,
+	0x2f920a,
+	0x36f688,
+	0x1f2023c4,
+	0x215ac3,
+	0x24bc43,
+	0x31e1c9,
+	0x22dec9,
+	0x302a46,
+	0x1f602243,
+	0x2d9885,
+	0x2f9e4d,
+	0x207286,
+	0x21134b,
+	0x1fa016c2,
+	0x34c188,
+	0x1fe1ea02,
+	0x20208282,
+	0x370545,
+	0x20603fc2,
+	0x269947,
+	0x2a6507,
+	0x21db43,
+	0x258c48,
+	0x20a07382,
+	0x282f04,
+	0x212d83,
+	0x34bb85,
+	0x383983,
+	0x237ac6,
+	0x2eae04,
+	0x245d43,
+	0x26f203,
+	0x20e0abc2,
+	0x2e9184,
+	0x353985,
+	0x367f07,
+	0x27bbc3,
+	0x2ad443,
+	0x2adc43,
+	0x1622602,
+	0x2add03,
+	0x2adf83,
+	0x21202dc2,
+	0x2ce884,
+	0x27f1c6,
+	0x20fa03,
+	0x2ae303,
+	0x216afcc2,
+	0x2afcc8,
+	0x2b0784,
+	0x2402c6,
+	0x2b0bc7,
+	0x218fc6,
+	0x338f04,
+	0x2f2001c2,
+	0x3a778b,
+	0x2f29ce,
+	0x21d4cf,
+	0x234343,
+	0x2fa44d02,
+	0x1605482,
+	0x2fe04b42,
+	0x227dc3,
+	0x233343,
+	0x238c46,
+	0x2f0ac6,
+	0x2e6587,
+	0x379084,
+	0x3028da82,
+	0x306062c2,
+	0x2f9b45,
+	0x2ee007,
+	0x2f15c6,
+	0x30a6cf82,
+	0x26cf84,
+	0x372003,
+	0x30e0ac82,
+	0x352e03,
+	0x3910c4,
+	0x2b6949,
+	0x16bd702,
+	0x31235c82,
+	0x2dac86,
+	0x26b485,
+	0x31645cc2,
+	0x31a00102,
+	0x33e107,
+	0x2033c9,
+	0x355c8b,
+	0x25af05,
+	0x3748c9,
+	0x2be006,
+	0x22bec7,
+	0x2060c4,
+	0x2cf089,
+	0x35dbc7,
+	0x2b7ec7,
+	0x20ae83,
+	0x20ae86,
+	0x2dd5c7,
+	0x2387c3,
+	0x27cf86,
+	0x31e049c2,
+	0x32231d82,
+	0x21bfc3,
+	0x260885,
+	0x221ac7,
+	0x343c86,
+	0x37de05,
+	0x376b04,
+	0x2de1c5,
+	0x2e9b84,
+	0x32600f02,
+	0x321d87,
+	0x2e2904,
+	0x22ddc4,
+	0x22ddcd,
+	0x24c649,
+	0x2e04c8,
+	0x22bb04,
+	0x323605,
+	0x2633c7,
+	0x2ceb44,
+	0x32ccc7,
+	0x35ab05,
+	0x32b9a984,
+	0x2ce185,
+	0x25df44,
+	0x374206,
+	0x317845,
+	0x32e34802,
+	0x213b44,
+	0x213b45,
+	0x38d946,
+	0x37df45,
+	0x2548c4,
+	0x2e7043,
+	0x380406,
+	0x20efc5,
+	0x210e45,
+	0x317944,
+	0x210b83,
+	0x210b8c,
+	0x33289bc2,
+	0x33605602,
+	0x33a17382,
+	0x39a883,
+	0x39a884,
+	0x33e03702,
+	0x2fd288,
+	0x35a2c5,
+	0x37f144,
+	0x29fe46,
+	0x34233a82,
+	0x3461ca02,
+	0x34a00982,
+	0x2b5dc5,
+	0x2613c6,
+	0x24fc44,
+	0x3435c6,
+	0x2d2446,
+	0x207a03,
+	0x34f2afca,
+	0x23d9c5,
+	0x2f3506,
+	0x2f3509,
+	0x367547,
+	0x291748,
+	0x295dc9,
+	0x218388,
+	0x322e86,
+	0x23db83,
+	0x35206a42,
+	0x386e83,
+	0x386e89,
+	0x3442c8,
+	0x3560ad82,
+	0x35a0f842,
+	0x232003,
+	0x2cff85,
+	0x251804,
+	0x2c1a09,
+	0x2aa9c4,
+	0x2b09c8,
+	0x20f843,
+	0x2600c4,
+	0x329d03,
+	0x22dd07,
+	0x35e3c442,
+	0x25a2c2,
+	0x22af85,
+	0x26d1c9,
+	0x220ec3,
+	0x27f804,
+	0x2d9844,
+	0x263443,
+	0x28088a,
+	0x3636f542,
+	0x36613b02,
+	0x2bef43,
+	0x3721c3,
+	0x1660082,
+	0x260f43,
+	0x36a50702,
+	0x3891c4,
+	0x36e02ac2,
+	0x3730db04,
+	0x34a586,
+	0x27e2c4,
+	0x2406c3,
+	0x284a83,
+	0x21c443,
+	0x23fb86,
+	0x2c4d05,
+	0x2bf7c7,
+	0x22bd89,
+	0x2c37c5,
+	0x2c4c46,
+	0x2c5248,
+	0x2c5446,
+	0x256604,
+	0x29920b,
+	0x2c70c3,
+	0x2c70c5,
+	0x2c7208,
+	0x21e782,
+	0x33e402,
+	0x37629382,
+	0x37a03642,
+	0x2632c3,
+	0x37e08b82,
+	0x26e443,
+	0x2c7504,
+	0x2c83c3,
+	0x38607682,
+	0x2c9f8b,
+	0x38acc586,
+	0x2ef8c6,
+	0x2ccbc8,
+	0x38ecae42,
+	0x39212602,
+	0x3961ee82,
+	0x39a0b602,
+	0x39e02642,
+	0x20264b,
+	0x3a201842,
+	0x2262c3,
+	0x316c05,
+	0x321ac6,
+	0x3a611004,
+	0x20b687,
+	0x32478a,
+	0x31ec86,
+	0x2e9444,
+	0x262ec3,
+	0x3b20dbc2,
+	0x202b42,
+	0x2570c3,
+	0x3b64c083,
+	0x2635c7,
+	0x317747,
+	0x3ca51107,
+	0x228b87,
+	0x214983,
+	0x2248ca,
+	0x214984,
+	0x248bc4,
+	0x248bca,
+	0x24f205,
+	0x3ce02402,
+	0x24e143,
+	0x3d200dc2,
+	0x20f543,
+	0x26ec83,
+	0x3da01742,
+	0x2a5a84,
+	0x220684,
+	0x201745,
+	0x2d8385,
+	0x2368c6,
+	0x236c46,
+	0x3de09142,
+	0x3e201042,
+	0x3360c5,
+	0x2ef5d2,
+	0x24ca86,
+	0x226a03,
+	0x33b046,
+	0x2ff645,
+	0x160b282,
+	0x4660d682,
+	0x2efd43,
+	0x310b83,
+	0x2dbcc3,
+	0x46a07902,
+	0x378d03,
+	0x46e12f42,
+	0x2a3443,
+	0x2ce8c8,
+	0x222f43,
+	0x222f46,
+	0x313c87,
+	0x210586,
+	0x21058b,
+	0x2e9387,
+	0x392704,
+	0x47602102,
+	0x3a0745,
+	0x202b03,
+	0x22cd43,
+	0x3188c3,
+	0x3188c6,
+	0x2d088a,
+	0x273f43,
+	0x235304,
+	0x316006,
+	0x20e006,
+	0x47a04703,
+	0x260b47,
+	0x37ea8d,
+	0x38cd87,
+	0x298f45,
+	0x246406,
+	0x20f003,
+	0x492050c3,
+	0x49609242,
+	0x3283c4,
+	0x22d28c,
+	0x32bf09,
+	0x23a787,
+	0x249885,
+	0x268144,
+	0x272748,
+	0x279205,
+	0x286a85,
+	0x28d409,
+	0x2f7703,
+	0x2f7704,
+	0x2a4bc4,
+	0x49a00ac2,
+	0x265883,
+	0x49e92c42,
+	0x2a1d46,
+	0x160b142,
+	0x4a299882,
+	0x2b5cc8,
+	0x2ce0c7,
+	0x299885,
+	0x2de9cb,
+	0x2d1dc6,
+	0x2debc6,
+	0x2f8346,
+	0x224cc4,
+	0x2fba46,
+	0x2d51c8,
+	0x232243,
+	0x247403,
+	0x247404,
+	0x2d6c84,
+	0x2d7007,
+	0x2d8185,
+	0x4a6d82c2,
+	0x4aa0a742,
+	0x20a745,
+	0x29cd44,
+	0x2d9b8b,
+	0x2dafc8,
+	0x2db6c4,
+	0x26cfc2,
+	0x4b2aff02,
+	0x2aff03,
+	0x2dbb04,
+	0x2dd185,
+	0x22a547,
+	0x2dfc44,
+	0x2e9244,
+	0x4b608582,
+	0x35d1c9,
+	0x2e0b05,
+	0x25b1c5,
+	0x2e1685,
+	0x4ba1d603,
+	0x2e24c4,
+	0x2e24cb,
+	0x2e4144,
+	0x2e45cb,
+	0x2e6745,
+	0x21d60a,
+	0x2e7108,
+	0x2e730a,
+	0x2e7583,
+	0x2e758a,
+	0x4be297c2,
+	0x4c242242,
+	0x263c83,
+	0x4c6e8e82,
+	0x2e8e83,
+	0x4caea942,
+	0x4cf132c2,
+	0x2e9a04,
+	0x21ea46,
+	0x343305,
+	0x2ea303,
+	0x27a5c6,
+	0x22b644,
+	0x4d203942,
+	0x2b6e84,
+	0x2c070a,
+	0x387f47,
+	0x237e46,
+	0x2d0d47,
+	0x22d3c3,
+	0x24f088,
+	0x25ab8b,
+	0x302b45,
+	0x2b7285,
+	0x2b7286,
+	0x217c04,
+	0x323908,
+	0x203943,
+	0x216a84,
+	0x216a87,
+	0x342fc6,
+	0x31f2c6,
+	0x29dfca,
+	0x246104,
+	0x24610a,
+	0x322406,
+	0x322407,
+	0x251d87,
+	0x276184,
+	0x276189,
+	0x266c05,
+	0x239e4b,
+	0x279443,
+	0x20d243,
+	0x229bc3,
+	0x384904,
+	0x4d6034c2,
+	0x25b486,
+	0x2ab2c5,
+	0x2b2645,
+	0x223e46,
+	0x248684,
+	0x4da00c02,
+	0x223f44,
+	0x4de0ed42,
+	0x2307c4,
+	0x225703,
+	0x4e301102,
+	0x308803,
+	0x258606,
+	0x4e602942,
+	0x2d30c8,
+	0x3abc84,
+	0x3abc86,
+	0x31abc6,
+	0x253d44,
+	0x380385,
+	0x2035c8,
+	0x204d07,
+	0x20c307,
+	0x20c30f,
+	0x2945c6,
+	0x220bc3,
+	0x220bc4,
+	0x228444,
+	0x233083,
+	0x223b44,
+	0x22fe84,
+	0x4ea2b382,
+	0x28a843,
+	0x23a203,
+	0x4ee03682,
+	0x253503,
+	0x345843,
+	0x21780a,
+	0x29fa47,
+	0x23b08c,
+	0x23b346,
+	0x23b886,
+	0x23d307,
+	0x22e547,
+	0x241f49,
+	0x21f4c4,
+	0x242e44,
+	0x4f24ecc2,
+	0x4f604042,
+	0x260944,
+	0x375f06,
+	0x22e9c8,
+	0x380d04,
+	0x269986,
+	0x2c9a05,
+	0x26ae48,
+	0x208643,
+	0x26df45,
+	0x272903,
+	0x25b2c3,
+	0x25b2c4,
+	0x2744c3,
+	0x4fa50642,
+	0x4fe01f82,
+	0x279309,
+	0x286985,
+	0x288004,
+	0x34adc5,
+	0x213604,
+	0x24be47,
+	0x340005,
+	0x2512c4,
+	0x2512c8,
+	0x2d5b06,
+	0x2d9544,
+	0x2de648,
+	0x2e2747,
+	0x50202742,
+	0x2e6e04,
+	0x2e63c4,
+	0x2b80c7,
+	0x50679d44,
+	0x236b42,
+	0x50a03a02,
+	0x24ddc3,
+	0x2dab84,
+	0x235c43,
+	0x2754c5,
+	0x50e4acc2,
+	0x2ed405,
+	0x20b5c2,
+	0x373e45,
+	0x363a45,
+	0x512198c2,
+	0x2198c4,
+	0x51609642,
+	0x236506,
+	0x2abb46,
+	0x26d308,
+	0x2b90c8,
+	0x2e6c44,
+	0x2f5905,
+	0x302f49,
+	0x34c804,
+	0x2d0844,
+	0x261603,
+	0x216845,
+	0x2bd7c7,
+	0x277b44,
+	0x2ea5cd,
+	0x2eab42,
+	0x2eab43,
+	0x2eac03,
+	0x51a04582,
+	0x38a045,
+	0x22b107,
+	0x228c44,
+	0x228c47,
+	0x295fc9,
+	0x2c0849,
+	0x20c987,
+	0x279043,
+	0x279048,
+	0x21db89,
+	0x2ebb87,
+	0x2ebf05,
+	0x2ec6c6,
+	0x2ecd06,
+	0x2ece85,
+	0x24c745,
+	0x51e00c42,
+	0x226605,
+	0x2bae0a,
+	0x2a7a08,
+	0x21c906,
+	0x2e6987,
+	0x26b104,
+	0x3ae3c7,
+	0x2f0186,
+	0x52200242,
+	0x38d646,
+	0x2f374a,
+	0x2f4745,
+	0x526d3382,
+	0x52a56142,
+	0x2dd906,
+	0x35ee88,
+	0x39f047,
+	0x52e00602,
+	0x213d03,
+	0x200a06,
+	0x30b804,
+	0x313b46,
+	0x34d186,
+	0x37fb0a,
+	0x397385,
+	0x20fa86,
+	0x2133c3,
+	0x2133c4,
+	0x2083c2,
+	0x300a43,
+	0x53248c82,
+	0x2c5603,
+	0x2f9484,
+	0x2dca84,
+	0x35efca,
+	0x2468c3,
+	0x287d48,
+	0x279dca,
+	0x234cc7,
+	0x2f5d86,
+	0x2363c4,
+	0x28fcc2,
+	0x208bc2,
+	0x5360a6c2,
+	0x245ac3,
+	0x251b47,
+	0x27a887,
+	0x38ffcb,
+	0x328284,
+	0x30c5c7,
+	0x22a646,
+	0x219bc7,
+	0x29f984,
+	0x2c7d05,
+	0x291bc5,
+	0x53a1bf02,
+	0x2225c6,
+	0x33a583,
+	0x2be6c2,
+	0x32b206,
+	0x53e0fe42,
+	0x542012c2,
+	0x2012c5,
+	0x5461c642,
+	0x54a05702,
+	0x2e7dc5,
+	0x38e705,
+	0x20fb45,
+	0x26c743,
+	0x239ac5,
+	0x2d1e87,
+	0x2a94c5,
+	0x3a0145,
+	0x265904,
+	0x243586,
+	0x24aac4,
+	0x54e05a02,
+	0x27dbc5,
+	0x2a2c87,
+	0x2299c8,
+	0x26ed46,
+	0x26ed4d,
+	0x26f609,
+	0x26f612,
+	0x2ee645,
+	0x2f2703,
+	0x55a0f042,
+	0x2e7b84,
+	0x207303,
+	0x318f45,
+	0x35d4c5,
+	0x55e12dc2,
+	0x36bb83,
+	0x56244302,
+	0x566cd782,
+	0x56a13082,
+	0x33f185,
+	0x331083,
+	0x264048,
+	0x56e07e02,
+	0x57201bc2,
+	0x2a5a46,
+	0x325c0a,
+	0x20d803,
+	0x239103,
+	0x2edd83,
+	0x57e03f02,
+	0x66207942,
+	0x66a0a302,
+	0x201242,
+	0x38d449,
+	0x2bcb44,
+	0x258f48,
+	0x66eea342,
+	0x67204102,
+	0x2e4805,
+	0x232d08,
+	0x24a508,
+	0x39e64c,
+	0x239cc3,
+	0x23bc82,
+	0x6760c402,
+	0x2c3c46,
+	0x2f6c05,
+	0x326903,
+	0x32b746,
+	0x2f6d46,
+	0x235d83,
+	0x2f8103,
+	0x2f8b46,
+	0x2f9904,
+	0x276a86,
+	0x2c7285,
+	0x2f9c8a,
+	0x233f84,
+	0x2faac4,
+	0x34da8a,
+	0x67a74b82,
+	0x271185,
+	0x2fcf4a,
+	0x2fdcc5,
+	0x2fe844,
+	0x2fe946,
+	0x2feac4,
+	0x228946,
+	0x67e00282,
+	0x237786,
+	0x238845,
+	0x201d07,
+	0x300fc6,
+	0x23d504,
+	0x2c8047,
+	0x32af06,
+	0x267e05,
+	0x267e07,
+	0x39b987,
+	0x39b98e,
+	0x2232c6,
+	0x32cb85,
+	0x283a47,
+	0x2f1c03,
+	0x366e07,
+	0x35ba05,
+	0x212644,
+	0x214382,
+	0x267207,
+	0x379104,
+	0x238bc4,
+	0x25a14b,
+	0x21fdc3,
+	0x286807,
+	0x21fdc4,
+	0x2a4c87,
+	0x22ac83,
+	0x32e98d,
+	0x38a888,
+	0x2511c4,
+	0x2511c5,
+	0x301705,
+	0x2ff303,
+	0x68214a02,
+	0x300a03,
+	0x301143,
+	0x399f04,
+	0x27e585,
+	0x21eec7,
+	0x213446,
+	0x36f643,
+	0x32b34b,
+	0x32f70b,
+	0x27338b,
+	0x27e68a,
+	0x2a55cb,
+	0x2caf0b,
+	0x2d33cc,
+	0x2f8711,
+	0x33d50a,
+	0x35030b,
+	0x37a40b,
+	0x3aef8a,
+	0x3b0f8a,
+	0x301b0d,
+	0x3032ce,
+	0x30438b,
+	0x30464a,
+	0x305811,
+	0x305c4a,
+	0x30614b,
+	0x30668e,
+	0x30720c,
+	0x3075cb,
+	0x30788e,
+	0x307c0c,
+	0x3094ca,
+	0x30a6cc,
+	0x6870a9ca,
+	0x30bbc9,
+	0x30dd0a,
+	0x30df8a,
+	0x30e20b,
+	0x31014e,
+	0x3104d1,
+	0x319509,
+	0x31974a,
+	0x31a00b,
+	0x31baca,
+	0x31c656,
+	0x31de0b,
+	0x3200ca,
+	0x320dca,
+	0x32528b,
+	0x329a09,
+	0x32f309,
+	0x33154d,
+	0x331dcb,
+	0x332b0b,
+	0x3334cb,
+	0x333c89,
+	0x3342ce,
+	0x3346ca,
+	0x335b0a,
+	0x33620a,
+	0x33698b,
+	0x3371cb,
+	0x33748d,
+	0x338b0d,
+	0x339950,
+	0x339e0b,
+	0x33b3cc,
+	0x33c04b,
+	0x33dc0b,
+	0x33fb8b,
+	0x34814b,
+	0x348bcf,
+	0x348f8b,
+	0x349bca,
+	0x34a2c9,
+	0x34a709,
+	0x34b0cb,
+	0x34b38e,
+	0x34e10b,
+	0x34eecf,
+	0x3512cb,
+	0x35158b,
+	0x35184b,
+	0x351c8a,
+	0x355889,
+	0x35898f,
+	0x36104c,
+	0x36150c,
+	0x36278e,
+	0x362fcf,
+	0x36338e,
+	0x363e90,
+	0x36428f,
+	0x36574e,
+	0x365c0c,
+	0x365f12,
+	0x367b11,
+	0x3680ce,
+	0x36850e,
+	0x368a4e,
+	0x368dcf,
+	0x36918e,
+	0x369513,
+	0x3699d1,
+	0x369e0e,
+	0x36a28c,
+	0x36ad53,
+	0x36b550,
+	0x36c18c,
+	0x36c48c,
+	0x36c94b,
+	0x36dfce,
+	0x36e64b,
+	0x36ea8b,
+	0x37090c,
+	0x37954a,
+	0x379c0c,
+	0x379f0c,
+	0x37a209,
+	0x37b60b,
+	0x37b8c8,
+	0x37bac9,
+	0x37bacf,
+	0x37d40b,
+	0x37e10a,
+	0x38154c,
+	0x383409,
+	0x3837c8,
+	0x384bcb,
+	0x3852cb,
+	0x38644a,
+	0x3866cb,
+	0x386c0c,
+	0x387948,
+	0x38aa8b,
+	0x38d14b,
+	0x39028b,
+	0x391b0b,
+	0x39b50b,
+	0x39b7c9,
+	0x39bd0d,
+	0x3a0f8a,
+	0x3a1ed7,
+	0x3a3b58,
+	0x3a8a09,
+	0x3a9c0b,
+	0x3aa3d4,
+	0x3aa8cb,
+	0x3aae4a,
+	0x3ab2ca,
+	0x3ab54b,
+	0x3ac450,
+	0x3ac851,
+	0x3ad10a,
+	0x3ae58d,
+	0x3aec8d,
+	0x3b134b,
+	0x3b2746,
+	0x2226c3,
+	0x68a5b343,
+	0x385d06,
+	0x28e605,
+	0x2d6487,
+	0x33d3c6,
+	0x1627342,
+	0x2ad589,
+	0x27a3c4,
+	0x2d0348,
+	0x245a03,
+	0x2e7ac7,
+	0x22eb82,
+	0x2acc83,
+	0x68e006c2,
+	0x2c2686,
+	0x2c36c4,
+	0x328a44,
+	0x23e083,
+	0x23e085,
+	0x696cd7c2,
+	0x2dba04,
+	0x2760c7,
+	0x1662e02,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x2020c3,
+	0x200882,
+	0x77a48,
+	0x206a82,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x20b803,
+	0x3152d6,
+	0x318493,
+	0x30c449,
+	0x324308,
+	0x3a05c9,
+	0x2fd0c6,
+	0x30d250,
+	0x303e13,
+	0x208888,
+	0x2407c7,
+	0x27c647,
+	0x29f48a,
+	0x2f9509,
+	0x34cf89,
+	0x290ccb,
+	0x340c06,
+	0x32324a,
+	0x222986,
+	0x279fc3,
+	0x2f1ec5,
+	0x226b48,
+	0x2365cd,
+	0x35988c,
+	0x238507,
+	0x304e0d,
+	0x2036c4,
+	0x22ff8a,
+	0x2308ca,
+	0x230d8a,
+	0x304107,
+	0x23cfc7,
+	0x23ff44,
+	0x22f246,
+	0x340fc4,
+	0x2f0448,
+	0x2aaa09,
+	0x2b9906,
+	0x2b9908,
+	0x24328d,
+	0x2c0a89,
+	0x204188,
+	0x25b147,
+	0x20150a,
+	0x24c286,
+	0x259f07,
+	0x2b1e04,
+	0x248087,
+	0x33a34a,
+	0x25958e,
+	0x210705,
+	0x2ff04b,
+	0x2f2509,
+	0x22dec9,
+	0x2a6347,
+	0x3a278a,
+	0x2b8007,
+	0x2f2b09,
+	0x359d48,
+	0x3141cb,
+	0x2cff85,
+	0x2e038a,
+	0x21ec49,
+	0x32688a,
+	0x2c384b,
+	0x247f8b,
+	0x290a55,
+	0x2d59c5,
+	0x25b1c5,
+	0x2e24ca,
+	0x2501ca,
+	0x376507,
+	0x220283,
+	0x29e308,
+	0x2cb24a,
+	0x3abc86,
+	0x241949,
+	0x26ae48,
+	0x2d9544,
+	0x235c49,
+	0x2b90c8,
+	0x2dcd87,
+	0x27dbc6,
+	0x2a2c87,
+	0x297b87,
+	0x23f985,
+	0x25388c,
+	0x2511c5,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x206a82,
+	0x22bf83,
+	0x24c083,
+	0x2020c3,
+	0x204703,
+	0x22bf83,
+	0x24c083,
+	0x222f43,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x206a82,
+	0x200e42,
+	0x230fc2,
+	0x207382,
+	0x203202,
+	0x2d3cc2,
+	0x462bf83,
+	0x231b03,
+	0x20f583,
+	0x250cc3,
+	0x202243,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x232dc3,
+	0x77a48,
+	0x329904,
+	0x25fe87,
+	0x262203,
+	0x3395c4,
+	0x22ea83,
+	0x286c03,
+	0x250cc3,
+	0x200882,
+	0x127883,
+	0x5606a82,
+	0x230fc2,
+	0x23c4,
+	0x200fc2,
+	0xe1c44,
+	0x77a48,
+	0x20e503,
+	0x2cd683,
+	0x5e2bf83,
+	0x22ff84,
+	0x6231b03,
+	0x6650cc3,
+	0x20b542,
+	0x2023c4,
+	0x24c083,
+	0x2f1d03,
+	0x2018c2,
+	0x204703,
+	0x21f0c2,
+	0x2e9943,
+	0x202942,
+	0x207703,
+	0x26af03,
+	0x201d02,
+	0x77a48,
+	0x20e503,
+	0x2f1d03,
+	0x2018c2,
+	0x2e9943,
+	0x202942,
+	0x207703,
+	0x26af03,
+	0x201d02,
+	0x2e9943,
+	0x202942,
+	0x207703,
+	0x26af03,
+	0x201d02,
+	0x22bf83,
+	0x327883,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x202243,
+	0x220ec3,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x209202,
+	0x21d603,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x327883,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x24c083,
+	0x204703,
+	0x2ebf05,
+	0x212dc2,
+	0x200882,
+	0x77a48,
+	0x250cc3,
+	0x260e41,
+	0x20bd81,
+	0x260e01,
+	0x20bb01,
+	0x275d81,
+	0x275e41,
+	0x262281,
+	0x24b581,
+	0x2f8901,
+	0x301dc1,
+	0x200141,
+	0x200001,
+	0x77a48,
+	0x200481,
+	0x200741,
+	0x200081,
+	0x201501,
+	0x2007c1,
+	0x200901,
+	0x200041,
+	0x202381,
+	0x2001c1,
+	0x2000c1,
+	0x200341,
+	0x200cc1,
+	0x200fc1,
+	0x200ac1,
+	0x213041,
+	0x200c01,
+	0x200241,
+	0x200a01,
+	0x2002c1,
+	0x200281,
+	0x201d01,
+	0x2041c1,
+	0x200781,
+	0x200641,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x200fc2,
+	0x204703,
+	0x142b87,
+	0x1c106,
+	0x18a4a,
+	0x89808,
+	0x51688,
+	0x51a47,
+	0x60f46,
+	0xcdfc5,
+	0x62145,
+	0x72606,
+	0x122706,
+	0x223504,
+	0x3212c7,
+	0x77a48,
+	0x2c8144,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x250cc3,
+	0x202243,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x212dc2,
+	0x2b6f03,
+	0x214583,
+	0x279643,
+	0x202a82,
+	0x249c83,
+	0x201e03,
+	0x2056c3,
+	0x200001,
+	0x2075c3,
+	0x276844,
+	0x3355c3,
+	0x30da43,
+	0x21eb83,
+	0x378d83,
+	0xa22bf83,
+	0x234a44,
+	0x21eb43,
+	0x22e0c3,
+	0x231b03,
+	0x231843,
+	0x211a83,
+	0x2a2383,
+	0x30d9c3,
+	0x226083,
+	0x2143c3,
+	0x24ce04,
+	0x23d6c2,
+	0x250f43,
+	0x2579c3,
+	0x279003,
+	0x260d83,
+	0x345903,
+	0x250cc3,
+	0x2e87c3,
+	0x2037c3,
+	0x2023c3,
+	0x249283,
+	0x35d7c3,
+	0x300b83,
+	0x387883,
+	0x200983,
+	0x232003,
+	0x220ec3,
+	0x21e782,
+	0x28a503,
+	0x24c083,
+	0x16020c3,
+	0x255bc3,
+	0x232943,
+	0x212c03,
+	0x204703,
+	0x20b103,
+	0x21d603,
+	0x23b303,
+	0x2f8183,
+	0x2e9b03,
+	0x303b85,
+	0x2298c3,
+	0x2e9b43,
+	0x2eb283,
+	0x2133c4,
+	0x25a903,
+	0x32be83,
+	0x277083,
+	0x232dc3,
+	0x212dc2,
+	0x239cc3,
+	0x2fb8c4,
+	0x238bc4,
+	0x24cd43,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x206a82,
+	0x204703,
+	0xb62bf83,
+	0x250cc3,
+	0x220ec3,
+	0x20dd02,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x6c2,
+	0x201142,
+	0x223342,
+	0x77a48,
+	0x6a82,
+	0x2338c2,
+	0x207082,
+	0x23eac2,
+	0x202402,
+	0x209142,
+	0x62145,
+	0x202702,
+	0x2018c2,
+	0x207902,
+	0x201702,
+	0x200ac2,
+	0x386d02,
+	0x203a02,
+	0x227d82,
+	0x117c0d,
+	0xed209,
+	0x4a30b,
+	0xd1d48,
+	0x472c9,
+	0x250cc3,
+	0x77a48,
+	0x77a48,
+	0x52946,
+	0x200882,
+	0x223504,
+	0x206a82,
+	0x22bf83,
+	0x200e42,
+	0x231b03,
+	0x20f582,
+	0x2c8144,
+	0x202243,
+	0x20ad82,
+	0x24c083,
+	0x200fc2,
+	0x204703,
+	0x25b1c6,
+	0x30e7cf,
+	0x701683,
+	0x77a48,
+	0x206a82,
+	0x20f583,
+	0x250cc3,
+	0x220ec3,
+	0x1479b8b,
+	0x206a82,
+	0x22bf83,
+	0x250cc3,
+	0x24c083,
+	0x200882,
+	0x206b82,
+	0x20a882,
+	0xea2bf83,
+	0x23e902,
+	0x231b03,
+	0x249242,
+	0x225902,
+	0x250cc3,
+	0x224242,
+	0x24b342,
+	0x24cd02,
+	0x204242,
+	0x28cf02,
+	0x205302,
+	0x200902,
+	0x210442,
+	0x20b882,
+	0x217e82,
+	0x2ad442,
+	0x23ba82,
+	0x312042,
+	0x24dcc2,
+	0x220ec3,
+	0x202ac2,
+	0x24c083,
+	0x243482,
+	0x273342,
+	0x204703,
+	0x249d02,
+	0x203682,
+	0x24ecc2,
+	0x201f82,
+	0x2198c2,
+	0x2d3382,
+	0x21bf02,
+	0x244302,
+	0x222742,
+	0x30464a,
+	0x349bca,
+	0x37f58a,
+	0x3b28c2,
+	0x20b642,
+	0x245d02,
+	0xeeaef89,
+	0xf26050a,
+	0xf42e107,
+	0xbac2,
+	0x6050a,
+	0x247204,
+	0xfe2bf83,
+	0x231b03,
+	0x248fc4,
+	0x250cc3,
+	0x2023c4,
+	0x202243,
+	0x220ec3,
+	0x24c083,
+	0x2020c3,
+	0x204703,
+	0x2298c3,
+	0x2232c3,
+	0x77a48,
+	0x1460ec4,
+	0x60745,
+	0x5f68a,
+	0x10a642,
+	0x17e606,
+	0x106aef89,
+	0x142d47,
+	0x1e02,
+	0x1ab7ca,
+	0xda987,
+	0x77a48,
+	0xfff08,
+	0xd8c7,
+	0x1181d10b,
+	0x3482,
+	0x1a0947,
+	0xdc0a,
+	0x19f20f,
+	0x124b4f,
+	0x1eb82,
+	0x6a82,
+	0xa22c8,
+	0xec94a,
+	0x143f48,
+	0x1582,
+	0x13564b,
+	0x16fcc8,
+	0x7f087,
+	0xdaa8a,
+	0x58a4b,
+	0x172cc9,
+	0x16fbc7,
+	0xf564c,
+	0xb587,
+	0xd0b0a,
+	0x14bcc8,
+	0xf20ce,
+	0x5360e,
+	0xda7cb,
+	0x17664b,
+	0xecf4b,
+	0x1c109,
+	0x1df4b,
+	0x22d8d,
+	0x24b0b,
+	0x277cd,
+	0x2b70d,
+	0x12c9ca,
+	0x38a0b,
+	0x5910b,
+	0x67505,
+	0x10b510,
+	0x14338f,
+	0xe37cf,
+	0x1e34d,
+	0x76650,
+	0x8b02,
+	0x11f24008,
+	0x142a08,
+	0x122e4205,
+	0x47d0b,
+	0x4f508,
+	0x17680a,
+	0x58189,
+	0x625c7,
+	0x62907,
+	0x62ac7,
+	0x656c7,
+	0x660c7,
+	0x663c7,
+	0x67807,
+	0x68687,
+	0x69007,
+	0x691c7,
+	0x6a487,
+	0x6a647,
+	0x6a807,
+	0x6a9c7,
+	0x6acc7,
+	0x6b347,
+	0x6c307,
+	0x6c8c7,
+	0x6d087,
+	0x6d807,
+	0x6d9c7,
+	0x6ddc7,
+	0x6e307,
+	0x6e507,
+	0x6e7c7,
+	0x6e987,
+	0x6eb47,
+	0x6f087,
+	0x6fa87,
+	0x70547,
+	0x72d47,
+	0x73007,
+	0x73647,
+	0x73807,
+	0x73b87,
+	0x749c7,
+	0x74c47,
+	0x75047,
+	0x758c7,
+	0x75a87,
+	0x75ec7,
+	0x76c07,
+	0x76f07,
+	0x77147,
+	0x77307,
+	0x77687,
+	0x78007,
+	0xd502,
+	0x44c4a,
+	0xf8407,
+	0x124c8a0b,
+	0x14c8a16,
+	0x1bb11,
+	0xdf0ca,
+	0xa214a,
+	0x52946,
+	0x18e90b,
+	0x10702,
+	0x184551,
+	0x99ac9,
+	0x92dc9,
+	0x10442,
+	0x9ec8a,
+	0xa3849,
+	0xa3f4f,
+	0xa48ce,
+	0xa5408,
+	0x134c2,
+	0x799c9,
+	0x1779ce,
+	0xac08c,
+	0xd438f,
+	0x194a8e,
+	0x1378c,
+	0x18549,
+	0x19451,
+	0x1ae88,
+	0x13ab12,
+	0x12bb8d,
+	0x2f10d,
+	0x398cb,
+	0x43755,
+	0x44b09,
+	0x4540a,
+	0x57b49,
+	0x5bb90,
+	0x6a1cb,
+	0x7be8f,
+	0x7ce4b,
+	0x8048c,
+	0x80e50,
+	0x85a0a,
+	0x8a3cd,
+	0x13f80e,
+	0x14aa0a,
+	0x8f30c,
+	0x97854,
+	0x99751,
+	0x9cc0b,
+	0x9de8f,
+	0xab18d,
+	0xaba0e,
+	0xdcc4c,
+	0x15eacc,
+	0xdc94b,
+	0xe8a4e,
+	0xeb550,
+	0x12e34b,
+	0x16a70d,
+	0xb48cf,
+	0xb83cc,
+	0xb978e,
+	0xb9f91,
+	0xbbd0c,
+	0x119e47,
+	0xc174d,
+	0xc60cc,
+	0xd5c50,
+	0xe608d,
+	0xfc987,
+	0xeecd0,
+	0xf3d88,
+	0xf494b,
+	0x16f84f,
+	0x15bd88,
+	0xdf2cd,
+	0x173dd0,
+	0xafec3,
+	0xac82,
+	0x2bb09,
+	0x5340a,
+	0xfb906,
+	0x128de7c9,
+	0x11e03,
+	0x10ad11,
+	0xccf47,
+	0xd36d0,
+	0xd3b8c,
+	0xd4d85,
+	0x1189c8,
+	0x19c9ca,
+	0x1976c7,
+	0x1042,
+	0x6184a,
+	0xe3b09,
+	0x34aca,
+	0x19ef8f,
+	0x4160b,
+	0x1283cc,
+	0x128692,
+	0xadd85,
+	0x161b4a,
+	0x12ee1545,
+	0x1132c3,
+	0x186d02,
+	0xe9e4a,
+	0xcfc88,
+	0x124ac7,
+	0x34c2,
+	0xed42,
+	0x2942,
+	0x1a7a10,
+	0x4042,
+	0x2e9cf,
+	0x72606,
+	0x176c8e,
+	0xd7c0b,
+	0x14ac08,
+	0xc9d49,
+	0x17cbd2,
+	0x404d,
+	0x496c8,
+	0x4a1c9,
+	0x4c40d,
+	0x4e7c9,
+	0x52a8b,
+	0x55d88,
+	0x5f4c8,
+	0x67f88,
+	0x68209,
+	0x6840a,
+	0x6898c,
+	0xea08a,
+	0xf81c7,
+	0x1684d,
+	0xed84b,
+	0x7a1cc,
+	0x65910,
+	0x1bc2,
+	0xd65cd,
+	0x3f02,
+	0x7942,
+	0xf810a,
+	0xdefca,
+	0xe79cb,
+	0x592cc,
+	0xffc8e,
+	0x199fcd,
+	0xf2f88,
+	0x6c2,
+	0x10b6778e,
+	0x10c2e107,
+	0x111ab089,
+	0x129c3,
+	0x1171b7cc,
+	0xbac2,
+	0x146151,
+	0x1676d1,
+	0x176fd1,
+	0x131111,
+	0x11b70f,
+	0x11fdcc,
+	0x124f4d,
+	0x15c8cd,
+	0x16da95,
+	0xbacc,
+	0x50b50,
+	0x1091cc,
+	0x10f6cc,
+	0x4f2c9,
+	0xbac2,
+	0x14620e,
+	0x16778e,
+	0x17708e,
+	0x1311ce,
+	0x11b7cc,
+	0x11fe89,
+	0xbb89,
+	0x50c0d,
+	0x109289,
+	0x10f789,
+	0x158543,
+	0x1892c3,
+	0xbac2,
+	0xd2d05,
+	0x1ab7c4,
+	0x135a04,
+	0x181444,
+	0x17e004,
+	0x17a784,
+	0x142d44,
+	0x1424703,
+	0x1416703,
+	0xf2b84,
+	0x8b02,
+	0x199fc3,
+	0x200882,
+	0x206a82,
+	0x200e42,
+	0x209342,
+	0x20f582,
+	0x200fc2,
+	0x202942,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c3,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x24c083,
+	0x204703,
+	0x39f83,
+	0x250cc3,
+	0x200882,
+	0x327883,
+	0x14a2bf83,
+	0x380d87,
+	0x250cc3,
+	0x39a883,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x24e9ca,
+	0x25b1c5,
+	0x21d603,
+	0x2012c2,
+	0x77a48,
+	0x77a48,
+	0x6a82,
+	0x110842,
+	0x1a0a85,
+	0x77a48,
+	0x2bf83,
+	0xf2447,
+	0xcd44f,
+	0xfb984,
+	0x172e4a,
+	0xabcc7,
+	0x18908a,
+	0x18ed8a,
+	0xfb906,
+	0x8a4d,
+	0x127883,
+	0x77a48,
+	0x6a82,
+	0x48fc4,
+	0x86d83,
+	0xebf05,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x201e03,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x292f83,
+	0x2232c3,
+	0x201e03,
+	0x223504,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22a543,
+	0x22bf83,
+	0x231b03,
+	0x210c43,
+	0x20f583,
+	0x250cc3,
+	0x2023c4,
+	0x265603,
+	0x232003,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x21d603,
+	0x200a43,
+	0x16e2bf83,
+	0x231b03,
+	0x245e83,
+	0x250cc3,
+	0x2805c3,
+	0x232003,
+	0x204703,
+	0x208583,
+	0x325ec4,
+	0x77a48,
+	0x1762bf83,
+	0x231b03,
+	0x2a54c3,
+	0x250cc3,
+	0x220ec3,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x220303,
+	0x77a48,
+	0x17e2bf83,
+	0x231b03,
+	0x20f583,
+	0x2020c3,
+	0x204703,
+	0x77a48,
+	0x142e107,
+	0x327883,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x175d04,
+	0x340dc5,
+	0x77a48,
+	0x742,
+	0x33303,
+	0x2cf588,
+	0x23ca87,
+	0x223504,
+	0x366b06,
+	0x36d946,
+	0x77a48,
+	0x23bd43,
+	0x2e31c9,
+	0x2b3f55,
+	0xb3f5f,
+	0x22bf83,
+	0x334fd2,
+	0x1011c6,
+	0x13b685,
+	0x17680a,
+	0x58189,
+	0x334d8f,
+	0x2c8144,
+	0x23c485,
+	0x35d590,
+	0x324507,
+	0x2020c3,
+	0x255bc8,
+	0x2d2d8a,
+	0x241204,
+	0x2e0f83,
+	0x25b1c6,
+	0x2012c2,
+	0x387d0b,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x2e8283,
+	0x206a82,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x39a883,
+	0x206f83,
+	0x204703,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x24c083,
+	0x204703,
+	0x200882,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x223504,
+	0x22bf83,
+	0x231b03,
+	0x30db04,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x2037c3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2614c3,
+	0x6ab03,
+	0x19a883,
+	0x24c083,
+	0x204703,
+	0x30464a,
+	0x31c409,
+	0x33e2cb,
+	0x33e94a,
+	0x349bca,
+	0x356a0b,
+	0x36f44a,
+	0x37954a,
+	0x37f58a,
+	0x37f80b,
+	0x39c709,
+	0x39e40a,
+	0x39e94b,
+	0x3aab8b,
+	0x3b0d4a,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x2625c4,
+	0x219242,
+	0x211004,
+	0x278b45,
+	0x201e03,
+	0x223504,
+	0x22bf83,
+	0x234a44,
+	0x231b03,
+	0x248fc4,
+	0x2c8144,
+	0x2023c4,
+	0x232003,
+	0x24c083,
+	0x204703,
+	0x297985,
+	0x22a543,
+	0x21d603,
+	0x25ad03,
+	0x2512c4,
+	0x260e04,
+	0x279645,
+	0x77a48,
+	0x2fa644,
+	0x221c46,
+	0x287e44,
+	0x206a82,
+	0x364707,
+	0x24b847,
+	0x246a04,
+	0x2569c5,
+	0x2e6285,
+	0x2a8e05,
+	0x2023c4,
+	0x316208,
+	0x2031c6,
+	0x2e6ec8,
+	0x23e385,
+	0x2cff85,
+	0x214984,
+	0x204703,
+	0x2e1c44,
+	0x355bc6,
+	0x25b2c3,
+	0x2512c4,
+	0x269bc5,
+	0x233504,
+	0x399e44,
+	0x2012c2,
+	0x24ec06,
+	0x392506,
+	0x2f6c05,
+	0x200882,
+	0x327883,
+	0x1d606a82,
+	0x233004,
+	0x20f582,
+	0x220ec3,
+	0x20b602,
+	0x24c083,
+	0x200fc2,
+	0x20b803,
+	0x2232c3,
+	0x77a48,
+	0x77a48,
+	0x250cc3,
+	0x200882,
+	0x1e206a82,
+	0x250cc3,
+	0x26a783,
+	0x265603,
+	0x31d184,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x200882,
+	0x1ea06a82,
+	0x22bf83,
+	0x24c083,
+	0x204703,
+	0x20f042,
+	0x212dc2,
+	0x39a883,
+	0x2d9f83,
+	0x200882,
+	0x77a48,
+	0x206a82,
+	0x231b03,
+	0x248fc4,
+	0x209d03,
+	0x250cc3,
+	0x2037c3,
+	0x220ec3,
+	0x24c083,
+	0x21a883,
+	0x204703,
+	0x220283,
+	0x125513,
+	0x134914,
+	0x145c6,
+	0x1c106,
+	0x514c7,
+	0x7a709,
+	0x141c0a,
+	0x896cd,
+	0x11790c,
+	0x17ef0a,
+	0x62145,
+	0x16d408,
+	0x72606,
+	0x122706,
+	0x208b02,
+	0x1ab987,
+	0x22bf83,
+	0xd0a85,
+	0x1bb06,
+	0x8d1ca,
+	0xacf83,
+	0x7a6c5,
+	0xd003,
+	0x18e9cc,
+	0x1ade48,
+	0x13f348,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x200882,
+	0x206a82,
+	0x250cc3,
+	0x20b542,
+	0x24c083,
+	0x204703,
+	0x20b803,
+	0x362fcf,
+	0x36338e,
+	0x77a48,
+	0x22bf83,
+	0x43f87,
+	0x231b03,
+	0x250cc3,
+	0x202243,
+	0x24c083,
+	0x204703,
+	0x21fc03,
+	0x264fc7,
+	0x201c42,
+	0x291d49,
+	0x200ec2,
+	0x3a7d0b,
+	0x28b2ca,
+	0x28cc09,
+	0x200d82,
+	0x261046,
+	0x254b15,
+	0x3a7e55,
+	0x257393,
+	0x3a83d3,
+	0x204a42,
+	0x20c905,
+	0x32178c,
+	0x21b24b,
+	0x2543c5,
+	0x20b0c2,
+	0x287302,
+	0x3747c6,
+	0x201e02,
+	0x25f986,
+	0x34be4d,
+	0x36fe4c,
+	0x30b584,
+	0x2009c2,
+	0x209ec2,
+	0x33aec8,
+	0x204a02,
+	0x32f986,
+	0x2d2944,
+	0x254cd5,
+	0x257513,
+	0x212903,
+	0x34b6ca,
+	0x35af47,
+	0x2e7c09,
+	0x229087,
+	0x305b42,
+	0x200002,
+	0x200006,
+	0x206e82,
+	0x77a48,
+	0x212742,
+	0x212bc2,
+	0x3994c7,
+	0x35bac7,
+	0x21f085,
+	0x203482,
+	0x220247,
+	0x220408,
+	0x23a242,
+	0x2715c2,
+	0x22ce42,
+	0x201482,
+	0x300cc8,
+	0x21a903,
+	0x286f48,
+	0x2c77cd,
+	0x214683,
+	0x2e3f48,
+	0x23218f,
+	0x23254e,
+	0x22338a,
+	0x299e51,
+	0x29a2d0,
+	0x2b2d0d,
+	0x2b304c,
+	0x20e607,
+	0x34b847,
+	0x366bc9,
+	0x245bc2,
+	0x2004c2,
+	0x252ecc,
+	0x2531cb,
+	0x2008c2,
+	0x2dcb06,
+	0x2092c2,
+	0x2036c2,
+	0x21eb82,
+	0x206a82,
+	0x3929c4,
+	0x23a547,
+	0x208942,
+	0x23fac7,
+	0x241007,
+	0x217442,
+	0x20e542,
+	0x243e45,
+	0x200682,
+	0x26794e,
+	0x27d94d,
+	0x231b03,
+	0x377f8e,
+	0x2dc3cd,
+	0x229343,
+	0x203982,
+	0x209f44,
+	0x245b82,
+	0x201502,
+	0x34a4c5,
+	0x351ac7,
+	0x36ed02,
+	0x209342,
+	0x248847,
+	0x24d248,
+	0x23d6c2,
+	0x2ade06,
+	0x252d4c,
+	0x25308b,
+	0x20db02,
+	0x25c18f,
+	0x25c550,
+	0x25c94f,
+	0x25cd15,
+	0x25d254,
+	0x25d74e,
+	0x25dace,
+	0x25de4f,
+	0x25e20e,
+	0x25e594,
+	0x25ea93,
+	0x25ef4d,
+	0x2781c9,
+	0x28a283,
+	0x2007c2,
+	0x31a605,
+	0x209d06,
+	0x20f582,
+	0x270387,
+	0x250cc3,
+	0x210702,
+	0x235e48,
+	0x29a091,
+	0x29a4d0,
+	0x200942,
+	0x21e747,
+	0x203fc2,
+	0x2cec87,
+	0x20ac82,
+	0x2cf389,
+	0x374787,
+	0x34aec8,
+	0x2261c6,
+	0x2d9e83,
+	0x322945,
+	0x231d82,
+	0x200402,
+	0x200405,
+	0x22aa05,
+	0x200f02,
+	0x233583,
+	0x233587,
+	0x200f07,
+	0x2013c2,
+	0x301344,
+	0x2025c3,
+	0x2bfb89,
+	0x2da648,
+	0x217382,
+	0x203702,
+	0x222047,
+	0x224605,
+	0x2a4248,
+	0x20c5c7,
+	0x201dc3,
+	0x2a1b86,
+	0x2b2b8d,
+	0x2b2f0c,
+	0x27e146,
+	0x207082,
+	0x206a42,
+	0x20f842,
+	0x23200f,
+	0x23240e,
+	0x2e6307,
+	0x200342,
+	0x30a2c5,
+	0x30a2c6,
+	0x250702,
+	0x202ac2,
+	0x215346,
+	0x291f83,
+	0x2cebc6,
+	0x2c1105,
+	0x2c110d,
+	0x2c1c55,
+	0x2c240c,
+	0x2c2c0d,
+	0x2c32d2,
+	0x203642,
+	0x208b82,
+	0x201842,
+	0x2e4f06,
+	0x2abf46,
+	0x201042,
+	0x209d86,
+	0x207902,
+	0x223d85,
+	0x203202,
+	0x267a89,
+	0x27074c,
+	0x270a8b,
+	0x200fc2,
+	0x24d688,
+	0x20cb42,
+	0x209242,
+	0x21b006,
+	0x3683c5,
+	0x21c307,
+	0x253b45,
+	0x299cc5,
+	0x244002,
+	0x322882,
+	0x200ac2,
+	0x27c187,
+	0x2d0e4d,
+	0x2d11cc,
+	0x275587,
+	0x20b142,
+	0x224742,
+	0x242988,
+	0x22bc88,
+	0x2d57c8,
+	0x2df284,
+	0x2e8cc7,
+	0x2db883,
+	0x2aff02,
+	0x20d102,
+	0x2dfa09,
+	0x3a4507,
+	0x208582,
+	0x273cc5,
+	0x242242,
+	0x22e1c2,
+	0x27b6c3,
+	0x27b6c6,
+	0x2e8282,
+	0x2e98c2,
+	0x200d42,
+	0x30c286,
+	0x209e87,
+	0x201442,
+	0x203942,
+	0x286d8f,
+	0x377dcd,
+	0x35914e,
+	0x2dc24c,
+	0x204742,
+	0x205fc2,
+	0x226005,
+	0x3b1146,
+	0x214442,
+	0x201002,
+	0x2034c2,
+	0x20c544,
+	0x2c7644,
+	0x338546,
+	0x202942,
+	0x27c9c7,
+	0x224d83,
+	0x226708,
+	0x228048,
+	0x32ba07,
+	0x22ed46,
+	0x202742,
+	0x238f03,
+	0x23ce07,
+	0x26e186,
+	0x2e4e45,
+	0x3497c8,
+	0x209642,
+	0x321e87,
+	0x20b702,
+	0x2eab42,
+	0x204002,
+	0x2df7c9,
+	0x200242,
+	0x200a02,
+	0x275803,
+	0x3a0007,
+	0x201f02,
+	0x2708cc,
+	0x270bcb,
+	0x27e1c6,
+	0x20cf45,
+	0x21c642,
+	0x205702,
+	0x2b2886,
+	0x26ba43,
+	0x357a07,
+	0x249842,
+	0x205a02,
+	0x254995,
+	0x3a8015,
+	0x257253,
+	0x3a8553,
+	0x269cc7,
+	0x277c08,
+	0x277c10,
+	0x278c4f,
+	0x28b093,
+	0x28c9d2,
+	0x291910,
+	0x2a248f,
+	0x2a8992,
+	0x2fcb11,
+	0x2f4bd3,
+	0x353a92,
+	0x320a0f,
+	0x2bb04e,
+	0x2c0c92,
+	0x2c8f51,
+	0x2cb48f,
+	0x2cc20e,
+	0x2cd9d1,
+	0x2fbb90,
+	0x2db252,
+	0x2df5d1,
+	0x2e5346,
+	0x2e6b07,
+	0x2f9347,
+	0x202c42,
+	0x281b85,
+	0x3471c7,
+	0x212dc2,
+	0x206d02,
+	0x229585,
+	0x2212c3,
+	0x2798c6,
+	0x2d100d,
+	0x2d134c,
+	0x201242,
+	0x32160b,
+	0x21b10a,
+	0x2eae8a,
+	0x2b1649,
+	0x2dde0b,
+	0x20c70d,
+	0x362b4c,
+	0x224f0a,
+	0x22a18c,
+	0x24470b,
+	0x33bc8c,
+	0x25424b,
+	0x2706c3,
+	0x277806,
+	0x2ce482,
+	0x2ea342,
+	0x221f83,
+	0x204102,
+	0x204c03,
+	0x2562c6,
+	0x25cec7,
+	0x26d686,
+	0x2ecb08,
+	0x22b988,
+	0x2f3906,
+	0x20c402,
+	0x2f65cd,
+	0x2f690c,
+	0x2c8207,
+	0x2fa507,
+	0x214602,
+	0x2342c2,
+	0x23cd82,
+	0x266dc2,
+	0x206a82,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x20b803,
+	0x200882,
+	0x200702,
+	0x21a8f085,
+	0x21e07f05,
+	0x2230bf46,
+	0x77a48,
+	0x226ae4c5,
+	0x206a82,
+	0x200e42,
+	0x22b41485,
+	0x22e80385,
+	0x23281247,
+	0x23615009,
+	0x23a58804,
+	0x20f582,
+	0x210702,
+	0x23f6ed45,
+	0x24291589,
+	0x24772b48,
+	0x24aac5c5,
+	0x24ebf307,
+	0x2521f7c8,
+	0x256d8045,
+	0x25a01c06,
+	0x25e71249,
+	0x263ac248,
+	0x266b9dc8,
+	0x26a981ca,
+	0x26e4a044,
+	0x272c96c5,
+	0x276b5708,
+	0x27a6b485,
+	0x21a982,
+	0x27e00343,
+	0x282a14c6,
+	0x28645248,
+	0x28a067c6,
+	0x28ece688,
+	0x29321ac6,
+	0x29731b84,
+	0x202b42,
+	0x29a3bf07,
+	0x29ea6f84,
+	0x2a27bc47,
+	0x2a713c87,
+	0x200fc2,
+	0x2aa98f45,
+	0x2af24244,
+	0x2b2fc647,
+	0x2b623747,
+	0x2ba85546,
+	0x2be5b885,
+	0x2c293d47,
+	0x2c6d5388,
+	0x2cb1a987,
+	0x2cf6ab09,
+	0x2d38e705,
+	0x2d75e547,
+	0x2da8e7c6,
+	0x2de61248,
+	0x33a60d,
+	0x244189,
+	0x2e430b,
+	0x24b38b,
+	0x275c0b,
+	0x2a3a4b,
+	0x30220b,
+	0x3024cb,
+	0x302d49,
+	0x3048cb,
+	0x304b8b,
+	0x3053cb,
+	0x305eca,
+	0x30640a,
+	0x306a0c,
+	0x309b0b,
+	0x30a44a,
+	0x3199ca,
+	0x32aa8e,
+	0x32dace,
+	0x32de4a,
+	0x33188a,
+	0x3323cb,
+	0x33268b,
+	0x33320b,
+	0x34e64b,
+	0x34ec4a,
+	0x34f90b,
+	0x34fbca,
+	0x34fe4a,
+	0x3500ca,
+	0x37110b,
+	0x37a88b,
+	0x37c1ce,
+	0x37c54b,
+	0x38618b,
+	0x3874cb,
+	0x38ad4a,
+	0x38afc9,
+	0x38b20a,
+	0x38c88a,
+	0x39d0cb,
+	0x39ec0b,
+	0x39fa8a,
+	0x3a120b,
+	0x3a728b,
+	0x3b078b,
+	0x2e283308,
+	0x2e688e49,
+	0x2eb61e09,
+	0x2eed0348,
+	0x3382c5,
+	0x207503,
+	0x202d44,
+	0x3996c5,
+	0x258546,
+	0x266545,
+	0x288644,
+	0x270288,
+	0x218d45,
+	0x290444,
+	0x205c87,
+	0x29c18a,
+	0x3411ca,
+	0x387307,
+	0x2078c7,
+	0x2f47c7,
+	0x327dc7,
+	0x2b6085,
+	0x323d06,
+	0x33f687,
+	0x26dcc4,
+	0x320686,
+	0x3a6bc6,
+	0x2017c5,
+	0x24fa04,
+	0x2c0406,
+	0x29b587,
+	0x32c646,
+	0x305107,
+	0x27f203,
+	0x24e386,
+	0x230705,
+	0x281347,
+	0x2bda0a,
+	0x235f44,
+	0x21b888,
+	0x2eb949,
+	0x2d1b07,
+	0x330c06,
+	0x327b88,
+	0x314009,
+	0x2396c4,
+	0x3619c4,
+	0x2fb3c5,
+	0x210f08,
+	0x2be207,
+	0x2a9209,
+	0x231548,
+	0x2feb46,
+	0x243586,
+	0x2965c8,
+	0x3739c6,
+	0x207f05,
+	0x285606,
+	0x27c348,
+	0x231f06,
+	0x251f0b,
+	0x2343c6,
+	0x297d4d,
+	0x3a26c5,
+	0x2a6e46,
+	0x2065c5,
+	0x29ae89,
+	0x32a347,
+	0x3825c8,
+	0x2d5046,
+	0x296cc9,
+	0x3a0486,
+	0x2bd985,
+	0x237446,
+	0x2a8406,
+	0x2c47c9,
+	0x2394c6,
+	0x248547,
+	0x2d9045,
+	0x203203,
+	0x252085,
+	0x298007,
+	0x327706,
+	0x3a25c9,
+	0x30bf46,
+	0x285846,
+	0x205149,
+	0x285009,
+	0x29f347,
+	0x322b08,
+	0x28dc89,
+	0x281808,
+	0x31ce86,
+	0x2cc985,
+	0x30b24a,
+	0x2858c6,
+	0x380c06,
+	0x2a1685,
+	0x3843c8,
+	0x2109c7,
+	0x22f98a,
+	0x249406,
+	0x2f9a85,
+	0x330e86,
+	0x263ec7,
+	0x330ac7,
+	0x2ef245,
+	0x2bdb45,
+	0x29f6c6,
+	0x2ad006,
+	0x383a06,
+	0x333b84,
+	0x2840c9,
+	0x289d06,
+	0x35104a,
+	0x21a588,
+	0x35e248,
+	0x3411ca,
+	0x3a3505,
+	0x29b4c5,
+	0x385b88,
+	0x2c9448,
+	0x36d747,
+	0x211206,
+	0x312e08,
+	0x2e4947,
+	0x2837c8,
+	0x36a5c6,
+	0x286148,
+	0x2b3406,
+	0x23e507,
+	0x297706,
+	0x2c0406,
+	0x233bca,
+	0x392a46,
+	0x2cc989,
+	0x2ae7c6,
+	0x2d224a,
+	0x331b89,
+	0x2f3a06,
+	0x37ac04,
+	0x31a6cd,
+	0x2890c7,
+	0x3157c6,
+	0x2b9c85,
+	0x3a0505,
+	0x31abc6,
+	0x274209,
+	0x2b1c47,
+	0x27d406,
+	0x2cd2c6,
+	0x2886c9,
+	0x2bf4c4,
+	0x22c784,
+	0x2073c8,
+	0x256686,
+	0x273d88,
+	0x2373c8,
+	0x282fc7,
+	0x200849,
+	0x383c07,
+	0x2ae38a,
+	0x236d8f,
+	0x2463ca,
+	0x225e05,
+	0x27c585,
+	0x21ac05,
+	0x2d2887,
+	0x20e243,
+	0x322d08,
+	0x2f7206,
+	0x2f7309,
+	0x2b0106,
+	0x2c3107,
+	0x296a89,
+	0x3824c8,
+	0x2a1747,
+	0x3015c3,
+	0x338345,
+	0x20e1c5,
+	0x3339cb,
+	0x26b544,
+	0x2d5f04,
+	0x27af06,
+	0x301947,
+	0x397d8a,
+	0x246c47,
+	0x239747,
+	0x280385,
+	0x2043c5,
+	0x2181c9,
+	0x2c0406,
+	0x246acd,
+	0x359c85,
+	0x302803,
+	0x2102c3,
+	0x30c385,
+	0x352545,
+	0x327b88,
+	0x27de47,
+	0x22c506,
+	0x29cf86,
+	0x229cc5,
+	0x231dc7,
+	0x207b47,
+	0x203087,
+	0x2c974a,
+	0x24e448,
+	0x333b84,
+	0x383fc7,
+	0x27f347,
+	0x332046,
+	0x269307,
+	0x2b2288,
+	0x361d08,
+	0x26fd46,
+	0x3450c8,
+	0x239544,
+	0x33f686,
+	0x39aa46,
+	0x375986,
+	0x30cd86,
+	0x22ef84,
+	0x327e86,
+	0x2b8c06,
+	0x295b86,
+	0x233bc6,
+	0x210186,
+	0x2aeec6,
+	0x22c408,
+	0x320508,
+	0x2ca248,
+	0x266748,
+	0x385b06,
+	0x213585,
+	0x27cb06,
+	0x2ac645,
+	0x38a187,
+	0x231605,
+	0x215a43,
+	0x207645,
+	0x22cd44,
+	0x2102c5,
+	0x21d8c3,
+	0x2fd4c7,
+	0x319c88,
+	0x3051c6,
+	0x2d600d,
+	0x27c546,
+	0x295045,
+	0x2bc283,
+	0x2b50c9,
+	0x2bf646,
+	0x295646,
+	0x29ec04,
+	0x246347,
+	0x233246,
+	0x384205,
+	0x233b83,
+	0x203f04,
+	0x27f506,
+	0x2b0944,
+	0x30eb08,
+	0x396f89,
+	0x32a849,
+	0x29ea0a,
+	0x310d8d,
+	0x32fe07,
+	0x380a86,
+	0x2124c4,
+	0x215009,
+	0x2877c8,
+	0x288cc6,
+	0x267d06,
+	0x269307,
+	0x2c2806,
+	0x225546,
+	0x3a3606,
+	0x313d0a,
+	0x21f7c8,
+	0x33aa05,
+	0x282d09,
+	0x283cca,
+	0x2d6388,
+	0x29abc8,
+	0x2955c8,
+	0x207f4c,
+	0x2e8945,
+	0x29d208,
+	0x30a1c6,
+	0x2d5646,
+	0x379787,
+	0x246b45,
+	0x285785,
+	0x32a709,
+	0x214c87,
+	0x2b2745,
+	0x229f87,
+	0x2102c3,
+	0x2beb45,
+	0x3aa1c8,
+	0x2d4047,
+	0x29aa89,
+	0x2d9545,
+	0x3074c4,
+	0x2a0288,
+	0x20e747,
+	0x2a1908,
+	0x34cdc8,
+	0x35e9c5,
+	0x23c806,
+	0x252586,
+	0x2e5e89,
+	0x313687,
+	0x2aca46,
+	0x20b247,
+	0x215403,
+	0x258804,
+	0x29c8c5,
+	0x2589c4,
+	0x360104,
+	0x283587,
+	0x209507,
+	0x22f744,
+	0x29a8d0,
+	0x326fc7,
+	0x2043c5,
+	0x2e95cc,
+	0x2b6084,
+	0x2c6588,
+	0x23e409,
+	0x302086,
+	0x33f488,
+	0x240544,
+	0x240548,
+	0x384946,
+	0x32d148,
+	0x29c5c6,
+	0x2c84cb,
+	0x204a85,
+	0x2c4308,
+	0x21a084,
+	0x284c8a,
+	0x29aa89,
+	0x2e0286,
+	0x2d8b48,
+	0x257a45,
+	0x2fdac4,
+	0x2c6486,
+	0x202f48,
+	0x283308,
+	0x349546,
+	0x37ff84,
+	0x30b1c6,
+	0x383c87,
+	0x27bb47,
+	0x26930f,
+	0x208607,
+	0x2f3ac7,
+	0x2d5505,
+	0x2efcc5,
+	0x29f009,
+	0x272346,
+	0x281f05,
+	0x285307,
+	0x2d8e08,
+	0x295c85,
+	0x297706,
+	0x21a3c8,
+	0x2067ca,
+	0x215448,
+	0x3acd07,
+	0x2371c6,
+	0x282cc6,
+	0x20e003,
+	0x20fa43,
+	0x283e89,
+	0x28db09,
+	0x2c6386,
+	0x2d9545,
+	0x2a7008,
+	0x2d8b48,
+	0x2ba5c8,
+	0x3a368b,
+	0x2d6247,
+	0x2ff489,
+	0x269588,
+	0x33c444,
+	0x2c48c8,
+	0x28c489,
+	0x2acd45,
+	0x2d2787,
+	0x2f7805,
+	0x283208,
+	0x28ef0b,
+	0x293a90,
+	0x2a6c45,
+	0x219fcc,
+	0x22c6c5,
+	0x207203,
+	0x2a7d46,
+	0x2b7204,
+	0x3397c6,
+	0x29b587,
+	0x215444,
+	0x2422c8,
+	0x322bcd,
+	0x2d8a05,
+	0x298f84,
+	0x218404,
+	0x282789,
+	0x2a4e08,
+	0x30bdc7,
+	0x3849c8,
+	0x284188,
+	0x27d705,
+	0x342607,
+	0x27d687,
+	0x2e2f87,
+	0x2bdb49,
+	0x2330c9,
+	0x23fc46,
+	0x2b3246,
+	0x269546,
+	0x26c105,
+	0x3b0044,
+	0x201b06,
+	0x203c86,
+	0x27d748,
+	0x263b8b,
+	0x26b987,
+	0x2124c4,
+	0x315c46,
+	0x207047,
+	0x2aeac5,
+	0x316dc5,
+	0x20f484,
+	0x233046,
+	0x201b88,
+	0x215009,
+	0x248446,
+	0x287148,
+	0x3842c6,
+	0x332908,
+	0x3ae14c,
+	0x27d5c6,
+	0x294d0d,
+	0x29518b,
+	0x248605,
+	0x207c87,
+	0x2395c6,
+	0x330988,
+	0x23fcc9,
+	0x2e5ac8,
+	0x2043c5,
+	0x2f0807,
+	0x281908,
+	0x366909,
+	0x23c0c6,
+	0x24834a,
+	0x330708,
+	0x2e590b,
+	0x2c6dcc,
+	0x240648,
+	0x27ec46,
+	0x342008,
+	0x208747,
+	0x233349,
+	0x29148d,
+	0x29ba46,
+	0x3a6cc8,
+	0x3203c9,
+	0x2b5ac8,
+	0x286248,
+	0x2b94cc,
+	0x2ba7c7,
+	0x2bb3c7,
+	0x2bd985,
+	0x2ee287,
+	0x2d8cc8,
+	0x2c6506,
+	0x256acc,
+	0x2e6808,
+	0x2c5808,
+	0x266a06,
+	0x20df47,
+	0x23fe44,
+	0x266748,
+	0x2dc68c,
+	0x21c68c,
+	0x225e85,
+	0x393e07,
+	0x37ff06,
+	0x20dec6,
+	0x29b048,
+	0x3a4984,
+	0x32c64b,
+	0x2263cb,
+	0x2371c6,
+	0x322a47,
+	0x328b05,
+	0x273145,
+	0x32c786,
+	0x257a05,
+	0x26b505,
+	0x379a47,
+	0x27b509,
+	0x2344c4,
+	0x3621c5,
+	0x2d71c5,
+	0x25b748,
+	0x376005,
+	0x2a7849,
+	0x370587,
+	0x37058b,
+	0x2d1546,
+	0x22c149,
+	0x24f948,
+	0x280d45,
+	0x2e3088,
+	0x233108,
+	0x211807,
+	0x282b87,
+	0x283609,
+	0x320447,
+	0x38cf09,
+	0x2aa34c,
+	0x36aa08,
+	0x2b5ec9,
+	0x2b8247,
+	0x284249,
+	0x209647,
+	0x2c6ec8,
+	0x25ba85,
+	0x33f606,
+	0x2b9cc8,
+	0x2d6a88,
+	0x283b89,
+	0x26b547,
+	0x273205,
+	0x216c49,
+	0x28e046,
+	0x28e7c4,
+	0x2e5786,
+	0x2450c8,
+	0x248e07,
+	0x263d88,
+	0x345189,
+	0x364f87,
+	0x29c346,
+	0x207d44,
+	0x2076c9,
+	0x342488,
+	0x2668c7,
+	0x323e06,
+	0x20e286,
+	0x380b84,
+	0x326186,
+	0x210243,
+	0x2cf889,
+	0x204a46,
+	0x2a4485,
+	0x29cf86,
+	0x2a1a45,
+	0x281d88,
+	0x240387,
+	0x35b546,
+	0x3414c6,
+	0x35e248,
+	0x29f187,
+	0x29ba85,
+	0x29d488,
+	0x38cc48,
+	0x330708,
+	0x22c585,
+	0x33f686,
+	0x32a609,
+	0x252404,
+	0x373acb,
+	0x22524b,
+	0x33a909,
+	0x2102c3,
+	0x2546c5,
+	0x20ff46,
+	0x267608,
+	0x236d04,
+	0x3051c6,
+	0x2c9889,
+	0x2c6845,
+	0x379986,
+	0x20e746,
+	0x211184,
+	0x2a064a,
+	0x2a43c8,
+	0x2d6a86,
+	0x329185,
+	0x20d707,
+	0x378e07,
+	0x23c804,
+	0x225487,
+	0x2315c4,
+	0x2315c6,
+	0x21a503,
+	0x2bdb45,
+	0x36fb05,
+	0x20eac8,
+	0x258905,
+	0x27d309,
+	0x266587,
+	0x26658b,
+	0x2a12cc,
+	0x2a1eca,
+	0x2bf307,
+	0x202003,
+	0x2e6408,
+	0x22c745,
+	0x295d05,
+	0x338404,
+	0x2c6dc6,
+	0x23e406,
+	0x3261c7,
+	0x39998b,
+	0x22ef84,
+	0x382744,
+	0x26fec4,
+	0x2c4046,
+	0x215444,
+	0x211008,
+	0x338205,
+	0x29d545,
+	0x2ba507,
+	0x207d89,
+	0x352545,
+	0x31abca,
+	0x2d8f49,
+	0x2a104a,
+	0x313e49,
+	0x39cc04,
+	0x2cd385,
+	0x2c2908,
+	0x2fc70b,
+	0x2fb3c5,
+	0x237546,
+	0x214904,
+	0x27d846,
+	0x364e09,
+	0x315d07,
+	0x30c108,
+	0x311106,
+	0x383c07,
+	0x283308,
+	0x38fbc6,
+	0x244a44,
+	0x3636c7,
+	0x34a905,
+	0x350a47,
+	0x201c04,
+	0x239546,
+	0x21fa48,
+	0x295348,
+	0x2ee007,
+	0x378288,
+	0x2b34c5,
+	0x210104,
+	0x3410c8,
+	0x3321c4,
+	0x211805,
+	0x2efe04,
+	0x2e4a47,
+	0x289dc7,
+	0x284388,
+	0x2a1a86,
+	0x258885,
+	0x27d108,
+	0x215648,
+	0x29e949,
+	0x225546,
+	0x22fa08,
+	0x284b0a,
+	0x2aeb48,
+	0x2d8045,
+	0x27cd06,
+	0x2740c8,
+	0x2f08ca,
+	0x24fb47,
+	0x287bc5,
+	0x294288,
+	0x2ad9c4,
+	0x384446,
+	0x2bbb48,
+	0x210186,
+	0x264308,
+	0x252247,
+	0x205b86,
+	0x37ac04,
+	0x37e907,
+	0x2fd904,
+	0x364dc7,
+	0x33924d,
+	0x288b05,
+	0x2d3e4b,
+	0x29c6c6,
+	0x24d788,
+	0x242284,
+	0x278906,
+	0x27f506,
+	0x342347,
+	0x2949cd,
+	0x2ab007,
+	0x302748,
+	0x24c9c5,
+	0x288248,
+	0x2be186,
+	0x2b3548,
+	0x217086,
+	0x3448c7,
+	0x345349,
+	0x33ff07,
+	0x288f88,
+	0x276005,
+	0x21f108,
+	0x20de05,
+	0x242b45,
+	0x35a545,
+	0x226103,
+	0x285684,
+	0x282d05,
+	0x271249,
+	0x2ffa06,
+	0x2b2388,
+	0x24bc05,
+	0x32e087,
+	0x24ba0a,
+	0x3798c9,
+	0x2a830a,
+	0x2ca2c8,
+	0x229dcc,
+	0x28538d,
+	0x334603,
+	0x264208,
+	0x203ec5,
+	0x206586,
+	0x382346,
+	0x2d7b45,
+	0x20b349,
+	0x264745,
+	0x27d108,
+	0x255ac6,
+	0x33cac6,
+	0x2a0149,
+	0x38f3c7,
+	0x28f1c6,
+	0x24b988,
+	0x375888,
+	0x2d0547,
+	0x32d2ce,
+	0x2be3c5,
+	0x366805,
+	0x210088,
+	0x3978c7,
+	0x20ca82,
+	0x2b9044,
+	0x3396ca,
+	0x266988,
+	0x207146,
+	0x296bc8,
+	0x252586,
+	0x323708,
+	0x2aca48,
+	0x242b04,
+	0x333805,
+	0x687e44,
+	0x687e44,
+	0x687e44,
+	0x204b03,
+	0x20e106,
+	0x27d5c6,
+	0x29bd0c,
+	0x205243,
+	0x283cc6,
+	0x21a4c4,
+	0x2bf5c8,
+	0x2c96c5,
+	0x3397c6,
+	0x2b5808,
+	0x2cb1c6,
+	0x35b4c6,
+	0x327988,
+	0x29c947,
+	0x32ce49,
+	0x306f8a,
+	0x264944,
+	0x231605,
+	0x2a91c5,
+	0x214e06,
+	0x32fe46,
+	0x2a7406,
+	0x2eeb86,
+	0x32cf84,
+	0x32cf8b,
+	0x2313c4,
+	0x20d785,
+	0x2ab905,
+	0x283086,
+	0x3b0588,
+	0x285247,
+	0x30bec4,
+	0x259b83,
+	0x2ad4c5,
+	0x2e5647,
+	0x2a2849,
+	0x28514b,
+	0x3261c7,
+	0x20e9c7,
+	0x2b5708,
+	0x32e1c7,
+	0x2a2a86,
+	0x244448,
+	0x2a584b,
+	0x399606,
+	0x217549,
+	0x2a59c5,
+	0x3015c3,
+	0x379986,
+	0x252148,
+	0x214ec3,
+	0x21cf03,
+	0x283306,
+	0x252586,
+	0x38c60a,
+	0x27ec85,
+	0x27f34b,
+	0x29cecb,
+	0x2417c3,
+	0x21fe03,
+	0x2ae304,
+	0x344d07,
+	0x240644,
+	0x207f44,
+	0x30a044,
+	0x2aee48,
+	0x3290c8,
+	0x35ad89,
+	0x38e788,
+	0x271407,
+	0x233bc6,
+	0x2b1fcf,
+	0x2be506,
+	0x2c9644,
+	0x328f0a,
+	0x2e5547,
+	0x201846,
+	0x28e809,
+	0x35ad05,
+	0x20ec05,
+	0x35ae46,
+	0x21f243,
+	0x2ada09,
+	0x21f946,
+	0x344f49,
+	0x397d86,
+	0x2bdb45,
+	0x226285,
+	0x208603,
+	0x344e48,
+	0x3a5ac7,
+	0x2f7204,
+	0x2bf448,
+	0x2c0184,
+	0x2c5686,
+	0x2a7d46,
+	0x23ec06,
+	0x2c41c9,
+	0x295c85,
+	0x2c0406,
+	0x2697c9,
+	0x3ad4c6,
+	0x2aeec6,
+	0x3895c6,
+	0x215105,
+	0x2efe06,
+	0x3448c4,
+	0x25ba85,
+	0x2b9cc4,
+	0x309f46,
+	0x359c44,
+	0x202c43,
+	0x287885,
+	0x232e08,
+	0x22b547,
+	0x2b3dc9,
+	0x287ac8,
+	0x296391,
+	0x20e7ca,
+	0x237107,
+	0x2bc046,
+	0x21a4c4,
+	0x2b9dc8,
+	0x22f488,
+	0x29654a,
+	0x2a760d,
+	0x237446,
+	0x327a86,
+	0x37e9c6,
+	0x2ef0c7,
+	0x302805,
+	0x261107,
+	0x2bf505,
+	0x3706c4,
+	0x2a5286,
+	0x326007,
+	0x2ad70d,
+	0x274007,
+	0x270188,
+	0x27d409,
+	0x27cc06,
+	0x23c045,
+	0x21d904,
+	0x2451c6,
+	0x23c706,
+	0x266b06,
+	0x299108,
+	0x215d03,
+	0x210603,
+	0x323ac5,
+	0x376b86,
+	0x2aca05,
+	0x311308,
+	0x29b74a,
+	0x2ce804,
+	0x2bf5c8,
+	0x2955c8,
+	0x282ec7,
+	0x24bcc9,
+	0x2b5408,
+	0x215087,
+	0x269ec6,
+	0x21018a,
+	0x245248,
+	0x2c6c09,
+	0x2a4ec8,
+	0x221649,
+	0x2e5bc7,
+	0x349945,
+	0x361f86,
+	0x2c6388,
+	0x24d908,
+	0x28eb48,
+	0x21acc8,
+	0x20d785,
+	0x200884,
+	0x3a57c8,
+	0x201944,
+	0x313c44,
+	0x2bdb45,
+	0x290487,
+	0x207b49,
+	0x342147,
+	0x2051c5,
+	0x27b106,
+	0x33f0c6,
+	0x206944,
+	0x2a0486,
+	0x383f44,
+	0x288146,
+	0x3a4a46,
+	0x219206,
+	0x2043c5,
+	0x3111c7,
+	0x202003,
+	0x3670c9,
+	0x35e048,
+	0x214f04,
+	0x214f0d,
+	0x295448,
+	0x2f3f48,
+	0x2c6b86,
+	0x345449,
+	0x3798c9,
+	0x364b05,
+	0x29b84a,
+	0x28224a,
+	0x289f8c,
+	0x28a106,
+	0x27b9c6,
+	0x2bea86,
+	0x26db09,
+	0x2067c6,
+	0x261146,
+	0x264806,
+	0x266748,
+	0x215446,
+	0x2c450b,
+	0x290605,
+	0x29d545,
+	0x27bc45,
+	0x202106,
+	0x210143,
+	0x23eb86,
+	0x273f87,
+	0x2b9c85,
+	0x243645,
+	0x3a0505,
+	0x335f46,
+	0x31abc4,
+	0x372a46,
+	0x299489,
+	0x201f8c,
+	0x370408,
+	0x202ec4,
+	0x2efbc6,
+	0x29c7c6,
+	0x252148,
+	0x2d8b48,
+	0x201e89,
+	0x20d707,
+	0x2563c9,
+	0x24cf86,
+	0x22cf44,
+	0x20f184,
+	0x288bc4,
+	0x283308,
+	0x20798a,
+	0x3524c6,
+	0x356387,
+	0x236087,
+	0x22c245,
+	0x2a9184,
+	0x28c446,
+	0x302846,
+	0x233303,
+	0x35de87,
+	0x34ccc8,
+	0x364c4a,
+	0x2cbb88,
+	0x2ce688,
+	0x359c85,
+	0x248705,
+	0x26ba85,
+	0x22c606,
+	0x37ed86,
+	0x209445,
+	0x2cfac9,
+	0x2a8f8c,
+	0x26bb47,
+	0x2965c8,
+	0x257d45,
+	0x687e44,
+	0x247884,
+	0x2d4184,
+	0x2c1606,
+	0x29da4e,
+	0x20ec87,
+	0x2edfc5,
+	0x25238c,
+	0x2c0047,
+	0x325f87,
+	0x35bf49,
+	0x21b949,
+	0x287bc5,
+	0x35e048,
+	0x32a609,
+	0x2f2ec5,
+	0x2b9bc8,
+	0x2c4ac6,
+	0x341346,
+	0x331b84,
+	0x2a46c8,
+	0x249e83,
+	0x342c84,
+	0x2ad545,
+	0x335407,
+	0x20f4c5,
+	0x2849c9,
+	0x28d60d,
+	0x2a6246,
+	0x32c004,
+	0x211188,
+	0x27b34a,
+	0x20cb87,
+	0x239d85,
+	0x206d03,
+	0x29d08e,
+	0x25258c,
+	0x2fb707,
+	0x29dc07,
+	0x201c43,
+	0x206805,
+	0x2d4185,
+	0x296f88,
+	0x2940c9,
+	0x202dc6,
+	0x240644,
+	0x237046,
+	0x37564b,
+	0x3a0b8c,
+	0x341ec7,
+	0x2c9385,
+	0x38cb48,
+	0x2d0305,
+	0x328f07,
+	0x23bf07,
+	0x249e85,
+	0x210143,
+	0x2af184,
+	0x20f945,
+	0x2ad0c5,
+	0x2ad0c6,
+	0x2927c8,
+	0x326007,
+	0x382646,
+	0x206486,
+	0x35a486,
+	0x263a09,
+	0x342707,
+	0x202c46,
+	0x3a0d06,
+	0x249f46,
+	0x2a6f45,
+	0x20aa06,
+	0x399385,
+	0x376088,
+	0x2936cb,
+	0x28c246,
+	0x2360c4,
+	0x2f0689,
+	0x266584,
+	0x2c4a48,
+	0x2961c7,
+	0x286144,
+	0x2b4708,
+	0x2bad84,
+	0x2a6f84,
+	0x2889c5,
+	0x2d8a46,
+	0x2aed87,
+	0x2643c3,
+	0x29c405,
+	0x2f7784,
+	0x366846,
+	0x364b88,
+	0x327885,
+	0x28ff09,
+	0x216e45,
+	0x2e2b48,
+	0x263747,
+	0x38a2c8,
+	0x2b3c07,
+	0x2f3b89,
+	0x327d06,
+	0x36bd06,
+	0x264804,
+	0x269e05,
+	0x2f5e4c,
+	0x27bc47,
+	0x27c447,
+	0x235f48,
+	0x2a6246,
+	0x273ec4,
+	0x2ead84,
+	0x283489,
+	0x2beb86,
+	0x218247,
+	0x30cd04,
+	0x2ffb06,
+	0x325b85,
+	0x2a15c7,
+	0x2c4486,
+	0x248209,
+	0x282087,
+	0x269307,
+	0x29ffc6,
+	0x310c85,
+	0x280a08,
+	0x21f7c8,
+	0x23d906,
+	0x3278c5,
+	0x261c86,
+	0x205d03,
+	0x296e09,
+	0x2a718e,
+	0x2b2a08,
+	0x2c0288,
+	0x23d70b,
+	0x290146,
+	0x321ac4,
+	0x284f84,
+	0x2a728a,
+	0x219ec7,
+	0x202d05,
+	0x217549,
+	0x2b8cc5,
+	0x313c87,
+	0x301e84,
+	0x397107,
+	0x2372c8,
+	0x2d1bc6,
+	0x3a6e49,
+	0x2b550a,
+	0x219e46,
+	0x294f86,
+	0x2ab885,
+	0x37cb05,
+	0x357f47,
+	0x2456c8,
+	0x325ac8,
+	0x242b06,
+	0x226305,
+	0x32fbce,
+	0x333b84,
+	0x23d885,
+	0x27aa89,
+	0x272148,
+	0x3acc46,
+	0x298d8c,
+	0x29b350,
+	0x29d68f,
+	0x29ef08,
+	0x2bf307,
+	0x2043c5,
+	0x282d05,
+	0x2aec09,
+	0x294489,
+	0x30b2c6,
+	0x2fb447,
+	0x393d85,
+	0x36d749,
+	0x3320c6,
+	0x20660d,
+	0x288a89,
+	0x207f44,
+	0x2b2788,
+	0x3a5889,
+	0x352686,
+	0x27b205,
+	0x36bd06,
+	0x30bfc9,
+	0x2381c8,
+	0x213585,
+	0x284c04,
+	0x298f4b,
+	0x352545,
+	0x267686,
+	0x2856c6,
+	0x26b006,
+	0x3a388b,
+	0x290009,
+	0x209785,
+	0x38a087,
+	0x20e746,
+	0x339506,
+	0x284888,
+	0x269fc9,
+	0x26ff4c,
+	0x2e5448,
+	0x352786,
+	0x349543,
+	0x2d2986,
+	0x2829c5,
+	0x27f688,
+	0x225d06,
+	0x2a1808,
+	0x246cc5,
+	0x215185,
+	0x2a0848,
+	0x378f47,
+	0x382287,
+	0x3261c7,
+	0x33f488,
+	0x28e9c8,
+	0x24de06,
+	0x309d87,
+	0x2586c7,
+	0x28288a,
+	0x24ce83,
+	0x202106,
+	0x203005,
+	0x324244,
+	0x27d409,
+	0x2f3b04,
+	0x22b5c4,
+	0x29c644,
+	0x29dc0b,
+	0x3a5a07,
+	0x32fe05,
+	0x293548,
+	0x27b106,
+	0x27b108,
+	0x27ebc6,
+	0x28adc5,
+	0x28b545,
+	0x28d046,
+	0x28e488,
+	0x28e748,
+	0x27d5c6,
+	0x29338f,
+	0x2968d0,
+	0x3a26c5,
+	0x202003,
+	0x24c905,
+	0x2ff3c8,
+	0x294389,
+	0x330708,
+	0x263888,
+	0x380648,
+	0x3a5ac7,
+	0x27adc9,
+	0x2a1a08,
+	0x2b0684,
+	0x29c4c8,
+	0x25b809,
+	0x30b8c7,
+	0x298144,
+	0x342208,
+	0x310f8a,
+	0x2c3ec6,
+	0x237446,
+	0x225409,
+	0x29b587,
+	0x2c4e48,
+	0x209fc8,
+	0x30cb88,
+	0x355ec5,
+	0x37da85,
+	0x29d545,
+	0x2d4145,
+	0x2f1807,
+	0x210145,
+	0x2b9c85,
+	0x212ec6,
+	0x330647,
+	0x2fc647,
+	0x311286,
+	0x2ca805,
+	0x267686,
+	0x240405,
+	0x2bfec8,
+	0x2ff984,
+	0x3ad546,
+	0x2e7dc4,
+	0x2fdac8,
+	0x3ad64a,
+	0x27de4c,
+	0x399b85,
+	0x2ef186,
+	0x270106,
+	0x34c5c6,
+	0x2ff5c4,
+	0x325e45,
+	0x27ea07,
+	0x29b609,
+	0x2a2947,
+	0x687e44,
+	0x687e44,
+	0x30bd45,
+	0x229144,
+	0x29874a,
+	0x27af86,
+	0x2e5884,
+	0x2017c5,
+	0x2eb445,
+	0x302744,
+	0x285307,
+	0x216dc7,
+	0x2c4048,
+	0x317048,
+	0x213589,
+	0x3321c8,
+	0x29890b,
+	0x214e04,
+	0x361905,
+	0x281f85,
+	0x326149,
+	0x269fc9,
+	0x2f0588,
+	0x2313c8,
+	0x283084,
+	0x29c805,
+	0x207503,
+	0x214dc5,
+	0x2c0486,
+	0x293f0c,
+	0x21f846,
+	0x240446,
+	0x2940c5,
+	0x335fc8,
+	0x3a0e06,
+	0x2bc1c6,
+	0x237446,
+	0x22d6cc,
+	0x266cc4,
+	0x35a5ca,
+	0x3ace08,
+	0x293d47,
+	0x244946,
+	0x202e87,
+	0x2e0845,
+	0x323e06,
+	0x354906,
+	0x382147,
+	0x22b604,
+	0x2e4b45,
+	0x27aa84,
+	0x370747,
+	0x27acc8,
+	0x27b84a,
+	0x281787,
+	0x23da87,
+	0x2bf287,
+	0x2d0449,
+	0x293f0a,
+	0x22cf03,
+	0x22b505,
+	0x219243,
+	0x30a089,
+	0x2f1108,
+	0x2d5507,
+	0x330809,
+	0x21f8c6,
+	0x2b0208,
+	0x2fd445,
+	0x21574a,
+	0x326dc9,
+	0x26fc09,
+	0x379787,
+	0x22f589,
+	0x219108,
+	0x2eff86,
+	0x2ef348,
+	0x215e47,
+	0x320447,
+	0x2d8f47,
+	0x2d5388,
+	0x2efa46,
+	0x310d45,
+	0x27ea07,
+	0x294a88,
+	0x35a404,
+	0x350f04,
+	0x28f0c7,
+	0x2acdc7,
+	0x32a48a,
+	0x2eff06,
+	0x2fb58a,
+	0x2b8f87,
+	0x333947,
+	0x242c04,
+	0x38cfc4,
+	0x227a06,
+	0x264d04,
+	0x264d0c,
+	0x3b1f45,
+	0x21ab09,
+	0x2e2cc4,
+	0x302805,
+	0x27b2c8,
+	0x28e805,
+	0x31abc6,
+	0x2115c4,
+	0x2a0a8a,
+	0x2b0846,
+	0x29574a,
+	0x31a987,
+	0x263ec5,
+	0x21f245,
+	0x22c28a,
+	0x2a0585,
+	0x29ea06,
+	0x201944,
+	0x2ae486,
+	0x358005,
+	0x225dc6,
+	0x2ee00c,
+	0x2c4fca,
+	0x269ec4,
+	0x233bc6,
+	0x29b587,
+	0x2c8984,
+	0x266748,
+	0x38e606,
+	0x32fa49,
+	0x2c5ec9,
+	0x36ab09,
+	0x373c86,
+	0x215f46,
+	0x2ef487,
+	0x2cfa08,
+	0x215d49,
+	0x3a5a07,
+	0x2b3346,
+	0x383c87,
+	0x37e885,
+	0x333b84,
+	0x2ef047,
+	0x2f7805,
+	0x288905,
+	0x300687,
+	0x249d48,
+	0x38cac6,
+	0x2959cd,
+	0x29718f,
+	0x29cecd,
+	0x205204,
+	0x232f06,
+	0x2cbec8,
+	0x2647c5,
+	0x282a48,
+	0x2116ca,
+	0x207f44,
+	0x3a7006,
+	0x39f8c7,
+	0x22ef87,
+	0x29ca09,
+	0x2ef305,
+	0x302744,
+	0x33374a,
+	0x2b4fc9,
+	0x22f687,
+	0x26cbc6,
+	0x352686,
+	0x29c746,
+	0x363786,
+	0x2cb84f,
+	0x2cbd89,
+	0x215446,
+	0x22f386,
+	0x27a409,
+	0x309e87,
+	0x21d943,
+	0x22d846,
+	0x20fa43,
+	0x2d7a08,
+	0x383ac7,
+	0x29f109,
+	0x2a7bc8,
+	0x3823c8,
+	0x26b686,
+	0x23c549,
+	0x2c7a85,
+	0x244944,
+	0x349a07,
+	0x26db85,
+	0x205204,
+	0x32fec8,
+	0x21a184,
+	0x305647,
+	0x319c06,
+	0x29f785,
+	0x2a4ec8,
+	0x35254b,
+	0x35e547,
+	0x22c506,
+	0x2be584,
+	0x321a46,
+	0x2bdb45,
+	0x2f7805,
+	0x280789,
+	0x284f09,
+	0x2a2a04,
+	0x3204c5,
+	0x233c05,
+	0x2155c6,
+	0x35e148,
+	0x2b7586,
+	0x34cb0b,
+	0x301f0a,
+	0x2fda05,
+	0x28b5c6,
+	0x2f6f05,
+	0x209845,
+	0x29ad47,
+	0x2073c8,
+	0x2563c4,
+	0x364a06,
+	0x28e7c6,
+	0x2192c7,
+	0x301584,
+	0x27f506,
+	0x300e05,
+	0x300e09,
+	0x216144,
+	0x2a9309,
+	0x27d5c6,
+	0x2ba888,
+	0x233c05,
+	0x236185,
+	0x225dc6,
+	0x26fe49,
+	0x21b949,
+	0x2404c6,
+	0x272248,
+	0x252488,
+	0x2f6ec4,
+	0x363c84,
+	0x363c88,
+	0x3158c8,
+	0x2564c9,
+	0x2c0406,
+	0x237446,
+	0x312ccd,
+	0x3051c6,
+	0x3ae009,
+	0x2022c5,
+	0x35ae46,
+	0x261248,
+	0x30fc45,
+	0x258704,
+	0x2bdb45,
+	0x284588,
+	0x298509,
+	0x27ab44,
+	0x239546,
+	0x2e5d0a,
+	0x2d6388,
+	0x32a609,
+	0x35b64a,
+	0x330786,
+	0x297348,
+	0x328cc5,
+	0x326c48,
+	0x2b3d05,
+	0x21f789,
+	0x368809,
+	0x202e02,
+	0x2a59c5,
+	0x272e86,
+	0x27d507,
+	0x324245,
+	0x2f9986,
+	0x30fd08,
+	0x2a6246,
+	0x2c27c9,
+	0x27c546,
+	0x284708,
+	0x2a8645,
+	0x24ae86,
+	0x3449c8,
+	0x283308,
+	0x3a4ac8,
+	0x2febc8,
+	0x20aa04,
+	0x22a783,
+	0x2c2a04,
+	0x236fc6,
+	0x37e8c4,
+	0x2c01c7,
+	0x2bc0c9,
+	0x2bdd85,
+	0x209fc6,
+	0x22d846,
+	0x29260b,
+	0x2fd946,
+	0x316406,
+	0x2c2f88,
+	0x243586,
+	0x263cc3,
+	0x20a383,
+	0x333b84,
+	0x22f905,
+	0x384107,
+	0x27acc8,
+	0x27accf,
+	0x27e90b,
+	0x35df48,
+	0x2395c6,
+	0x35e24e,
+	0x225dc3,
+	0x2b1d84,
+	0x2fd8c5,
+	0x33d746,
+	0x28c54b,
+	0x290546,
+	0x21a449,
+	0x29f785,
+	0x38b708,
+	0x212088,
+	0x21b80c,
+	0x29dc46,
+	0x214e06,
+	0x2d9545,
+	0x288d48,
+	0x27de45,
+	0x33c448,
+	0x29d30a,
+	0x361e09,
+	0x687e44,
+	0x2f606a82,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x327883,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x24c083,
+	0x204703,
+	0x223503,
+	0x223504,
+	0x22bf83,
+	0x234a44,
+	0x231b03,
+	0x2c8144,
+	0x250cc3,
+	0x324507,
+	0x220ec3,
+	0x2020c3,
+	0x255bc8,
+	0x204703,
+	0x2d2d8b,
+	0x2e0f83,
+	0x25b1c6,
+	0x2012c2,
+	0x387d0b,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x204703,
+	0x29dfc3,
+	0x205a03,
+	0x200882,
+	0x77a48,
+	0x343145,
+	0x2d4e88,
+	0x2da008,
+	0x206a82,
+	0x330f85,
+	0x357bc7,
+	0x200202,
+	0x2424c7,
+	0x20f582,
+	0x23d4c7,
+	0x265249,
+	0x3167c8,
+	0x30ca09,
+	0x3345c2,
+	0x26ab07,
+	0x240244,
+	0x357c87,
+	0x301e07,
+	0x244d02,
+	0x220ec3,
+	0x203642,
+	0x202b42,
+	0x200fc2,
+	0x200ac2,
+	0x203942,
+	0x203682,
+	0x2a81c5,
+	0x2477c5,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x481,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x202243,
+	0x24c083,
+	0x204703,
+	0x20b743,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0xa9c2,
+	0x77a48,
+	0x45684,
+	0xd0705,
+	0x200882,
+	0x2bb844,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x37f383,
+	0x2a8e05,
+	0x202243,
+	0x39a883,
+	0x24c083,
+	0x20f543,
+	0x204703,
+	0x20b803,
+	0x223583,
+	0x2232c3,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x206a82,
+	0x204703,
+	0x77a48,
+	0x250cc3,
+	0x77a48,
+	0x2cd683,
+	0x22bf83,
+	0x22ff84,
+	0x231b03,
+	0x250cc3,
+	0x20b542,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x20b542,
+	0x232003,
+	0x24c083,
+	0x204703,
+	0x2d9f83,
+	0x20b803,
+	0x200882,
+	0x206a82,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x25b1c5,
+	0xad186,
+	0x223504,
+	0x2012c2,
+	0x77a48,
+	0x200882,
+	0x20048,
+	0x206a82,
+	0xf206,
+	0x143f44,
+	0x10844b,
+	0x18986,
+	0x142b87,
+	0x231b03,
+	0x250cc3,
+	0x159b85,
+	0x14d4c4,
+	0x24dd43,
+	0x4ce47,
+	0xcd204,
+	0x24c083,
+	0x14c104,
+	0x204703,
+	0x2e1c44,
+	0x10c888,
+	0x122706,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x2020c3,
+	0x204703,
+	0x2e0f83,
+	0x2012c2,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c3,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x2c8144,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x25b1c6,
+	0x231b03,
+	0x250cc3,
+	0x178d03,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x142b87,
+	0x77a48,
+	0x250cc3,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x3822bf83,
+	0x231b03,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x200882,
+	0x206a82,
+	0x22bf83,
+	0x250cc3,
+	0x24c083,
+	0x200fc2,
+	0x204703,
+	0x30abc7,
+	0x2e330b,
+	0x208883,
+	0x23a8c8,
+	0x2cf787,
+	0x2b7b46,
+	0x2bc885,
+	0x2f9509,
+	0x25af48,
+	0x31b349,
+	0x31b350,
+	0x35d24b,
+	0x2ec7c9,
+	0x206103,
+	0x2130c9,
+	0x230a86,
+	0x230a8c,
+	0x31b548,
+	0x3ad308,
+	0x279709,
+	0x29e40e,
+	0x37b34b,
+	0x237bcc,
+	0x201e03,
+	0x268d0c,
+	0x208349,
+	0x375287,
+	0x231a4c,
+	0x39c18a,
+	0x247204,
+	0x3a4d8d,
+	0x268bc8,
+	0x3a52cd,
+	0x26e086,
+	0x290ccb,
+	0x375b49,
+	0x3162c7,
+	0x31eb06,
+	0x321c09,
+	0x34b9ca,
+	0x304f48,
+	0x2e0b84,
+	0x362087,
+	0x278a07,
+	0x30cf04,
+	0x228dc4,
+	0x262f09,
+	0x2ce4c9,
+	0x323148,
+	0x212905,
+	0x392845,
+	0x20d546,
+	0x3a4c49,
+	0x21194d,
+	0x237648,
+	0x20d447,
+	0x2bc908,
+	0x32cc06,
+	0x3a2444,
+	0x37dd45,
+	0x204946,
+	0x205884,
+	0x208247,
+	0x20a60a,
+	0x214bc4,
+	0x219d86,
+	0x21a789,
+	0x21a78f,
+	0x21b50d,
+	0x21ca86,
+	0x21fc50,
+	0x220046,
+	0x220787,
+	0x221047,
+	0x22104f,
+	0x221889,
+	0x2256c6,
+	0x227e87,
+	0x227e88,
+	0x228249,
+	0x290248,
+	0x2d7547,
+	0x20ce83,
+	0x386a86,
+	0x2e4c88,
+	0x29e6ca,
+	0x214689,
+	0x212383,
+	0x357ac6,
+	0x36484a,
+	0x2f7d07,
+	0x3750ca,
+	0x2028ce,
+	0x2219c6,
+	0x2a5bc7,
+	0x217306,
+	0x208406,
+	0x37d88b,
+	0x3038ca,
+	0x22704d,
+	0x216007,
+	0x264988,
+	0x264989,
+	0x26498f,
+	0x20e34c,
+	0x27f909,
+	0x3af74e,
+	0x32460a,
+	0x329546,
+	0x37a686,
+	0x306ccc,
+	0x3108cc,
+	0x32adc8,
+	0x33fe07,
+	0x2b0585,
+	0x206a84,
+	0x345b4e,
+	0x34bc44,
+	0x22adc7,
+	0x265e4a,
+	0x382ad4,
+	0x384e4f,
+	0x221208,
+	0x386948,
+	0x36d10d,
+	0x36d10e,
+	0x390509,
+	0x22e108,
+	0x22e10f,
+	0x23174c,
+	0x23174f,
+	0x232c47,
+	0x2352ca,
+	0x21f50b,
+	0x239c08,
+	0x23aac7,
+	0x25a64d,
+	0x35c546,
+	0x3a4f46,
+	0x23ea09,
+	0x250248,
+	0x243048,
+	0x24304e,
+	0x2e3407,
+	0x2aabc5,
+	0x244ec5,
+	0x200e84,
+	0x2b7e06,
+	0x323048,
+	0x25ff83,
+	0x2de30e,
+	0x25aa08,
+	0x308dcb,
+	0x367487,
+	0x3a4485,
+	0x22f246,
+	0x2a9b47,
+	0x2e8448,
+	0x330489,
+	0x35c7c5,
+	0x2878c8,
+	0x214006,
+	0x37e50a,
+	0x345a49,
+	0x231b09,
+	0x231b0b,
+	0x31f608,
+	0x30cdc9,
+	0x2129c6,
+	0x35a8ca,
+	0x2b898a,
+	0x2354cc,
+	0x35c307,
+	0x291e8a,
+	0x2af68b,
+	0x2af699,
+	0x2dd788,
+	0x25b245,
+	0x25a806,
+	0x2dad89,
+	0x316cc6,
+	0x211e4a,
+	0x342a06,
+	0x2143c4,
+	0x2c098d,
+	0x24fd07,
+	0x2143c9,
+	0x245e85,
+	0x245fc8,
+	0x2467c9,
+	0x246a04,
+	0x247107,
+	0x247108,
+	0x2479c7,
+	0x2687c8,
+	0x24d447,
+	0x23c285,
+	0x25520c,
+	0x2558c9,
+	0x36bf0a,
+	0x38f249,
+	0x2131c9,
+	0x27450c,
+	0x259a4b,
+	0x259d08,
+	0x25bf88,
+	0x25f344,
+	0x285e08,
+	0x286bc9,
+	0x39c247,
+	0x21a9c6,
+	0x2413c7,
+	0x3af509,
+	0x2af2cb,
+	0x325947,
+	0x204787,
+	0x2e2d47,
+	0x3a5244,
+	0x3a5245,
+	0x2a6d05,
+	0x337a0b,
+	0x398604,
+	0x317e88,
+	0x2aa7ca,
+	0x2140c7,
+	0x34a107,
+	0x28bdd2,
+	0x288046,
+	0x22fb86,
+	0x32728e,
+	0x34ae06,
+	0x291308,
+	0x29210f,
+	0x3a5688,
+	0x377c48,
+	0x2b4b8a,
+	0x2b4b91,
+	0x2a0d0e,
+	0x23adca,
+	0x23adcc,
+	0x22e307,
+	0x22e310,
+	0x203d08,
+	0x2a0f05,
+	0x2aa10a,
+	0x2058cc,
+	0x2b368d,
+	0x2abe06,
+	0x2abe07,
+	0x2abe0c,
+	0x2f338c,
+	0x2d988c,
+	0x28f4cb,
+	0x287284,
+	0x225584,
+	0x371389,
+	0x2d8447,
+	0x32c449,
+	0x2b87c9,
+	0x367f07,
+	0x39c006,
+	0x39c009,
+	0x3a6b43,
+	0x2a634a,
+	0x206cc7,
+	0x3624cb,
+	0x226eca,
+	0x23d604,
+	0x3564c6,
+	0x281a09,
+	0x20b504,
+	0x3b200a,
+	0x2f9b45,
+	0x2b6285,
+	0x2b628d,
+	0x2b65ce,
+	0x3146c5,
+	0x3942c6,
+	0x25adc7,
+	0x2dc04a,
+	0x2e8646,
+	0x2fc484,
+	0x2f5987,
+	0x220d8b,
+	0x32ccc7,
+	0x3a34c4,
+	0x374206,
+	0x37420d,
+	0x23918c,
+	0x380406,
+	0x23784a,
+	0x217b06,
+	0x21da08,
+	0x228507,
+	0x37f14a,
+	0x2310c6,
+	0x215f03,
+	0x2613c6,
+	0x201308,
+	0x298b0a,
+	0x26c447,
+	0x26c448,
+	0x2732c4,
+	0x2863c7,
+	0x28e0c8,
+	0x2151c8,
+	0x285c08,
+	0x32630a,
+	0x2cff85,
+	0x2c76c7,
+	0x23ac13,
+	0x22c006,
+	0x2b09c8,
+	0x223ac9,
+	0x242388,
+	0x26b70b,
+	0x2b8d88,
+	0x220ec4,
+	0x2a0946,
+	0x3b11c6,
+	0x2d8889,
+	0x387747,
+	0x255308,
+	0x3acf86,
+	0x21c444,
+	0x2c4d05,
+	0x2bf0c8,
+	0x2bf98a,
+	0x2c0608,
+	0x2c5446,
+	0x29920a,
+	0x234548,
+	0x2c8788,
+	0x2c9bc8,
+	0x2ca4c6,
+	0x2cc0c6,
+	0x31f00c,
+	0x2cc590,
+	0x28a505,
+	0x2f9f88,
+	0x2f9f90,
+	0x3a5490,
+	0x31b1ce,
+	0x31ec8e,
+	0x31ec94,
+	0x31f7cf,
+	0x31fb86,
+	0x250751,
+	0x3086d3,
+	0x308b48,
+	0x321585,
+	0x359ec8,
+	0x20f3c5,
+	0x22964c,
+	0x256789,
+	0x22ac09,
+	0x241147,
+	0x214989,
+	0x24ff47,
+	0x2b6106,
+	0x37db47,
+	0x2605c5,
+	0x310b83,
+	0x260149,
+	0x227409,
+	0x378d03,
+	0x3abb84,
+	0x38004d,
+	0x3810cf,
+	0x3005c5,
+	0x3188c6,
+	0x20d147,
+	0x303d07,
+	0x289946,
+	0x28994b,
+	0x2a2085,
+	0x257ec6,
+	0x209247,
+	0x273949,
+	0x3358c6,
+	0x210805,
+	0x22400b,
+	0x37f406,
+	0x249885,
+	0x39f588,
+	0x2b5cc8,
+	0x2b6b4c,
+	0x2b6b50,
+	0x2ca9c9,
+	0x2f8587,
+	0x2de9cb,
+	0x2d59c6,
+	0x2d740a,
+	0x2d860b,
+	0x2d918a,
+	0x2d9406,
+	0x2d9e45,
+	0x2cf686,
+	0x27c708,
+	0x24120a,
+	0x36cd9c,
+	0x2e104c,
+	0x2e1348,
+	0x25b1c5,
+	0x2e51c7,
+	0x29e046,
+	0x399445,
+	0x21ea46,
+	0x289b08,
+	0x2b5247,
+	0x29e308,
+	0x2a5cca,
+	0x321f8c,
+	0x322209,
+	0x20a147,
+	0x20c544,
+	0x245846,
+	0x3777ca,
+	0x2b88c5,
+	0x3a2c8c,
+	0x3a5088,
+	0x350b48,
+	0x20da4c,
+	0x213c4c,
+	0x2162c9,
+	0x216507,
+	0x2c7e0c,
+	0x32f644,
+	0x39080a,
+	0x20b80c,
+	0x274d8b,
+	0x23a28b,
+	0x23b346,
+	0x23df07,
+	0x22e547,
+	0x22e54f,
+	0x2f4311,
+	0x3b1ad2,
+	0x23eecd,
+	0x23eece,
+	0x23f20e,
+	0x31f988,
+	0x31f992,
+	0x242e48,
+	0x2fc287,
+	0x24a88a,
+	0x20fd88,
+	0x34adc5,
+	0x2f164a,
+	0x220587,
+	0x2e6e04,
+	0x24ddc3,
+	0x376a45,
+	0x2b4e07,
+	0x2fa347,
+	0x2b388e,
+	0x38708d,
+	0x39b189,
+	0x216845,
+	0x2ea903,
+	0x25f8c6,
+	0x36ba85,
+	0x309008,
+	0x2eb049,
+	0x25a845,
+	0x25a84f,
+	0x2d9c87,
+	0x2f9445,
+	0x271d8a,
+	0x3a2986,
+	0x21db89,
+	0x2ec3cc,
+	0x2ee449,
+	0x203f46,
+	0x2aa5cc,
+	0x2eea06,
+	0x2f19c8,
+	0x2f1bc6,
+	0x2dd906,
+	0x24fa84,
+	0x25a083,
+	0x35efca,
+	0x31e391,
+	0x27faca,
+	0x327f85,
+	0x38ec47,
+	0x251b47,
+	0x28e1c4,
+	0x28e1cb,
+	0x316648,
+	0x2b2886,
+	0x235fc5,
+	0x265904,
+	0x269ac9,
+	0x27a984,
+	0x3041c7,
+	0x2ee645,
+	0x2ee647,
+	0x3274c5,
+	0x2a8283,
+	0x2fc148,
+	0x325c0a,
+	0x2643c3,
+	0x34318a,
+	0x274386,
+	0x25a5cf,
+	0x358f89,
+	0x2de290,
+	0x2e1848,
+	0x2c5909,
+	0x298347,
+	0x37418f,
+	0x330bc4,
+	0x2c81c4,
+	0x21b386,
+	0x275746,
+	0x2ff74a,
+	0x32b746,
+	0x33e787,
+	0x2f8b48,
+	0x2f8d47,
+	0x2f9747,
+	0x34da8a,
+	0x2fbf8b,
+	0x238845,
+	0x3b1708,
+	0x22aec3,
+	0x36524c,
+	0x38d68f,
+	0x2b038d,
+	0x2ef707,
+	0x39b2c9,
+	0x22cb47,
+	0x240008,
+	0x382ccc,
+	0x272988,
+	0x2511c8,
+	0x30d64e,
+	0x31d014,
+	0x31d524,
+	0x33ee8a,
+	0x35d98b,
+	0x250004,
+	0x250009,
+	0x3a7088,
+	0x245a05,
+	0x25fa8a,
+	0x265107,
+	0x2cf584,
+	0x327883,
+	0x22bf83,
+	0x234a44,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x202243,
+	0x220ec3,
+	0x2cc586,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x21d603,
+	0x200882,
+	0x327883,
+	0x206a82,
+	0x22bf83,
+	0x234a44,
+	0x231b03,
+	0x250cc3,
+	0x202243,
+	0x2cc586,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x722f48,
+	0x201482,
+	0x200482,
+	0x206a82,
+	0x22bf83,
+	0x200d02,
+	0x202002,
+	0x2023c4,
+	0x30db04,
+	0x21ee82,
+	0x211004,
+	0x200fc2,
+	0x204703,
+	0x21d603,
+	0x23b346,
+	0x212dc2,
+	0x203f02,
+	0x214a02,
+	0x3aa23a03,
+	0x3ae095c3,
+	0x52886,
+	0x52886,
+	0x223504,
+	0xe3d4c,
+	0x19a1cc,
+	0x8390d,
+	0xda987,
+	0x1cc08,
+	0x22408,
+	0x1a9f8a,
+	0x3bb1cb45,
+	0x11cb49,
+	0x142c08,
+	0x16b1ca,
+	0x170bce,
+	0x144218b,
+	0x143f44,
+	0x16fcc8,
+	0x7f087,
+	0x12c47,
+	0x172cc9,
+	0xb587,
+	0x14bcc8,
+	0x1a4249,
+	0xda4c5,
+	0x6098e,
+	0xa868d,
+	0x142a08,
+	0x3be6b1c6,
+	0x62c87,
+	0x65d07,
+	0x6bf07,
+	0x72b87,
+	0xd502,
+	0x14d247,
+	0x103a8c,
+	0xed107,
+	0x90906,
+	0xa3849,
+	0xa5408,
+	0x134c2,
+	0x2002,
+	0x1808cb,
+	0x18549,
+	0x44b09,
+	0x15bd88,
+	0xafcc2,
+	0x3c909,
+	0x120809,
+	0xcd808,
+	0xcde07,
+	0xcff09,
+	0xd32c5,
+	0xd36d0,
+	0x1a2ac6,
+	0x62145,
+	0x22f4d,
+	0xb146,
+	0xdb947,
+	0xe1c58,
+	0xcfc88,
+	0x19110a,
+	0x185e4d,
+	0x4042,
+	0x72606,
+	0x8c808,
+	0x14ac08,
+	0x77909,
+	0x496c8,
+	0x56f0e,
+	0xe9f85,
+	0x4ef08,
+	0x1bc2,
+	0x122706,
+	0x6c2,
+	0xc01,
+	0x3c2e24c4,
+	0x3c692f43,
+	0x141,
+	0x4e86,
+	0x141,
+	0x1,
+	0x4e86,
+	0x1570305,
+	0x247204,
+	0x22bf83,
+	0x248fc4,
+	0x2023c4,
+	0x24c083,
+	0x223985,
+	0x20b743,
+	0x2298c3,
+	0x2ebf05,
+	0x2232c3,
+	0x3d62bf83,
+	0x231b03,
+	0x250cc3,
+	0x200041,
+	0x220ec3,
+	0x30db04,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x20b803,
+	0x77a48,
+	0x200882,
+	0x327883,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x202002,
+	0x2023c4,
+	0x202243,
+	0x220ec3,
+	0x24c083,
+	0x2020c3,
+	0x204703,
+	0x2232c3,
+	0x77a48,
+	0x38d402,
+	0x6a82,
+	0xf8f0e,
+	0x3e600142,
+	0x27a048,
+	0x225f46,
+	0x2bc3c6,
+	0x2258c7,
+	0x3ea06b82,
+	0x3ef58e08,
+	0x20628a,
+	0x262708,
+	0x200ec2,
+	0x206b09,
+	0x238887,
+	0x21a946,
+	0x208d89,
+	0x25ba04,
+	0x2b7a46,
+	0x2e2884,
+	0x27b484,
+	0x254709,
+	0x343886,
+	0x247885,
+	0x20eec5,
+	0x3a7607,
+	0x2b9207,
+	0x36ee44,
+	0x225b06,
+	0x2f2945,
+	0x2e48c5,
+	0x2f6e45,
+	0x392607,
+	0x3672c5,
+	0x3098c9,
+	0x2644c5,
+	0x2d0cc4,
+	0x2e8587,
+	0x2cee0e,
+	0x31ae09,
+	0x327149,
+	0x35c146,
+	0x31bf08,
+	0x2ae58b,
+	0x2d1fcc,
+	0x26c186,
+	0x37b207,
+	0x20ae05,
+	0x228dca,
+	0x31a489,
+	0x24f6c9,
+	0x388f86,
+	0x2f0f05,
+	0x282145,
+	0x366349,
+	0x2f6fcb,
+	0x27ed46,
+	0x333e06,
+	0x20d444,
+	0x28ba86,
+	0x2aac48,
+	0x201186,
+	0x203786,
+	0x209988,
+	0x20a887,
+	0x20ab89,
+	0x20bdc5,
+	0x77a48,
+	0x212bc4,
+	0x37ee84,
+	0x213a85,
+	0x395589,
+	0x222b07,
+	0x222b0b,
+	0x2243ca,
+	0x228ac5,
+	0x3f20ce82,
+	0x226d87,
+	0x3f629408,
+	0x287607,
+	0x343bc5,
+	0x238f8a,
+	0x6a82,
+	0x266d4b,
+	0x383dca,
+	0x223c86,
+	0x3a4483,
+	0x338f8d,
+	0x35b24c,
+	0x36654d,
+	0x385a45,
+	0x23e645,
+	0x25ffc7,
+	0x200d09,
+	0x206186,
+	0x32b5c5,
+	0x2a9f08,
+	0x28b983,
+	0x2da308,
+	0x28b988,
+	0x2bd387,
+	0x3b00c8,
+	0x200b09,
+	0x233a47,
+	0x2e2e87,
+	0x2f74c8,
+	0x24c0c4,
+	0x24c0c7,
+	0x26df88,
+	0x204bc6,
+	0x39a48f,
+	0x218747,
+	0x2d76c6,
+	0x240185,
+	0x36e8c3,
+	0x36e8c7,
+	0x36a503,
+	0x247b86,
+	0x249b86,
+	0x24b006,
+	0x28fd05,
+	0x2687c3,
+	0x389f48,
+	0x36c709,
+	0x3817cb,
+	0x24b188,
+	0x24d105,
+	0x24e185,
+	0x3fa3d6c2,
+	0x37dc09,
+	0x202447,
+	0x257f45,
+	0x254607,
+	0x256dc6,
+	0x363645,
+	0x36b8cb,
+	0x259d04,
+	0x2622c5,
+	0x262407,
+	0x278406,
+	0x278845,
+	0x286007,
+	0x286587,
+	0x274344,
+	0x28a70a,
+	0x28abc8,
+	0x328d49,
+	0x3a0805,
+	0x34c306,
+	0x2aae0a,
+	0x20edc6,
+	0x266f87,
+	0x31694d,
+	0x227b09,
+	0x329805,
+	0x345fc7,
+	0x344108,
+	0x344788,
+	0x323487,
+	0x366f86,
+	0x215b47,
+	0x249503,
+	0x337a04,
+	0x360585,
+	0x38dd07,
+	0x392009,
+	0x227608,
+	0x22ccc5,
+	0x3afa84,
+	0x382f85,
+	0x2474cd,
+	0x204242,
+	0x302bc6,
+	0x272546,
+	0x2a3cca,
+	0x365a86,
+	0x377705,
+	0x317145,
+	0x317147,
+	0x37e34c,
+	0x27648a,
+	0x28b746,
+	0x206945,
+	0x28b8c6,
+	0x28bc07,
+	0x28d946,
+	0x28fc0c,
+	0x208ec9,
+	0x3fe05307,
+	0x2924c5,
+	0x2924c6,
+	0x2929c8,
+	0x2b1285,
+	0x2a2c05,
+	0x2a2e48,
+	0x2a304a,
+	0x4020b882,
+	0x4060f802,
+	0x3827c5,
+	0x2d7643,
+	0x267348,
+	0x21dcc3,
+	0x2a32c4,
+	0x21dccb,
+	0x2ae948,
+	0x2a6088,
+	0x40b40cc9,
+	0x2a7ec9,
+	0x2a8586,
+	0x2a97c8,
+	0x2a99c9,
+	0x2ab6c6,
+	0x2ab845,
+	0x383646,
+	0x2ac389,
+	0x341607,
+	0x24ad46,
+	0x238c87,
+	0x206007,
+	0x23bc44,
+	0x40efa889,
+	0x2c3d08,
+	0x358d08,
+	0x360187,
+	0x2bed46,
+	0x3007c9,
+	0x2f7a07,
+	0x32a14a,
+	0x2be708,
+	0x34c447,
+	0x357e06,
+	0x21ce8a,
+	0x280b88,
+	0x271fc5,
+	0x22d185,
+	0x2be8c7,
+	0x2d1789,
+	0x2d6d4b,
+	0x2ede48,
+	0x264549,
+	0x24b747,
+	0x3adc4c,
+	0x2b0e4c,
+	0x2b114a,
+	0x2b13cc,
+	0x2bc348,
+	0x2bc548,
+	0x2bc744,
+	0x2bcb09,
+	0x2bcd49,
+	0x2bcf8a,
+	0x2bd209,
+	0x2bd547,
+	0x20010c,
+	0x242886,
+	0x2794c8,
+	0x20ee86,
+	0x388a46,
+	0x329707,
+	0x31b008,
+	0x261a4b,
+	0x2874c7,
+	0x2f0bc9,
+	0x249149,
+	0x253dc7,
+	0x2e2ac4,
+	0x363b07,
+	0x34c986,
+	0x2179c6,
+	0x237a05,
+	0x2ccd48,
+	0x20f2c4,
+	0x20f2c6,
+	0x27634b,
+	0x2a6649,
+	0x31e946,
+	0x35ab09,
+	0x392786,
+	0x301348,
+	0x2025c3,
+	0x209185,
+	0x2038c9,
+	0x20cb05,
+	0x2fd284,
+	0x277506,
+	0x26bdc5,
+	0x2db706,
+	0x2fe047,
+	0x2af586,
+	0x2974cb,
+	0x35a7c7,
+	0x2d1646,
+	0x371506,
+	0x3a76c6,
+	0x36ee09,
+	0x24df0a,
+	0x2b5985,
+	0x22d94d,
+	0x2a3146,
+	0x391306,
+	0x2e1746,
+	0x21d985,
+	0x2d39c7,
+	0x29bb47,
+	0x29fb0e,
+	0x220ec3,
+	0x2bed09,
+	0x316e89,
+	0x2291c7,
+	0x27e2c7,
+	0x2a7505,
+	0x323f05,
+	0x4126304f,
+	0x2c5b47,
+	0x2c5d08,
+	0x2c6784,
+	0x2c6a46,
+	0x41629382,
+	0x2ca746,
+	0x2cc586,
+	0x261d8e,
+	0x2da14a,
+	0x226886,
+	0x22ee4a,
+	0x205d89,
+	0x314e85,
+	0x393c08,
+	0x3adb06,
+	0x31e788,
+	0x326ac8,
+	0x24090b,
+	0x2259c5,
+	0x367348,
+	0x209acc,
+	0x343a87,
+	0x24a7c6,
+	0x27fd08,
+	0x2b7cc8,
+	0x41a09142,
+	0x3700cb,
+	0x376209,
+	0x2d2b49,
+	0x3a3347,
+	0x20af88,
+	0x41f5b088,
+	0x20bfcb,
+	0x35bc09,
+	0x221d4d,
+	0x378388,
+	0x29bf88,
+	0x422018c2,
+	0x201084,
+	0x4260dd02,
+	0x2edbc6,
+	0x42a02482,
+	0x21c48a,
+	0x322806,
+	0x32c808,
+	0x31c208,
+	0x2b7946,
+	0x388086,
+	0x2e8046,
+	0x308f85,
+	0x23a584,
+	0x42f012c4,
+	0x338446,
+	0x33be87,
+	0x432e9287,
+	0x35e7cb,
+	0x2cf1c9,
+	0x23e68a,
+	0x261644,
+	0x317288,
+	0x24ab0d,
+	0x2dfd49,
+	0x2dff88,
+	0x2e06c9,
+	0x2e1c44,
+	0x208c84,
+	0x281645,
+	0x36228b,
+	0x2ae8c6,
+	0x338285,
+	0x343d49,
+	0x225bc8,
+	0x29f3c4,
+	0x228f49,
+	0x330045,
+	0x2b9248,
+	0x2e3547,
+	0x327548,
+	0x281c06,
+	0x226c47,
+	0x2910c9,
+	0x224189,
+	0x249905,
+	0x339605,
+	0x436284c2,
+	0x2e8344,
+	0x33b285,
+	0x291c46,
+	0x335e85,
+	0x24e247,
+	0x26ccc5,
+	0x26cd44,
+	0x35c206,
+	0x32b647,
+	0x244f86,
+	0x3af445,
+	0x37fd48,
+	0x226145,
+	0x39a807,
+	0x3b2509,
+	0x2a678a,
+	0x236987,
+	0x23698c,
+	0x247846,
+	0x22b289,
+	0x340105,
+	0x370f08,
+	0x212983,
+	0x212985,
+	0x2e90c5,
+	0x255707,
+	0x43a17482,
+	0x23e287,
+	0x2e5046,
+	0x2fa7c6,
+	0x303146,
+	0x2b7c06,
+	0x3302c8,
+	0x35a005,
+	0x2d7787,
+	0x2d778d,
+	0x24ddc3,
+	0x3a4885,
+	0x271b47,
+	0x387bc8,
+	0x271705,
+	0x228808,
+	0x32c346,
+	0x31cd07,
+	0x2bdf45,
+	0x225a46,
+	0x2d3005,
+	0x2bb8ca,
+	0x2fc546,
+	0x233dc7,
+	0x2c6905,
+	0x2f5207,
+	0x2f5904,
+	0x2fd206,
+	0x331ac5,
+	0x34368b,
+	0x34c809,
+	0x243bca,
+	0x249988,
+	0x336448,
+	0x337c8c,
+	0x3556c7,
+	0x35dd48,
+	0x361308,
+	0x36bc05,
+	0x3a024a,
+	0x2ea909,
+	0x43e04582,
+	0x204586,
+	0x20c984,
+	0x2de009,
+	0x362d89,
+	0x2250c7,
+	0x254447,
+	0x2b8649,
+	0x326508,
+	0x32650f,
+	0x270f06,
+	0x241b0b,
+	0x2ebd45,
+	0x2ebd47,
+	0x2ec189,
+	0x21de06,
+	0x228ec7,
+	0x3b1e45,
+	0x2305c4,
+	0x26bc86,
+	0x200c44,
+	0x30ba07,
+	0x2ee808,
+	0x442f0e08,
+	0x2f1305,
+	0x2f1447,
+	0x256149,
+	0x201904,
+	0x201908,
+	0x4476d588,
+	0x28e1c4,
+	0x230f08,
+	0x31ebc4,
+	0x21bf09,
+	0x22dc45,
+	0x44a012c2,
+	0x270f45,
+	0x220885,
+	0x24cb48,
+	0x232a87,
+	0x44e05a02,
+	0x2c8405,
+	0x2518c6,
+	0x266246,
+	0x2e8308,
+	0x2e9c48,
+	0x335e46,
+	0x2eac86,
+	0x21e589,
+	0x2fa706,
+	0x3081cb,
+	0x28edc5,
+	0x20fcc6,
+	0x3abd88,
+	0x3906c6,
+	0x35c646,
+	0x21d30a,
+	0x258dca,
+	0x24dac5,
+	0x35a0c7,
+	0x349746,
+	0x45201242,
+	0x271c87,
+	0x236445,
+	0x2aad84,
+	0x2aad85,
+	0x261546,
+	0x276d47,
+	0x20c705,
+	0x258f44,
+	0x26d548,
+	0x35c705,
+	0x28af07,
+	0x293245,
+	0x219805,
+	0x24a284,
+	0x28f709,
+	0x2f2788,
+	0x2cea06,
+	0x212e06,
+	0x28dec6,
+	0x457a8c08,
+	0x2f5087,
+	0x2f53cd,
+	0x2f5b4c,
+	0x2f6149,
+	0x2f6389,
+	0x45b54382,
+	0x3a6903,
+	0x20c403,
+	0x34ca45,
+	0x38de0a,
+	0x318e06,
+	0x2fab45,
+	0x2fe584,
+	0x2fe58b,
+	0x30e4cc,
+	0x30ed0c,
+	0x30f015,
+	0x30f9cd,
+	0x31150f,
+	0x3118d2,
+	0x311d4f,
+	0x312112,
+	0x312593,
+	0x312a4d,
+	0x31300d,
+	0x31338e,
+	0x31384e,
+	0x31448c,
+	0x31480c,
+	0x314c4b,
+	0x314fce,
+	0x318092,
+	0x318bcc,
+	0x319110,
+	0x32d652,
+	0x32e60c,
+	0x32eccd,
+	0x32f00c,
+	0x332dd1,
+	0x333f8d,
+	0x33664d,
+	0x336c4a,
+	0x336ecc,
+	0x3377cc,
+	0x337f8c,
+	0x33880c,
+	0x33c653,
+	0x33cc50,
+	0x33d050,
+	0x33d8cd,
+	0x33decc,
+	0x33ebc9,
+	0x34024d,
+	0x340593,
+	0x346891,
+	0x346cd3,
+	0x34738f,
+	0x34774c,
+	0x347a4f,
+	0x347e0d,
+	0x34840f,
+	0x3487d0,
+	0x34924e,
+	0x34d5ce,
+	0x34dd10,
+	0x34e90d,
+	0x34f28e,
+	0x34f60c,
+	0x3505d3,
+	0x3521ce,
+	0x352910,
+	0x352d11,
+	0x35314f,
+	0x353513,
+	0x353f0d,
+	0x35424f,
+	0x35460e,
+	0x354f10,
+	0x355309,
+	0x356010,
+	0x35664f,
+	0x356ccf,
+	0x357092,
+	0x35860e,
+	0x3594cd,
+	0x35cc0d,
+	0x35cf4d,
+	0x35f24d,
+	0x35f58d,
+	0x35f8d0,
+	0x35fccb,
+	0x36034c,
+	0x3606cc,
+	0x3609cc,
+	0x360cce,
+	0x36f050,
+	0x371692,
+	0x371b0b,
+	0x3720ce,
+	0x37244e,
+	0x3736ce,
+	0x37454b,
+	0x45f74b16,
+	0x37740d,
+	0x378594,
+	0x37920d,
+	0x37ad55,
+	0x37be8d,
+	0x37c80f,
+	0x37d04f,
+	0x381a8f,
+	0x381e4e,
+	0x3830cd,
+	0x3855d1,
+	0x38820c,
+	0x38850c,
+	0x38880b,
+	0x38938c,
+	0x38974f,
+	0x389b12,
+	0x38a4cd,
+	0x38b48c,
+	0x38b90c,
+	0x38bc0d,
+	0x38bf4f,
+	0x38c30e,
+	0x38dacc,
+	0x38e08d,
+	0x38e3cb,
+	0x38f00c,
+	0x38f58d,
+	0x38f8ce,
+	0x38fd49,
+	0x390a93,
+	0x39148d,
+	0x3917cd,
+	0x391dcc,
+	0x39224e,
+	0x392bcf,
+	0x392f8c,
+	0x39328d,
+	0x3935cf,
+	0x39398c,
+	0x39408c,
+	0x39444c,
+	0x39474c,
+	0x394e0d,
+	0x395152,
+	0x3957cc,
+	0x395acc,
+	0x395dd1,
+	0x39620f,
+	0x3965cf,
+	0x396993,
+	0x397a8e,
+	0x39800f,
+	0x3983cc,
+	0x4639870e,
+	0x398a8f,
+	0x398e56,
+	0x39ad12,
+	0x39c40c,
+	0x39cd0f,
+	0x39d38d,
+	0x39d6cf,
+	0x39da8c,
+	0x39dd8d,
+	0x39e0cd,
+	0x39fd0e,
+	0x3a14cc,
+	0x3a17cc,
+	0x3a1ad0,
+	0x3a5c91,
+	0x3a60cb,
+	0x3a650c,
+	0x3a680e,
+	0x3a9111,
+	0x3a954e,
+	0x3a98cd,
+	0x3ad8cb,
+	0x3ae8cf,
+	0x3afb94,
+	0x224242,
+	0x224242,
+	0x204c83,
+	0x224242,
+	0x204c83,
+	0x224242,
+	0x20c942,
+	0x383685,
+	0x3a8e0c,
+	0x224242,
+	0x224242,
+	0x20c942,
+	0x224242,
+	0x293045,
+	0x2a6785,
+	0x224242,
+	0x224242,
+	0x212bc2,
+	0x293045,
+	0x30ff09,
+	0x34658c,
+	0x224242,
+	0x224242,
+	0x224242,
+	0x224242,
+	0x383685,
+	0x224242,
+	0x224242,
+	0x224242,
+	0x224242,
+	0x212bc2,
+	0x30ff09,
+	0x224242,
+	0x224242,
+	0x224242,
+	0x2a6785,
+	0x224242,
+	0x2a6785,
+	0x34658c,
+	0x3a8e0c,
+	0x327883,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x24c083,
+	0x204703,
+	0x4fdc8,
+	0x57044,
+	0x4bf48,
+	0x200882,
+	0x47206a82,
+	0x240bc3,
+	0x244c44,
+	0x209d03,
+	0x2db184,
+	0x22fb86,
+	0x203b43,
+	0x379084,
+	0x27bd85,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x24e9ca,
+	0x23b346,
+	0x3727cc,
+	0x77a48,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x232003,
+	0x2cc586,
+	0x24c083,
+	0x204703,
+	0x21d603,
+	0x8ac2,
+	0xda987,
+	0xb7008,
+	0xf60e,
+	0x89352,
+	0x6e0b,
+	0x47f1cb45,
+	0x48375d8c,
+	0x3c347,
+	0x117a4a,
+	0x3b4d0,
+	0x16fcc8,
+	0x7f087,
+	0x58a4b,
+	0x172cc9,
+	0x16fbc7,
+	0xb587,
+	0x7ef87,
+	0x188c6,
+	0x14bcc8,
+	0x4881c106,
+	0xa868d,
+	0x117410,
+	0x48c08b02,
+	0x142a08,
+	0x6ca87,
+	0x88349,
+	0x52946,
+	0x92bc8,
+	0x10442,
+	0x9ec8a,
+	0xf2347,
+	0xed107,
+	0xa3849,
+	0xa5408,
+	0x159b85,
+	0xe0c0e,
+	0x1224e,
+	0x171cf,
+	0x18549,
+	0x44b09,
+	0x6f38b,
+	0x8248f,
+	0x8f90c,
+	0xac74b,
+	0x16be48,
+	0x15e6c7,
+	0xf02c8,
+	0x11bd0b,
+	0x13e54c,
+	0x149e0c,
+	0x151ecc,
+	0x154a4d,
+	0x15bd88,
+	0x3c909,
+	0x14e38b,
+	0xbef46,
+	0xcdfc5,
+	0xd36d0,
+	0x197586,
+	0x62145,
+	0xd6908,
+	0xdb947,
+	0xdcfc7,
+	0x142e47,
+	0xeda0a,
+	0xb6e8a,
+	0x72606,
+	0x906cd,
+	0x14ac08,
+	0x496c8,
+	0x4a1c9,
+	0xed54c,
+	0x154c4b,
+	0x120304,
+	0x16e409,
+	0xd7e86,
+	0x3f02,
+	0x122706,
+	0x6c2,
+	0xc2ec5,
+	0x481,
+	0xb7c3,
+	0x4878cd86,
+	0x92f43,
+	0xf582,
+	0x3a004,
+	0xec2,
+	0x23504,
+	0x9c2,
+	0x8f02,
+	0x70c2,
+	0x105b42,
+	0x1482,
+	0x107ac2,
+	0x8c2,
+	0x1eb82,
+	0x35542,
+	0x682,
+	0x1582,
+	0xb042,
+	0x31b03,
+	0x4142,
+	0x202,
+	0x9342,
+	0xdb02,
+	0x10702,
+	0x30842,
+	0x134c2,
+	0x42,
+	0x4982,
+	0x2002,
+	0x2243,
+	0x3fc2,
+	0x7382,
+	0xafcc2,
+	0xac82,
+	0x17382,
+	0x3702,
+	0x33a82,
+	0x6a42,
+	0xf842,
+	0x16f542,
+	0x8b82,
+	0xb602,
+	0x4c083,
+	0xdc2,
+	0x9142,
+	0x1042,
+	0x12f42,
+	0x49885,
+	0xa742,
+	0x42242,
+	0x3e943,
+	0x34c2,
+	0xed42,
+	0x4042,
+	0x2742,
+	0x3a02,
+	0x5a02,
+	0x1bc2,
+	0x3f02,
+	0x74747,
+	0x213f03,
+	0x200882,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x215ac3,
+	0x232003,
+	0x24c083,
+	0x2020c3,
+	0x204703,
+	0x292f83,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x220ec3,
+	0x24c083,
+	0x2020c3,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x200041,
+	0x220ec3,
+	0x24c083,
+	0x20f543,
+	0x204703,
+	0x327883,
+	0x22bf83,
+	0x231b03,
+	0x2aa943,
+	0x20f583,
+	0x376b83,
+	0x285843,
+	0x2a4543,
+	0x240383,
+	0x250cc3,
+	0x2023c4,
+	0x24c083,
+	0x204703,
+	0x2232c3,
+	0x341bc4,
+	0x22b683,
+	0x1e03,
+	0x201283,
+	0x330d48,
+	0x21cec4,
+	0x315e4a,
+	0x3807c6,
+	0xdd704,
+	0x3a4107,
+	0x22134a,
+	0x270dc9,
+	0x3b15c7,
+	0x20054a,
+	0x327883,
+	0x38284b,
+	0x3292c9,
+	0x28dfc5,
+	0x2ca587,
+	0x6a82,
+	0x22bf83,
+	0x3583c7,
+	0x21e2c5,
+	0x2e2989,
+	0x231b03,
+	0x2257c6,
+	0x2b1e83,
+	0xe50c3,
+	0xfd786,
+	0x60346,
+	0x3ac7,
+	0x214246,
+	0x21a385,
+	0x20be87,
+	0x338647,
+	0x4ae50cc3,
+	0x32e847,
+	0x363a03,
+	0x238785,
+	0x2023c4,
+	0x222788,
+	0x2ae00c,
+	0x2ad305,
+	0x3a30c6,
+	0x358287,
+	0x20a207,
+	0x3b0407,
+	0x205688,
+	0x27ffcf,
+	0x2d2a85,
+	0x240cc7,
+	0x39f787,
+	0x2a340a,
+	0x2a9d49,
+	0x2d8305,
+	0x2dbe4a,
+	0x1225c6,
+	0x2bb605,
+	0x371d44,
+	0x2b7886,
+	0x300b87,
+	0x23b987,
+	0x341908,
+	0x2025c5,
+	0x21e1c6,
+	0x203705,
+	0x267105,
+	0x21e104,
+	0x31c107,
+	0x33010a,
+	0x399d08,
+	0x2f0006,
+	0x32003,
+	0x2cff85,
+	0x22b046,
+	0x200346,
+	0x262046,
+	0x220ec3,
+	0x38a747,
+	0x39f705,
+	0x24c083,
+	0x3b184d,
+	0x2020c3,
+	0x341a08,
+	0x3abc04,
+	0x278705,
+	0x2a3306,
+	0x2347c6,
+	0x20fbc7,
+	0x2a4587,
+	0x26c785,
+	0x204703,
+	0x3977c7,
+	0x33b749,
+	0x258349,
+	0x26cd8a,
+	0x244002,
+	0x238744,
+	0x2d7304,
+	0x220c47,
+	0x23e148,
+	0x2dda89,
+	0x3a4749,
+	0x2ded47,
+	0x2d2586,
+	0xe0986,
+	0x2e1c44,
+	0x2e224a,
+	0x2e7808,
+	0x2e7f09,
+	0x29b1c6,
+	0x3028c5,
+	0x399bc8,
+	0x2c070a,
+	0x25ad03,
+	0x239406,
+	0x2dee47,
+	0x2115c5,
+	0x3abac5,
+	0x25b2c3,
+	0x2512c4,
+	0x22d145,
+	0x286687,
+	0x2f28c5,
+	0x2edd06,
+	0x135d45,
+	0x226943,
+	0x226949,
+	0x2784cc,
+	0x2ab3cc,
+	0x2c7308,
+	0x2995c7,
+	0x2f1d48,
+	0x2f318a,
+	0x2f414b,
+	0x329408,
+	0x3a31c8,
+	0x2032c6,
+	0x34d3c5,
+	0x31f40a,
+	0x217cc5,
+	0x2012c2,
+	0x2bde07,
+	0x26ed46,
+	0x355b45,
+	0x329f89,
+	0x237f45,
+	0x2be645,
+	0x238349,
+	0x22aec6,
+	0x3650c8,
+	0x35b843,
+	0x35b8c6,
+	0x277446,
+	0x300285,
+	0x300289,
+	0x2b1989,
+	0x244347,
+	0x100104,
+	0x300107,
+	0x3a4649,
+	0x221545,
+	0x3a688,
+	0x366d05,
+	0x357885,
+	0x22a889,
+	0x20b0c2,
+	0x22b484,
+	0x202882,
+	0x203fc2,
+	0x33f285,
+	0x2dd488,
+	0x373085,
+	0x2bd703,
+	0x2bd705,
+	0x2ca943,
+	0x212602,
+	0x269704,
+	0x2344c3,
+	0x209242,
+	0x35a344,
+	0x2d8003,
+	0x20d102,
+	0x2bd783,
+	0x28c784,
+	0x2b5c43,
+	0x23d444,
+	0x202942,
+	0x275183,
+	0x203a03,
+	0x209642,
+	0x2eab42,
+	0x2b17c9,
+	0x207e02,
+	0x289ec4,
+	0x208b42,
+	0x399a44,
+	0x2d2544,
+	0x2e69c4,
+	0x203f02,
+	0x202f02,
+	0x216483,
+	0x2e3c43,
+	0x310c04,
+	0x262e44,
+	0x2b1b84,
+	0x2c5584,
+	0x2ff383,
+	0x2af143,
+	0x322544,
+	0x301544,
+	0x301846,
+	0x25b3c2,
+	0x206a82,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x200882,
+	0x327883,
+	0x22bf83,
+	0x231b03,
+	0x204543,
+	0x250cc3,
+	0x2023c4,
+	0x2b1a84,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x21d603,
+	0x2e4144,
+	0x27a003,
+	0x2b3003,
+	0x347104,
+	0x366b06,
+	0x208143,
+	0x21afc3,
+	0x211a83,
+	0x2b0d83,
+	0x2387c3,
+	0x232003,
+	0x2298c5,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x2da603,
+	0x22e9c3,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x202243,
+	0x24c083,
+	0x232704,
+	0x204703,
+	0x29e044,
+	0x2b7685,
+	0x206a82,
+	0x200e42,
+	0x20f582,
+	0x202b42,
+	0x200fc2,
+	0x22bf83,
+	0x234a44,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x20b803,
+	0x223504,
+	0x77a48,
+	0x22bf83,
+	0x2020c3,
+	0x247204,
+	0x77a48,
+	0x22bf83,
+	0x248fc4,
+	0x2023c4,
+	0x2020c3,
+	0x2018c2,
+	0x204703,
+	0x2298c3,
+	0x2ebf05,
+	0x2012c2,
+	0x301683,
+	0x200882,
+	0x77a48,
+	0x206a82,
+	0x231b03,
+	0x250cc3,
+	0x202002,
+	0x204703,
+	0x200882,
+	0x200707,
+	0x25ba05,
+	0x2ba684,
+	0x387ac6,
+	0x20540b,
+	0x267b89,
+	0x3a3006,
+	0x340a09,
+	0x2b2508,
+	0x208243,
+	0x77a48,
+	0x2286c7,
+	0x328148,
+	0x345883,
+	0x3038c4,
+	0x32c10b,
+	0x266545,
+	0x2f7888,
+	0x2ea389,
+	0x25a0c3,
+	0x22bf83,
+	0x204488,
+	0x2f0fc7,
+	0x345e86,
+	0x231b03,
+	0x345987,
+	0x250cc3,
+	0x2598c6,
+	0x202243,
+	0x22d007,
+	0x235947,
+	0x390f47,
+	0x31c085,
+	0x20a8c3,
+	0x21038b,
+	0x265448,
+	0x227c88,
+	0x33b906,
+	0x344b49,
+	0x323887,
+	0x2fae85,
+	0x3af704,
+	0x271008,
+	0x234e4a,
+	0x235089,
+	0x26d4c3,
+	0x281485,
+	0x28da83,
+	0x22c806,
+	0x2ba4c4,
+	0x300488,
+	0x388b8b,
+	0x33f145,
+	0x2b7406,
+	0x2ba3c5,
+	0x2baa88,
+	0x2bb747,
+	0x3b0287,
+	0x315a47,
+	0x2151c4,
+	0x30b3c7,
+	0x296746,
+	0x220ec3,
+	0x2c3b08,
+	0x24e2c3,
+	0x2cac08,
+	0x2d42c5,
+	0x3ac0c8,
+	0x231d07,
+	0x24c083,
+	0x245c83,
+	0x28ae44,
+	0x323b87,
+	0x209d83,
+	0x235a0b,
+	0x2039c3,
+	0x24e284,
+	0x2ebf88,
+	0x204703,
+	0x2f2f05,
+	0x376a05,
+	0x3abfc6,
+	0x215c45,
+	0x2d4684,
+	0x209202,
+	0x2e81c3,
+	0x371dca,
+	0x3a24c3,
+	0x271549,
+	0x30b0c6,
+	0x217f88,
+	0x28c2c6,
+	0x224d87,
+	0x2e8788,
+	0x2f2d08,
+	0x319043,
+	0x373143,
+	0x22a3c9,
+	0x2f61c3,
+	0x349646,
+	0x25b686,
+	0x2445c6,
+	0x397389,
+	0x2ffd44,
+	0x216c43,
+	0x2dbd45,
+	0x30c709,
+	0x22d103,
+	0x2fc404,
+	0x362ac4,
+	0x212dc4,
+	0x294846,
+	0x20a403,
+	0x20a408,
+	0x255448,
+	0x2eb306,
+	0x2fac8b,
+	0x2fafc8,
+	0x2fb1cb,
+	0x2fdd89,
+	0x2fd687,
+	0x2fe208,
+	0x2fedc3,
+	0x2e94c6,
+	0x39ab47,
+	0x297445,
+	0x34d8c9,
+	0x34448d,
+	0x217dd1,
+	0x234905,
+	0x200882,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x2c8144,
+	0x250cc3,
+	0x202243,
+	0x220ec3,
+	0x24c083,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x232003,
+	0x24c083,
+	0x204703,
+	0x263c83,
+	0x20b803,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x232003,
+	0x24c083,
+	0x204703,
+	0x212dc2,
+	0x200141,
+	0x200882,
+	0x200001,
+	0x311602,
+	0x77a48,
+	0x21fc45,
+	0x200481,
+	0x2bf83,
+	0x200741,
+	0x200081,
+	0x201501,
+	0x234382,
+	0x36a504,
+	0x383603,
+	0x2007c1,
+	0x200901,
+	0x200041,
+	0x2001c1,
+	0x388e07,
+	0x2d49cf,
+	0x2cadc6,
+	0x2000c1,
+	0x26c046,
+	0x200341,
+	0x200cc1,
+	0x25040e,
+	0x200fc1,
+	0x204703,
+	0x200ac1,
+	0x26f285,
+	0x209202,
+	0x25b1c5,
+	0x200c01,
+	0x200241,
+	0x200a01,
+	0x2012c2,
+	0x2002c1,
+	0x201d01,
+	0x2041c1,
+	0x200781,
+	0x200641,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x20b743,
+	0x22bf83,
+	0x250cc3,
+	0x8ce48,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x14d9688,
+	0x77a48,
+	0x45684,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x24c083,
+	0x204703,
+	0x201e03,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x2c8144,
+	0x204703,
+	0x297985,
+	0x325c04,
+	0x22bf83,
+	0x24c083,
+	0x204703,
+	0x206a82,
+	0x22bf83,
+	0x230509,
+	0x231b03,
+	0x23db49,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x2e1a48,
+	0x21d847,
+	0x2ebf05,
+	0x200707,
+	0x20540b,
+	0x204f48,
+	0x340a09,
+	0x2286c7,
+	0x204488,
+	0x2598c6,
+	0x235947,
+	0x227c88,
+	0x33b906,
+	0x323887,
+	0x235089,
+	0x37ab09,
+	0x2b7406,
+	0x2b9385,
+	0x2c3b08,
+	0x24e2c3,
+	0x2cac08,
+	0x231d07,
+	0x209d83,
+	0x358107,
+	0x215c45,
+	0x2dd2c8,
+	0x264905,
+	0x373143,
+	0x2c7b49,
+	0x2a9bc7,
+	0x2fc404,
+	0x362ac4,
+	0x2fac8b,
+	0x2fafc8,
+	0x2fd687,
+	0x22bf83,
+	0x231b03,
+	0x20f583,
+	0x204703,
+	0x22d3c3,
+	0x250cc3,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x200882,
+	0x206a82,
+	0x204703,
+	0x77a48,
+	0x200882,
+	0x206a82,
+	0x20f582,
+	0x202002,
+	0x200342,
+	0x24c083,
+	0x200fc2,
+	0x200882,
+	0x327883,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x20f582,
+	0x250cc3,
+	0x202243,
+	0x220ec3,
+	0x211004,
+	0x24c083,
+	0x21a883,
+	0x204703,
+	0x2ffd44,
+	0x2232c3,
+	0x250cc3,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x2020c3,
+	0x204703,
+	0x39c8c7,
+	0x22bf83,
+	0x2555c7,
+	0x263506,
+	0x203983,
+	0x210c43,
+	0x250cc3,
+	0x2037c3,
+	0x2023c4,
+	0x377844,
+	0x2d4746,
+	0x250403,
+	0x24c083,
+	0x204703,
+	0x297985,
+	0x20d644,
+	0x317f43,
+	0x2273c3,
+	0x2bde07,
+	0x2e34c5,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x209ec2,
+	0x34ad43,
+	0x237643,
+	0x327883,
+	0x5522bf83,
+	0x200d02,
+	0x231b03,
+	0x209d03,
+	0x250cc3,
+	0x2023c4,
+	0x265603,
+	0x2d2a83,
+	0x220ec3,
+	0x211004,
+	0x5560dbc2,
+	0x24c083,
+	0x204703,
+	0x22a543,
+	0x2468c3,
+	0x212dc2,
+	0x2232c3,
+	0x77a48,
+	0x250cc3,
+	0x2cf584,
+	0x327883,
+	0x206a82,
+	0x22bf83,
+	0x234a44,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x202243,
+	0x2ce884,
+	0x30db04,
+	0x2cc586,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x21d603,
+	0x26ed46,
+	0x18b4b,
+	0x1c106,
+	0x2310a,
+	0xfee8a,
+	0x77a48,
+	0x2036c4,
+	0x22bf83,
+	0x327844,
+	0x231b03,
+	0x24a304,
+	0x250cc3,
+	0x2614c3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x32104b,
+	0x39e40a,
+	0x3b0a4c,
+	0x200882,
+	0x206a82,
+	0x20f582,
+	0x2a8e05,
+	0x2023c4,
+	0x20f842,
+	0x220ec3,
+	0x30db04,
+	0x202b42,
+	0x200fc2,
+	0x203682,
+	0x212dc2,
+	0x127883,
+	0x35ecc9,
+	0x25b508,
+	0x33a1c9,
+	0x235789,
+	0x23cc0a,
+	0x255f4a,
+	0x20a1c2,
+	0x21eb82,
+	0x6a82,
+	0x22bf83,
+	0x208942,
+	0x240e86,
+	0x356b42,
+	0x218982,
+	0x27184e,
+	0x2751ce,
+	0x27f787,
+	0x3804c7,
+	0x274802,
+	0x231b03,
+	0x250cc3,
+	0x201b42,
+	0x202002,
+	0x233fcf,
+	0x205482,
+	0x23bb07,
+	0x33bb07,
+	0x365587,
+	0x24dbcc,
+	0x253f4c,
+	0x204b44,
+	0x28148a,
+	0x28da82,
+	0x20ac82,
+	0x2b1f04,
+	0x2266c2,
+	0x2bc342,
+	0x254184,
+	0x21a982,
+	0x217382,
+	0x33b987,
+	0x27fec5,
+	0x233a82,
+	0x233f44,
+	0x36f542,
+	0x2ce2c8,
+	0x24c083,
+	0x3b2248,
+	0x201082,
+	0x232fc5,
+	0x324146,
+	0x204703,
+	0x20a742,
+	0x2ddcc7,
+	0x9202,
+	0x274b05,
+	0x393f45,
+	0x2040c2,
+	0x22b382,
+	0x31650a,
+	0x26c60a,
+	0x20b5c2,
+	0x320784,
+	0x201f02,
+	0x238608,
+	0x20a302,
+	0x33b148,
+	0x2f7bc7,
+	0x2f7ec9,
+	0x274b82,
+	0x2fdfc5,
+	0x25b9c5,
+	0x2c13cb,
+	0x2c210c,
+	0x22ce88,
+	0x2fe388,
+	0x25b3c2,
+	0x20fc82,
+	0x200882,
+	0x77a48,
+	0x206a82,
+	0x22bf83,
+	0x20f582,
+	0x202b42,
+	0x200fc2,
+	0x204703,
+	0x203682,
+	0x200882,
+	0x57606a82,
+	0x57a50cc3,
+	0x39a883,
+	0x20f842,
+	0x24c083,
+	0x3a2c03,
+	0x204703,
+	0x2d9f83,
+	0x274846,
+	0x160b803,
+	0x77a48,
+	0x62145,
+	0x6ae47,
+	0x58200182,
+	0x58600ec2,
+	0x58a01e02,
+	0x58e02902,
+	0x592136c2,
+	0x59601482,
+	0x59a06a82,
+	0x59e0e542,
+	0x5a221982,
+	0x5a601582,
+	0x2751c3,
+	0x201503,
+	0x5aa17982,
+	0x5ae01c82,
+	0x49507,
+	0x5b233602,
+	0x5b600902,
+	0x5ba048c2,
+	0x5be0b542,
+	0x5c204982,
+	0x5c602002,
+	0xbac45,
+	0x226103,
+	0x20b504,
+	0x5ca266c2,
+	0x5ce35c82,
+	0x5d200102,
+	0x7c80b,
+	0x5d600982,
+	0x5de0ad82,
+	0x5e20f842,
+	0x5e600342,
+	0x5ea50702,
+	0x5ee02ac2,
+	0x5f203642,
+	0x5f608b82,
+	0x5fa0dbc2,
+	0x5fe00cc2,
+	0x60202b42,
+	0x606353c2,
+	0x60a02d82,
+	0x60e43482,
+	0x14c104,
+	0x2d2fc3,
+	0x61211382,
+	0x61619d02,
+	0x61a052c2,
+	0x61e03502,
+	0x62200fc2,
+	0x62609242,
+	0xdbc07,
+	0x62a08582,
+	0x62e05fc2,
+	0x63203682,
+	0x6364ecc2,
+	0xed54c,
+	0x63a1c642,
+	0x63e75bc2,
+	0x642076c2,
+	0x64601242,
+	0x64a0c402,
+	0x64e3cd82,
+	0x65201d02,
+	0x65603b82,
+	0x65a777c2,
+	0x65e4ffc2,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x5da65603,
+	0x2805c3,
+	0x229944,
+	0x25b406,
+	0x2e8283,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x200482,
+	0x200482,
+	0x265603,
+	0x2805c3,
+	0x6662bf83,
+	0x231b03,
+	0x331043,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x77a48,
+	0x206a82,
+	0x22bf83,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x247204,
+	0x206a82,
+	0x22bf83,
+	0x2cfe43,
+	0x231b03,
+	0x248fc4,
+	0x20f583,
+	0x250cc3,
+	0x2023c4,
+	0x202243,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x2298c3,
+	0x2ebf05,
+	0x237d03,
+	0x2232c3,
+	0x206a82,
+	0x22bf83,
+	0x265603,
+	0x24c083,
+	0x204703,
+	0x200882,
+	0x327883,
+	0x77a48,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x22fb86,
+	0x2023c4,
+	0x202243,
+	0x211004,
+	0x24c083,
+	0x204703,
+	0x21d603,
+	0x22bf83,
+	0x231b03,
+	0x24c083,
+	0x204703,
+	0x22bf83,
+	0x1c106,
+	0x231b03,
+	0x250cc3,
+	0xd19c6,
+	0x24c083,
+	0x204703,
+	0x309748,
+	0x30d009,
+	0x319509,
+	0x329dc8,
+	0x37d6c8,
+	0x37d6c9,
+	0x34385,
+	0x200882,
+	0x2e3305,
+	0x22fc03,
+	0x69206a82,
+	0x231b03,
+	0x250cc3,
+	0x22c947,
+	0x2387c3,
+	0x220ec3,
+	0x24c083,
+	0x20f543,
+	0x213583,
+	0x2020c3,
+	0x204703,
+	0x23b346,
+	0x2012c2,
+	0x2232c3,
+	0x77a48,
+	0x200882,
+	0x327883,
+	0x206a82,
+	0x22bf83,
+	0x231b03,
+	0x250cc3,
+	0x2023c4,
+	0x220ec3,
+	0x24c083,
+	0x204703,
+	0x20b803,
+}
+
+// children is the list of nodes' children, the parent's wildcard bit and the
+// parent's node type. If a node has no children then their children index
+// will be in the range [0, 6), depending on the wildcard bit and node type.
+//
+// The layout within the uint32, from MSB to LSB, is:
+//	[ 1 bits] unused
+//	[ 1 bits] wildcard bit
+//	[ 2 bits] node type
+//	[14 bits] high nodes index (exclusive) of children
+//	[14 bits] low nodes index (inclusive) of children
+var children = [...]uint32{
+	0x0,
+	0x10000000,
+	0x20000000,
+	0x40000000,
+	0x50000000,
+	0x60000000,
+	0x1858610,
+	0x185c616,
+	0x187c617,
+	0x19d861f,
+	0x19ec676,
+	0x1a0067b,
+	0x1a10680,
+	0x1a2c684,
+	0x1a3068b,
+	0x1a4868c,
+	0x1a6c692,
+	0x1a7069b,
+	0x1a8869c,
+	0x1a8c6a2,
+	0x1aa86a3,
+	0x1aac6aa,
+	0x1af46ab,
+	0x1af86bd,
+	0x1b186be,
+	0x1b2c6c6,
+	0x1b306cb,
+	0x1b606cc,
+	0x1b7c6d8,
+	0x1ba46df,
+	0x1bac6e9,
+	0x1bb06eb,
+	0x1c446ec,
+	0x1c58711,
+	0x1c6c716,
+	0x1c9871b,
+	0x1ca8726,
+	0x1cbc72a,
+	0x1ce072f,
+	0x1df8738,
+	0x1dfc77e,
+	0x1e1077f,
+	0x1e24784,
+	0x1e2c789,
+	0x1e3c78b,
+	0x1e4078f,
+	0x1e58790,
+	0x1ea0796,
+	0x1eb47a8,
+	0x1eb87ad,
+	0x1ebc7ae,
+	0x1ec47af,
+	0x1f007b1,
+	0x61f047c0,
+	0x1f187c1,
+	0x1f1c7c6,
+	0x1f2c7c7,
+	0x1fdc7cb,
+	0x1fe07f7,
+	0x21fe87f8,
+	0x21fec7fa,
+	0x1ff07fb,
+	0x20247fc,
+	0x2028809,
+	0x244880a,
+	0x22498912,
+	0x2249c926,
+	0x24c4927,
+	0x24cc931,
+	0x224d0933,
+	0x24d8934,
+	0x224e8936,
+	0x224ec93a,
+	0x24f893b,
+	0x24fc93e,
+	0x2250093f,
+	0x251c940,
+	0x2534947,
+	0x253894d,
+	0x254894e,
+	0x2550952,
+	0x22584954,
+	0x2588961,
+	0x2598962,
+	0x25c4966,
+	0x25dc971,
+	0x25f0977,
+	0x261897c,
+	0x2638986,
+	0x266898e,
+	0x269099a,
+	0x26949a4,
+	0x26b89a5,
+	0x26bc9ae,
+	0x26d09af,
+	0x26d49b4,
+	0x26d89b5,
+	0x26f89b6,
+	0x26fc9be,
+	0x270c9bf,
+	0x27809c3,
+	0x279c9e0,
+	0x27a89e7,
+	0x27bc9ea,
+	0x27d49ef,
+	0x27e89f5,
+	0x28009fa,
+	0x2818a00,
+	0x2830a06,
+	0x284ca0c,
+	0x2864a13,
+	0x28c4a19,
+	0x28dca31,
+	0x28f0a37,
+	0x2934a3c,
+	0x29b4a4d,
+	0x29e0a6d,
+	0x29e4a78,
+	0x29eca79,
+	0x2a0ca7b,
+	0x2a10a83,
+	0x2a2ca84,
+	0x2a34a8b,
+	0x2a68a8d,
+	0x2aa0a9a,
+	0x2aa4aa8,
+	0x2ad0aa9,
+	0x2ae8ab4,
+	0x2b0caba,
+	0x2b2cac3,
+	0x30f0acb,
+	0x30fcc3c,
+	0x311cc3f,
+	0x32d8c47,
+	0x33a8cb6,
+	0x3418cea,
+	0x3470d06,
+	0x3558d1c,
+	0x35b0d56,
+	0x35ecd6c,
+	0x36e8d7b,
+	0x37b4dba,
+	0x384cded,
+	0x38dce13,
+	0x3940e37,
+	0x3b78e50,
+	0x3c30ede,
+	0x3cfcf0c,
+	0x3d48f3f,
+	0x3dd0f52,
+	0x3e0cf74,
+	0x3e5cf83,
+	0x3ed4f97,
+	0x63ed8fb5,
+	0x63edcfb6,
+	0x63ee0fb7,
+	0x3f5cfb8,
+	0x3fc0fd7,
+	0x403cff0,
+	0x40b500f,
+	0x413502d,
+	0x41a104d,
+	0x42cd068,
+	0x43250b3,
+	0x643290c9,
+	0x43c10ca,
+	0x44490f0,
+	0x4495112,
+	0x44fd125,
+	0x45a513f,
+	0x466d169,
+	0x46d519b,
+	0x47e91b5,
+	0x647ed1fa,
+	0x647f11fb,
+	0x484d1fc,
+	0x48a9213,
+	0x493922a,
+	0x49b524e,
+	0x49f926d,
+	0x4add27e,
+	0x4b112b7,
+	0x4b712c4,
+	0x4be52dc,
+	0x4c6d2f9,
+	0x4cad31b,
+	0x4d1d32b,
+	0x64d21347,
+	0x64d25348,
+	0x24d29349,
+	0x4d4134a,
+	0x4d5d350,
+	0x4da1357,
+	0x4db1368,
+	0x4dc936c,
+	0x4e41372,
+	0x4e55390,
+	0x4e6d395,
+	0x4e9139b,
+	0x4ea53a4,
+	0x4ec13a9,
+	0x4ec53b0,
+	0x4ecd3b1,
+	0x4f093b3,
+	0x4f1d3c2,
+	0x4f253c7,
+	0x4f2d3c9,
+	0x4f313cb,
+	0x4f553cc,
+	0x4f793d5,
+	0x4f913de,
+	0x4f953e4,
+	0x4f9d3e5,
+	0x4fa13e7,
+	0x4ff53e8,
+	0x50193fd,
+	0x5039406,
+	0x505540e,
+	0x5065415,
+	0x5079419,
+	0x507d41e,
+	0x508541f,
+	0x5099421,
+	0x50a9426,
+	0x50ad42a,
+	0x50c942b,
+	0x5959432,
+	0x5991656,
+	0x59bd664,
+	0x59d566f,
+	0x59f5675,
+	0x659f967d,
+	0x5a3d67e,
+	0x5a4568f,
+	0x25a49691,
+	0x25a4d692,
+	0x5a51693,
+	0x5b71694,
+	0x25b756dc,
+	0x25b7d6dd,
+	0x25b856df,
+	0x25b916e1,
+	0x5b956e4,
+	0x5bbd6e5,
+	0x5be56ef,
+	0x5be96f9,
+	0x25c216fa,
+	0x5c31708,
+	0x678970c,
+	0x678d9e2,
+	0x67919e3,
+	0x267959e4,
+	0x67999e5,
+	0x2679d9e6,
+	0x67a19e7,
+	0x267ad9e8,
+	0x67b19eb,
+	0x67b59ec,
+	0x267b99ed,
+	0x67bd9ee,
+	0x267c59ef,
+	0x67c99f1,
+	0x67cd9f2,
+	0x267dd9f3,
+	0x67e19f7,
+	0x67e59f8,
+	0x67e99f9,
+	0x67ed9fa,
+	0x267f19fb,
+	0x67f59fc,
+	0x67f99fd,
+	0x67fd9fe,
+	0x68019ff,
+	0x26809a00,
+	0x680da02,
+	0x6811a03,
+	0x6815a04,
+	0x26819a05,
+	0x681da06,
+	0x26825a07,
+	0x26829a09,
+	0x6845a0a,
+	0x6851a11,
+	0x6891a14,
+	0x6895a24,
+	0x68b9a25,
+	0x69fda2e,
+	0x26a05a7f,
+	0x26a09a81,
+	0x26a0da82,
+	0x6a15a83,
+	0x6af1a85,
+	0x6af5abc,
+	0x6b21abd,
+	0x6b41ac8,
+	0x6b4dad0,
+	0x6b6dad3,
+	0x6ba5adb,
+	0x6e3dae9,
+	0x6ef9b8f,
+	0x6f0dbbe,
+	0x6f41bc3,
+	0x6f6dbd0,
+	0x6f89bdb,
+	0x6fadbe2,
+	0x6fc5beb,
+	0x6fe1bf1,
+	0x7005bf8,
+	0x7015c01,
+	0x7045c05,
+	0x7061c11,
+	0x726dc18,
+	0x7291c9b,
+	0x72b1ca4,
+	0x72c5cac,
+	0x72d9cb1,
+	0x72f9cb6,
+	0x739dcbe,
+	0x73b9ce7,
+	0x73d5cee,
+	0x73d9cf5,
+	0x73ddcf6,
+	0x73e1cf7,
+	0x73f5cf8,
+	0x7415cfd,
+	0x7421d05,
+	0x7451d08,
+	0x74d1d14,
+	0x74e5d34,
+	0x74e9d39,
+	0x7501d3a,
+	0x750dd40,
+	0x7511d43,
+	0x752dd44,
+	0x7569d4b,
+	0x756dd5a,
+	0x758dd5b,
+	0x75ddd63,
+	0x75f5d77,
+	0x7649d7d,
+	0x764dd92,
+	0x7651d93,
+	0x7695d94,
+	0x76a5da5,
+	0x76ddda9,
+	0x770ddb7,
+	0x7849dc3,
+	0x786de12,
+	0x7899e1b,
+	0x78a1e26,
+	0x78a5e28,
+	0x79ade29,
+	0x79b9e6b,
+	0x79c5e6e,
+	0x79d1e71,
+	0x79dde74,
+	0x79e9e77,
+	0x79f5e7a,
+	0x7a01e7d,
+	0x7a0de80,
+	0x7a19e83,
+	0x7a25e86,
+	0x7a31e89,
+	0x7a3de8c,
+	0x7a49e8f,
+	0x7a51e92,
+	0x7a5de94,
+	0x7a69e97,
+	0x7a75e9a,
+	0x7a81e9d,
+	0x7a8dea0,
+	0x7a99ea3,
+	0x7aa5ea6,
+	0x7ab1ea9,
+	0x7abdeac,
+	0x7ac9eaf,
+	0x7ad5eb2,
+	0x7ae1eb5,
+	0x7aedeb8,
+	0x7af9ebb,
+	0x7b05ebe,
+	0x7b11ec1,
+	0x7b1dec4,
+	0x7b25ec7,
+	0x7b31ec9,
+	0x7b3decc,
+	0x7b49ecf,
+	0x7b55ed2,
+	0x7b61ed5,
+	0x7b6ded8,
+	0x7b79edb,
+	0x7b85ede,
+	0x7b91ee1,
+	0x7b9dee4,
+	0x7ba9ee7,
+	0x7bb5eea,
+	0x7bc1eed,
+	0x7bc9ef0,
+	0x7bd5ef2,
+	0x7be1ef5,
+	0x7bedef8,
+	0x7bf9efb,
+	0x7c05efe,
+	0x7c11f01,
+	0x7c1df04,
+	0x7c29f07,
+	0x7c2df0a,
+	0x7c39f0b,
+	0x7c51f0e,
+	0x7c55f14,
+	0x7c65f15,
+	0x7c7df19,
+	0x7cc1f1f,
+	0x7cd5f30,
+	0x7d09f35,
+	0x7d19f42,
+	0x7d35f46,
+	0x7d4df4d,
+	0x7d51f53,
+	0x27d95f54,
+	0x7d99f65,
+	0x7dc5f66,
+}
+
+// max children 421 (capacity 511)
+// max text offset 27811 (capacity 32767)
+// max text length 36 (capacity 63)
+// max hi 8049 (capacity 16383)
+// max lo 8038 (capacity 16383)
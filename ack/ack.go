@@ -0,0 +1,47 @@
+// Package ack tracks when all the work derived from an input record is finished.
+package ack
+
+import "sync/atomic"
+
+// Token is a reference count on the work derived from a single input record. Every
+// stage which hands the record (or something derived from it) on to another stage
+// Holds the token for each copy it hands on, then Releases its own hold. Once the last
+// hold is released the token's done function is called.
+//
+// All methods are safe to call on a nil Token, which makes acknowledgement optional for
+// inputs that don't need it.
+type Token struct {
+	holds int64
+	done  func()
+}
+
+// New returns a Token with a single hold, owned by the caller, which calls done when
+// all holds have been released.
+func New(done func()) *Token {
+	return &Token{holds: 1, done: done}
+}
+
+// Hold adds a hold to the token.
+func (t *Token) Hold() {
+	if t == nil {
+		return
+	}
+	atomic.AddInt64(&t.holds, 1)
+}
+
+// Release releases a hold on the token, calling its done function if it was the last.
+func (t *Token) Release() {
+	if t == nil {
+		return
+	}
+	if atomic.AddInt64(&t.holds, -1) == 0 {
+		t.done()
+	}
+}
+
+// ReleaseAll releases one hold on each of the given tokens.
+func ReleaseAll(tokens []*Token) {
+	for _, t := range tokens {
+		t.Release()
+	}
+}
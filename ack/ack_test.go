@@ -0,0 +1,26 @@
+package ack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToken(t *testing.T) {
+	done := 0
+	token := New(func() { done++ })
+	token.Hold()
+	token.Hold()
+	token.Release()
+	token.Release()
+	assert.Equal(t, 0, done)
+	token.Release()
+	assert.Equal(t, 1, done)
+}
+
+func TestNilToken(t *testing.T) {
+	var token *Token
+	token.Hold()
+	token.Release()
+	ReleaseAll([]*Token{nil, nil})
+}
@@ -7,19 +7,27 @@ import (
 
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
+	"github.com/twitchscience/spade/ack"
 )
 
 // Complete is the type of a function that Batcher will
-// call for every completed batch
-type Complete func([][]byte)
+// call for every completed batch, along with the tokens
+// submitted with its entries
+type Complete func([][]byte, []*ack.Token)
+
+type entry struct {
+	data   []byte
+	tokens []*ack.Token
+}
 
 // A Batcher will batch togther a slice of byte slices, based
 // on a size and timer criteria
 type Batcher struct {
 	config         scoop_protocol.BatcherConfig
 	completor      Complete
-	incoming       chan []byte
+	incoming       chan entry
 	pending        [][]byte
+	pendingTokens  []*ack.Token
 	pendingSize    int
 	pendingEntries int
 	timer          *time.Timer
@@ -44,7 +52,7 @@ func New(config scoop_protocol.BatcherConfig, completor Complete) (*Batcher, err
 		completor: completor,
 		maxAge:    maxAge,
 		timer:     time.NewTimer(maxAge),
-		incoming:  make(chan []byte, config.BufferLength),
+		incoming:  make(chan entry, config.BufferLength),
 	}
 
 	b.Add(1)
@@ -52,9 +60,10 @@ func New(config scoop_protocol.BatcherConfig, completor Complete) (*Batcher, err
 	return b, nil
 }
 
-// Submit submits an object to be batched
-func (b *Batcher) Submit(entry []byte) {
-	b.incoming <- entry
+// Submit submits an object to be batched, taking over the caller's
+// holds on the given tokens until the batch is completed
+func (b *Batcher) Submit(e []byte, tokens ...*ack.Token) {
+	b.incoming <- entry{data: e, tokens: tokens}
 }
 
 // Close closes the batcher. Will return after all
@@ -64,8 +73,8 @@ func (b *Batcher) Close() {
 	b.Wait()
 }
 
-func (b *Batcher) add(entry []byte) {
-	s := len(entry) + b.pendingSize
+func (b *Batcher) add(e entry) {
+	s := len(e.data) + b.pendingSize
 	if s > b.config.MaxSize ||
 		(b.config.MaxEntries != -1 && b.pendingEntries >= b.config.MaxEntries) {
 		b.complete()
@@ -75,8 +84,9 @@ func (b *Batcher) add(entry []byte) {
 		b.timer.Reset(b.maxAge)
 	}
 
-	b.pending = append(b.pending, entry)
-	b.pendingSize += len(entry)
+	b.pending = append(b.pending, e.data)
+	b.pendingTokens = append(b.pendingTokens, e.tokens...)
+	b.pendingSize += len(e.data)
 	b.pendingEntries++
 }
 
@@ -85,8 +95,9 @@ func (b *Batcher) complete() {
 		return
 	}
 
-	b.completor(b.pending)
+	b.completor(b.pending, b.pendingTokens)
 	b.pending = nil
+	b.pendingTokens = nil
 	b.pendingSize = 0
 	b.pendingEntries = 0
 }
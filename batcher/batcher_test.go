@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
+	"github.com/twitchscience/spade/ack"
 )
 
 var (
@@ -52,7 +53,7 @@ func TestBatcher(t *testing.T) {
 
 	var result [][]byte
 
-	b, err := New(config, func(batch [][]byte) {
+	b, err := New(config, func(batch [][]byte, _ []*ack.Token) {
 		result = batch
 	})
 
@@ -76,7 +77,7 @@ func TestTimeout(t *testing.T) {
 
 	result := make(chan [][]byte)
 
-	b, err := New(config, func(batch [][]byte) {
+	b, err := New(config, func(batch [][]byte, _ []*ack.Token) {
 		result <- batch
 	})
 
@@ -100,7 +101,7 @@ func TestSizeLimit(t *testing.T) {
 
 	result := make(chan [][]byte, 10)
 
-	b, err := New(config, func(batch [][]byte) {
+	b, err := New(config, func(batch [][]byte, _ []*ack.Token) {
 		result <- batch
 	})
 
@@ -123,7 +124,7 @@ func TestMaxEntryLimit(t *testing.T) {
 
 	result := make(chan [][]byte, 10)
 
-	b, err := New(config, func(batch [][]byte) {
+	b, err := New(config, func(batch [][]byte, _ []*ack.Token) {
 		result <- batch
 	})
 
@@ -135,3 +136,26 @@ func TestMaxEntryLimit(t *testing.T) {
 	assert.True(t, len(<-result) <= 2)
 	b.Close()
 }
+
+func TestTokensFollowEntries(t *testing.T) {
+	config := scoop_protocol.BatcherConfig{
+		MaxSize:      1 * 1024 * 1024,
+		MaxEntries:   2,
+		MaxAge:       "1m",
+		BufferLength: 5,
+	}
+
+	var batchTokens [][]*ack.Token
+	b, err := New(config, func(batch [][]byte, tokens []*ack.Token) {
+		batchTokens = append(batchTokens, tokens)
+	})
+	assert.NoError(t, err)
+
+	first, second := ack.New(func() {}), ack.New(func() {})
+	b.Submit(expected[0], first)
+	b.Submit(expected[1])
+	b.Submit(expected[2], first, second)
+	b.Close()
+
+	assert.Equal(t, [][]*ack.Token{{first}, {first, second}}, batchTokens)
+}
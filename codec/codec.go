@@ -0,0 +1,183 @@
+// Package codec holds the compression codecs used for globs. The first byte of every glob
+// is a version which says which codec compressed the rest.
+package codec
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Versions of the built in codecs.
+const (
+	Flate  byte = 1
+	Gzip   byte = 2
+	Zstd   byte = 3
+	Snappy byte = 4
+)
+
+// Codec compresses and decompresses streams.
+type Codec interface {
+	// NewWriter returns a writer compressing into w. If the writer has a Reset(io.Writer)
+	// method, it may be reused after Close by calling Reset.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+type registration struct {
+	name  string
+	codec Codec
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[byte]registration{}
+)
+
+func init() {
+	Register(Flate, "flate", flateCodec{})
+	Register(Gzip, "gzip", gzipCodec{})
+	Register(Zstd, "zstd", zstdCodec{})
+	Register(Snappy, "snappy", snappyCodec{})
+}
+
+// Register makes a codec available under the given version and name. It panics if either
+// is already taken.
+func Register(version byte, name string, c Codec) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for v, r := range registry {
+		if v == version || r.name == name {
+			panic(fmt.Sprintf("codec: version %d or name %s registered twice", version, name))
+		}
+	}
+	registry[version] = registration{name: name, codec: c}
+}
+
+// Lookup returns the codec for a version.
+func Lookup(version byte) (Codec, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	r, ok := registry[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown compression version %d", version)
+	}
+	return r.codec, nil
+}
+
+// VersionOf returns the version of the codec with the given name; the empty name is flate.
+func VersionOf(name string) (byte, error) {
+	if name == "" {
+		return Flate, nil
+	}
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for v, r := range registry {
+		if r.name == name {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown codec %q, expected one of %v", name, names())
+}
+
+func names() []string {
+	var n []string
+	for _, r := range registry {
+		n = append(n, r.name)
+	}
+	sort.Strings(n)
+	return n
+}
+
+// Compress compresses data with the codec of the given version, prefixed by the version.
+func Compress(version byte, data []byte) ([]byte, error) {
+	c, err := Lookup(version)
+	if err != nil {
+		return nil, err
+	}
+	compressed := bytes.NewBuffer([]byte{version})
+	w, err := c.NewWriter(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("creating compressor: %v", err)
+	}
+	if _, err = w.Write(data); err != nil {
+		return nil, fmt.Errorf("compressing: %v", err)
+	}
+	if err = w.Close(); err != nil {
+		return nil, fmt.Errorf("compressing: %v", err)
+	}
+	return compressed.Bytes(), nil
+}
+
+// Decompress decompresses data prefixed by its version, as produced by Compress.
+func Decompress(compressed []byte) (data []byte, err error) {
+	if len(compressed) == 0 {
+		return nil, fmt.Errorf("missing version byte")
+	}
+	c, err := Lookup(compressed[0])
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.NewReader(bytes.NewReader(compressed[1:]))
+	if err != nil {
+		return nil, fmt.Errorf("creating decompressor: %v", err)
+	}
+	defer func() {
+		if cerr := r.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("closing decompressor: %v", cerr)
+		}
+	}()
+	return ioutil.ReadAll(r)
+}
+
+type flateCodec struct{}
+
+func (flateCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return flate.NewWriter(w, flate.BestSpeed)
+}
+
+func (flateCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return flate.NewReader(r), nil
+}
+
+type gzipCodec struct{}
+
+func (gzipCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriterLevel(w, gzip.BestSpeed)
+}
+
+func (gzipCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+type zstdCodec struct{}
+
+func (zstdCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+}
+
+func (zstdCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+type snappyCodec struct{}
+
+func (snappyCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return snappy.NewBufferedWriter(w), nil
+}
+
+func (snappyCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return ioutil.NopCloser(snappy.NewReader(r)), nil
+}
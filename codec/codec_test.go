@@ -0,0 +1,71 @@
+package codec
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte(`{"event":"test"},`), 100)
+	for _, version := range []byte{Flate, Gzip, Zstd, Snappy} {
+		compressed, err := Compress(version, data)
+		require.Nil(t, err)
+		assert.Equal(t, version, compressed[0])
+		assert.True(t, len(compressed) < len(data))
+
+		decompressed, err := Decompress(compressed)
+		require.Nil(t, err)
+		assert.Equal(t, data, decompressed)
+	}
+}
+
+// Test that writers can be reused after Reset, as the globber does.
+func TestReset(t *testing.T) {
+	for _, version := range []byte{Flate, Gzip, Zstd, Snappy} {
+		c, err := Lookup(version)
+		require.Nil(t, err)
+		var first, second bytes.Buffer
+		w, err := c.NewWriter(&first)
+		require.Nil(t, err)
+		_, err = w.Write([]byte("one"))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+
+		w.(interface {
+			Reset(io.Writer)
+		}).Reset(&second)
+		_, err = w.Write([]byte("two"))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+
+		decompressed, err := Decompress(append([]byte{version}, second.Bytes()...))
+		require.Nil(t, err)
+		assert.Equal(t, "two", string(decompressed))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	_, err := Decompress(nil)
+	assert.NotNil(t, err)
+	_, err = Decompress([]byte{99, 1, 2})
+	assert.NotNil(t, err)
+	_, err = Decompress([]byte{Gzip, 1, 2})
+	assert.NotNil(t, err)
+	_, err = Compress(99, nil)
+	assert.NotNil(t, err)
+
+	v, err := VersionOf("")
+	require.Nil(t, err)
+	assert.Equal(t, Flate, v)
+	v, err = VersionOf("zstd")
+	require.Nil(t, err)
+	assert.Equal(t, Zstd, v)
+	_, err = VersionOf("lz4")
+	assert.NotNil(t, err)
+
+	assert.Panics(t, func() { Register(Flate, "other", flateCodec{}) })
+}
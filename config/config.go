@@ -12,8 +12,13 @@ import (
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
 	"github.com/twitchscience/spade/cache/elastimemcache"
 	"github.com/twitchscience/spade/consumer"
+	"github.com/twitchscience/spade/dedup"
 	"github.com/twitchscience/spade/geoip"
 	"github.com/twitchscience/spade/lookup"
+	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/pii"
+	"github.com/twitchscience/spade/transformer"
+	"github.com/twitchscience/spade/useragent"
 )
 
 // Config controls the processor's behavior.
@@ -42,14 +47,40 @@ type Config struct {
 	AceBucketName string
 	// NonTrackedBucketName is the name of the s3 bucket to put nontracked events into
 	NonTrackedBucketName string
+	// DeadLetterBucketName, if set, is the name of the s3 bucket to put events which failed processing into
+	DeadLetterBucketName string
 	// MaxLogBytes is the max number of log bytes before file rotation
 	MaxLogBytes int64
 	// MaxLogAgeSecs is the max number of seconds between log rotations
 	MaxLogAgeSecs int64
 	// NontrackedMaxLogAgeSecs is the max number of seconds between nontracked log rotations
 	NontrackedMaxLogAgeSecs int64
+	// DeadLetterMaxLogAgeSecs is the max number of seconds between dead letter log rotations;
+	// MaxLogAgeSecs if unset
+	DeadLetterMaxLogAgeSecs int64
 	// Consumer is the config for the kinesis based event consumer
 	Consumer consumer.Config
+	// DirectoryConsumer, if set, reads events from files in a directory instead of Kinesis
+	DirectoryConsumer *consumer.DirectoryConfig
+	// HTTPConsumer, if set, accepts events POSTed over HTTP instead of reading Kinesis
+	HTTPConsumer *consumer.HTTPConfig
+	// Dedup, if set, persists the UUIDs of events seen so duplicates are dropped individually
+	Dedup *dedup.Config
+	// Parser, if set, selects the parsers for edge events by record version; otherwise all
+	// events are parsed as JSON envelopes
+	Parser *parser.Config
+	// ClientIP, if set, resolves client IPs from X-Forwarded-For for requests which came to the
+	// edge through trusted proxies
+	ClientIP *parser.ClientIPConfig
+	// Transformer, if set, configures how values which don't fit their columns are handled
+	Transformer *transformer.Config
+	// ColumnFailures, if set, serves the report of column failures at :7766/column_failures
+	ColumnFailures *transformer.FailureReportConfig
+	// PII, if set, configures the transforms which hash or anonymize identifying values in
+	// columns and Kinesis fields
+	PII *pii.Config
+	// UserAgent, if set, configures the database used by the user agent transformers
+	UserAgent *useragent.Config
 	// Geoip is the config for the geoip updater
 	Geoip *geoip.Config
 	// RollbarToken is our token to authenticate with Rollbar
@@ -177,6 +208,41 @@ func validateAndPopulateConfig(cfg *Config, replay bool) error {
 		}
 	}
 
+	if cfg.DirectoryConsumer != nil {
+		if err := checkNonempty(cfg.DirectoryConsumer.Path); err != nil {
+			return fmt.Errorf("directory consumer path: %v", err)
+		}
+	}
+	if cfg.HTTPConsumer != nil {
+		if err := checkNonempty(cfg.HTTPConsumer.Address); err != nil {
+			return fmt.Errorf("http consumer address: %v", err)
+		}
+		if err := checkNonempty(cfg.HTTPConsumer.Secret); err != nil {
+			return fmt.Errorf("http consumer secret: %v", err)
+		}
+	}
+	if cfg.Dedup != nil {
+		if err := checkNonempty(cfg.Dedup.Table); err != nil {
+			return fmt.Errorf("dedup table: %v", err)
+		}
+		if cfg.Dedup.Window.Duration <= 0 {
+			return errors.New("dedup window must be positive")
+		}
+	}
+	if cfg.Transformer != nil {
+		if err := cfg.Transformer.Validate(); err != nil {
+			return fmt.Errorf("transformer: %v", err)
+		}
+	}
+	if cfg.PII != nil {
+		if err := cfg.PII.Validate(); err != nil {
+			return fmt.Errorf("pii: %v", err)
+		}
+	}
+	if cfg.DirectoryConsumer != nil && cfg.HTTPConsumer != nil {
+		return errors.New("at most one of DirectoryConsumer and HTTPConsumer may be set")
+	}
+
 	for _, i := range []int64{
 		cfg.MaxLogBytes,
 		cfg.MaxLogAgeSecs,
@@ -189,9 +255,14 @@ func validateAndPopulateConfig(cfg *Config, replay bool) error {
 		}
 	}
 
+	if cfg.DeadLetterMaxLogAgeSecs == 0 {
+		cfg.DeadLetterMaxLogAgeSecs = cfg.MaxLogAgeSecs
+	}
+
 	for _, i := range []int64{
 		cfg.KinesisWriterErrorThrottlePeriodSeconds,
 		int64(cfg.KinesisWriterErrorsBeforeThrottling),
+		cfg.DeadLetterMaxLogAgeSecs,
 	} {
 		if i < 0 {
 			return errors.New(
@@ -221,6 +292,17 @@ func validateAndPopulateConfig(cfg *Config, replay bool) error {
 		if err := ko.Validate(cfg.KinesisFilterFuncs); err != nil {
 			return err
 		}
+		if cfg.PII != nil {
+			continue
+		}
+		for name, event := range ko.Events {
+			for field, transform := range event.FieldTransforms {
+				if transform == "hmac" {
+					return fmt.Errorf("kinesis output %s hashes %s.%s, but PII.HashSalt isn't set",
+						ko.StreamName, name, field)
+				}
+			}
+		}
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package consumer
 
 import (
 	"bufio"
+	"container/list"
 	"fmt"
 	"io"
 	"os"
@@ -14,6 +15,7 @@ import (
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/kinsumer"
 	kstatsd "github.com/twitchscience/kinsumer/statsd"
+	"github.com/twitchscience/spade/ack"
 )
 
 // Config is used to set configuration variables for the Consumer
@@ -42,6 +44,8 @@ type Config struct {
 type Result struct {
 	Data  []byte
 	Error error
+	// Token, if not nil, must be released once Data has been durably written.
+	Token *ack.Token
 }
 
 // ResultPipe consumes input from somewhere and provides Results through its ReadChannel.
@@ -87,7 +91,9 @@ func (c *StandardInputPipe) ReadChannel() <-chan *Result {
 // Close does nothing, as standard input closes automatically on EOF.
 func (c *StandardInputPipe) Close() {}
 
-// KinesisPipe is a ResultPipe that consumes globs of events from Kinesis.
+// KinesisPipe is a ResultPipe that consumes globs of events from Kinesis. A record is only
+// checkpointed once its Result's Token, and those of every record read before it from its
+// shard, have been released.
 type KinesisPipe struct {
 	// C is used to read records off the kinsumer queue
 	C <-chan *Result
@@ -97,9 +103,62 @@ type KinesisPipe struct {
 
 	closer   chan struct{}
 	kinsumer *kinsumer.Kinsumer
+	tracker  checkpointTracker
 	sync.WaitGroup
 }
 
+// checkpointTracker calls the checkpoint functions of each shard's records in the order they
+// were read, holding each back until it and every record before it from its shard have been
+// acknowledged. A record's checkpoint covers the records before it, so an acknowledged record
+// is dropped once the next record is acknowledged too; a shard waiting on a record holds at
+// most one acknowledged record after each unacknowledged one.
+type checkpointTracker struct {
+	// shards holds the pendingCheckpoints of each shard, in the order they were read
+	shards map[string]*list.List
+	sync.Mutex
+}
+
+type pendingCheckpoint struct {
+	checkpoint func()
+	acked      bool
+}
+
+// add starts tracking a record of the shard, returning the token which acknowledges it.
+func (t *checkpointTracker) add(shardID string, checkpoint func()) *ack.Token {
+	t.Lock()
+	if t.shards == nil {
+		t.shards = make(map[string]*list.List)
+	}
+	pending, ok := t.shards[shardID]
+	if !ok {
+		pending = list.New()
+		t.shards[shardID] = pending
+	}
+	e := pending.PushBack(&pendingCheckpoint{checkpoint: checkpoint})
+	t.Unlock()
+	return ack.New(func() { t.ack(shardID, e) })
+}
+
+func (t *checkpointTracker) ack(shardID string, e *list.Element) {
+	t.Lock()
+	defer t.Unlock()
+	pending := t.shards[shardID]
+	e.Value.(*pendingCheckpoint).acked = true
+	if prev := e.Prev(); prev != nil && prev.Value.(*pendingCheckpoint).acked {
+		pending.Remove(prev)
+	}
+	if next := e.Next(); next != nil && next.Value.(*pendingCheckpoint).acked {
+		pending.Remove(e)
+	}
+	for front := pending.Front(); front != nil && front.Value.(*pendingCheckpoint).acked; front = pending.Front() {
+		front.Value.(*pendingCheckpoint).checkpoint()
+		pending.Remove(front)
+	}
+	if pending.Len() == 0 {
+		delete(t.shards, shardID)
+	}
+}
+
 func configEntryToDuration(entry string) (time.Duration, error) {
 	if len(entry) == 0 {
 		return 0, nil
@@ -154,7 +213,7 @@ func NewKinesisPipe(kinesis kinesisiface.KinesisAPI, dynamodb dynamodbiface.Dyna
 		return nil, err
 	}
 
-	kinsumerConfig = kinsumerConfig.WithStats(kstatsd.NewWithStatter(stats))
+	kinsumerConfig = kinsumerConfig.WithStats(kstatsd.NewWithStatter(stats)).WithManualCheckpoints(true)
 	hostname, err := os.Hostname()
 	if err != nil {
 		return nil, err
@@ -194,11 +253,15 @@ func NewKinesisPipe(kinesis kinesisiface.KinesisAPI, dynamodb dynamodbiface.Dyna
 
 func (c *KinesisPipe) crank() {
 	for {
-		d, err := c.kinsumer.Next()
+		d, shardID, checkpoint, err := c.kinsumer.NextWithCheckpointer()
+		var token *ack.Token
+		if checkpoint != nil {
+			token = c.tracker.add(shardID, checkpoint)
+		}
 		select {
 		case <-c.closer:
 			return
-		case c.send <- &Result{Data: d, Error: err}:
+		case c.send <- &Result{Data: d, Error: err, Token: token}:
 		}
 	}
 }
@@ -208,7 +271,8 @@ func (c *KinesisPipe) ReadChannel() <-chan *Result {
 	return c.C
 }
 
-// Close closes down Kinesis consumption.
+// Close closes down Kinesis consumption, committing the checkpoints of all records whose
+// Tokens have been released. Records still in flight will be consumed again.
 func (c *KinesisPipe) Close() {
 	if c.kinsumer != nil {
 		c.kinsumer.Stop()
@@ -0,0 +1,55 @@
+package consumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpointTrackerInOrder(t *testing.T) {
+	var checkpointed []int
+	var tracker checkpointTracker
+	first := tracker.add("shard-0", func() { checkpointed = append(checkpointed, 1) })
+	second := tracker.add("shard-0", func() { checkpointed = append(checkpointed, 2) })
+	third := tracker.add("shard-0", func() { checkpointed = append(checkpointed, 3) })
+
+	second.Release()
+	assert.Empty(t, checkpointed, "second must wait for first")
+
+	first.Hold()
+	first.Release()
+	assert.Empty(t, checkpointed, "first is still held")
+
+	first.Release()
+	assert.Equal(t, []int{2}, checkpointed, "second's checkpoint covers first")
+
+	third.Release()
+	assert.Equal(t, []int{2, 3}, checkpointed)
+	assert.Empty(t, tracker.shards)
+}
+
+func TestCheckpointTrackerShards(t *testing.T) {
+	var checkpointed []string
+	var tracker checkpointTracker
+	stuck := tracker.add("shard-0", func() { checkpointed = append(checkpointed, "stuck") })
+	var later []func()
+	for i := 0; i < 100; i++ {
+		token := tracker.add("shard-0", func() { checkpointed = append(checkpointed, "later") })
+		later = append(later, token.Release)
+	}
+	other := tracker.add("shard-1", func() { checkpointed = append(checkpointed, "other") })
+
+	other.Release()
+	assert.Equal(t, []string{"other"}, checkpointed, "a shard shouldn't wait on another's records")
+
+	for _, release := range later {
+		release()
+	}
+	assert.Equal(t, []string{"other"}, checkpointed, "records wait on their shard's earlier records")
+	assert.Equal(t, 2, tracker.shards["shard-0"].Len(),
+		"only the latest acknowledged record after a held one should be kept")
+
+	stuck.Release()
+	assert.Equal(t, []string{"other", "later"}, checkpointed)
+	assert.Empty(t, tracker.shards)
+}
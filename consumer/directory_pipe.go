@@ -0,0 +1,351 @@
+package consumer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twitchscience/aws_utils/logger"
+	"github.com/twitchscience/spade/ack"
+)
+
+const (
+	defaultDirectoryPollInterval        = time.Second
+	defaultDirectoryCheckpointFrequency = 10 * time.Second
+	defaultDirectoryCheckpointFile      = ".spade_checkpoint.json"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// DirectoryConfig is used to configure a DirectoryPipe.
+type DirectoryConfig struct {
+	// Path is the directory to read files from. Files are read in lexical order, including
+	// those in subdirectories; files and directories whose names start with '.', and the
+	// checkpoint file, are ignored.
+	Path string
+
+	// (Optional) CheckpointFile is where per-file offsets are stored; defaults to
+	// .spade_checkpoint.json inside Path
+	CheckpointFile string
+
+	// (Optional) Globs is set if every line is a base64 encoded, compressed glob of events,
+	// as written to Kinesis by the edge. Otherwise every line is a single plaintext event.
+	Globs bool
+
+	// (Optional) Follow keeps polling the directory for new files and appended data instead
+	// of closing the pipe once everything has been read
+	Follow bool
+
+	// (Optional) Time to sleep between polls when following the directory
+	PollInterval string
+
+	// (Optional) How often offsets are written to the checkpoint file
+	CheckpointFrequency string
+}
+
+// fileCheckpoint records how much of a file has been consumed.
+type fileCheckpoint struct {
+	// Offset is the number of (decompressed) bytes of the file that have been consumed
+	Offset int64
+	// Size is the size of the file on disk when it was last read
+	Size int64
+}
+
+// DirectoryPipe is a ResultPipe that reads events from the gzipped or plain files in a
+// directory, checkpointing its progress through each file so a restart resumes where it
+// stopped. As with the KinesisPipe, a line is only checkpointed once its Result's Token, and
+// those of every line read before it, have been released.
+type DirectoryPipe struct {
+	config         DirectoryConfig
+	pollInterval   time.Duration
+	checkpointFreq time.Duration
+	lastCheckpoint time.Time
+	tracker        checkpointTracker
+
+	// read is how far each file has been read and sent
+	read map[string]fileCheckpoint
+
+	// checkpoints is how far each file has been durably written, and is what's saved
+	checkpoints     map[string]fileCheckpoint
+	checkpointsLock sync.Mutex
+
+	channel chan *Result
+	closer  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewDirectoryPipe loads the checkpoint file, if any, and starts reading the directory.
+func NewDirectoryPipe(config DirectoryConfig) (*DirectoryPipe, error) {
+	if config.Path == "" {
+		return nil, fmt.Errorf("directory consumer needs a Path")
+	}
+	if config.CheckpointFile == "" {
+		config.CheckpointFile = filepath.Join(config.Path, defaultDirectoryCheckpointFile)
+	}
+
+	pollInterval, err := configEntryToDuration(config.PollInterval)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid PollInterval: %s", err)
+	}
+	if pollInterval == 0 {
+		pollInterval = defaultDirectoryPollInterval
+	}
+	checkpointFreq, err := configEntryToDuration(config.CheckpointFrequency)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid CheckpointFrequency: %s", err)
+	}
+	if checkpointFreq == 0 {
+		checkpointFreq = defaultDirectoryCheckpointFrequency
+	}
+
+	checkpoints, err := loadCheckpoints(config.CheckpointFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading checkpoints: %v", err)
+	}
+
+	read := make(map[string]fileCheckpoint, len(checkpoints))
+	for path, checkpoint := range checkpoints {
+		read[path] = checkpoint
+	}
+	p := &DirectoryPipe{
+		config:         config,
+		pollInterval:   pollInterval,
+		checkpointFreq: checkpointFreq,
+		lastCheckpoint: time.Now(),
+		read:           read,
+		checkpoints:    checkpoints,
+		channel:        make(chan *Result),
+		closer:         make(chan struct{}),
+	}
+	p.wg.Add(1)
+	logger.Go(func() {
+		defer p.wg.Done()
+		defer close(p.channel)
+		p.crank()
+	})
+	return p, nil
+}
+
+func loadCheckpoints(filename string) (map[string]fileCheckpoint, error) {
+	checkpoints := map[string]fileCheckpoint{}
+	b, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return checkpoints, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &checkpoints); err != nil {
+		return nil, fmt.Errorf("decoding %s: %v", filename, err)
+	}
+	return checkpoints, nil
+}
+
+// saveCheckpoints atomically replaces the checkpoint file with the acknowledged offsets.
+func (p *DirectoryPipe) saveCheckpoints() error {
+	p.checkpointsLock.Lock()
+	b, err := json.Marshal(p.checkpoints)
+	p.checkpointsLock.Unlock()
+	if err != nil {
+		return err
+	}
+	tmp := p.config.CheckpointFile + ".tmp"
+	if err = ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	p.lastCheckpoint = time.Now()
+	return os.Rename(tmp, p.config.CheckpointFile)
+}
+
+// track returns the token which, once it and those of every line before it in the file have
+// been released, checkpoints the file at the given offset.
+func (p *DirectoryPipe) track(path string, checkpoint fileCheckpoint) *ack.Token {
+	return p.tracker.add(path, func() {
+		p.checkpointsLock.Lock()
+		p.checkpoints[path] = checkpoint
+		p.checkpointsLock.Unlock()
+	})
+}
+
+func (p *DirectoryPipe) maybeSaveCheckpoints() {
+	if time.Since(p.lastCheckpoint) < p.checkpointFreq {
+		return
+	}
+	if err := p.saveCheckpoints(); err != nil {
+		logger.WithError(err).Error("Failed to save directory checkpoints")
+	}
+}
+
+func (p *DirectoryPipe) crank() {
+	for {
+		files, err := p.listFiles()
+		if err != nil {
+			p.send(&Result{Error: fmt.Errorf("listing %s: %v", p.config.Path, err)})
+			return
+		}
+		for _, f := range files {
+			if !p.readFile(f) {
+				return
+			}
+		}
+		p.maybeSaveCheckpoints()
+		if !p.config.Follow {
+			return
+		}
+		select {
+		case <-p.closer:
+			return
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+// listFiles returns the files in the directory which have data we haven't read.
+func (p *DirectoryPipe) listFiles() ([]string, error) {
+	checkpointFile := filepath.Clean(p.config.CheckpointFile)
+	var files []string
+	err := filepath.Walk(p.config.Path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != p.config.Path && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// The checkpoint file may be anywhere, including in the directory without a '.'.
+		if path == checkpointFile || path == checkpointFile+".tmp" {
+			return nil
+		}
+		if !info.IsDir() && p.read[path].Size != info.Size() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+// readFile sends the unconsumed lines of the given file, returning false if the pipe should
+// stop.
+func (p *DirectoryPipe) readFile(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return p.send(&Result{Error: fmt.Errorf("opening %s: %v", path, err)})
+	}
+	defer func() {
+		if cerr := f.Close(); cerr != nil {
+			logger.WithError(cerr).WithField("path", path).Error("Failed to close file")
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return p.send(&Result{Error: fmt.Errorf("reading %s: %v", path, err)})
+	}
+
+	checkpoint := p.read[path]
+	if info.Size() < checkpoint.Size {
+		logger.WithField("path", path).Warn("File was truncated, reading it from the start")
+		checkpoint = fileCheckpoint{}
+	}
+
+	reader := bufio.NewReader(f)
+	magic, _ := reader.Peek(len(gzipMagic))
+	compressed := bytes.Equal(magic, gzipMagic)
+	if compressed {
+		gz, gerr := gzip.NewReader(reader)
+		if gerr != nil {
+			return p.send(&Result{Error: fmt.Errorf("reading %s: %v", path, gerr)})
+		}
+		// bufio.NewScanner would be simpler here, but some of our lines are
+		// too long for it.
+		reader = bufio.NewReader(gz)
+		if _, err = io.CopyN(ioutil.Discard, reader, checkpoint.Offset); err != nil {
+			return p.send(&Result{Error: fmt.Errorf("skipping to offset in %s: %v", path, err)})
+		}
+	} else if _, err = f.Seek(checkpoint.Offset, io.SeekStart); err != nil {
+		return p.send(&Result{Error: fmt.Errorf("seeking in %s: %v", path, err)})
+	} else {
+		reader.Reset(f)
+	}
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			if p.config.Follow && err == io.ErrUnexpectedEOF {
+				// The gzip stream is still being written; try again on the next poll.
+				break
+			}
+			return p.send(&Result{Error: fmt.Errorf("reading %s: %v", path, err)})
+		}
+		if err == io.EOF && p.config.Follow && !compressed && len(line) > 0 {
+			// The last line may still be being written; wait for its newline.
+			break
+		}
+		checkpoint.Offset += int64(len(line))
+		if err == io.EOF {
+			checkpoint.Size = info.Size()
+		}
+		p.read[path] = checkpoint
+		token := p.track(path, checkpoint)
+
+		var data []byte
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 && p.config.Globs {
+			glob := make([]byte, base64.StdEncoding.DecodedLen(len(trimmed)))
+			n, derr := base64.StdEncoding.Decode(glob, trimmed)
+			if derr != nil {
+				logger.WithError(derr).WithField("path", path).Error("Failed to decode glob")
+			} else {
+				data = glob[:n]
+			}
+		} else if len(trimmed) > 0 {
+			data = trimmed
+		}
+		if data == nil {
+			// Nothing to write, so the line is done with.
+			token.Release()
+		} else if !p.send(&Result{Data: data, Token: token}) {
+			return false
+		}
+		p.maybeSaveCheckpoints()
+		if err == io.EOF {
+			break
+		}
+	}
+	return true
+}
+
+// send returns false if the pipe was closed before the result could be sent.
+func (p *DirectoryPipe) send(result *Result) bool {
+	select {
+	case <-p.closer:
+		return false
+	case p.channel <- result:
+		return result.Error == nil
+	}
+}
+
+// ReadChannel provides Results which are single plaintext events, or compressed globs of
+// events if the pipe is configured with Globs.
+func (p *DirectoryPipe) ReadChannel() <-chan *Result {
+	return p.channel
+}
+
+// Close stops reading the directory and saves the offsets of the lines acknowledged so far.
+// Lines still in flight will be read again.
+func (p *DirectoryPipe) Close() {
+	close(p.closer)
+	p.wg.Wait()
+	if err := p.saveCheckpoints(); err != nil {
+		logger.WithError(err).Error("Failed to save directory checkpoints")
+	}
+}
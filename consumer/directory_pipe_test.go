@@ -0,0 +1,102 @@
+package consumer
+
+import (
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAll(t *testing.T, p *DirectoryPipe) []string {
+	var lines []string
+	for result := range p.ReadChannel() {
+		require.Nil(t, result.Error)
+		lines = append(lines, string(result.Data))
+		result.Token.Release()
+	}
+	p.Close()
+	return lines
+}
+
+func TestDirectoryPipe(t *testing.T) {
+	dir, err := ioutil.TempDir("", "directory_pipe")
+	require.Nil(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "a.log"), []byte("{\"a\":1}\n\n{\"a\":2}\n"), 0644))
+	f, err := os.Create(filepath.Join(dir, "b.gz"))
+	require.Nil(t, err)
+	gz := gzip.NewWriter(f)
+	_, err = gz.Write([]byte("{\"b\":1}\n{\"b\":2}"))
+	require.Nil(t, err)
+	require.Nil(t, gz.Close())
+	require.Nil(t, f.Close())
+
+	p, err := NewDirectoryPipe(DirectoryConfig{Path: dir})
+	require.Nil(t, err)
+	assert.Equal(t, []string{`{"a":1}`, `{"a":2}`, `{"b":1}`, `{"b":2}`}, readAll(t, p))
+
+	// A restart only reads data appended since the checkpoint.
+	af, err := os.OpenFile(filepath.Join(dir, "a.log"), os.O_APPEND|os.O_WRONLY, 0644)
+	require.Nil(t, err)
+	_, err = af.Write([]byte("{\"a\":3}\n"))
+	require.Nil(t, err)
+	require.Nil(t, af.Close())
+
+	p, err = NewDirectoryPipe(DirectoryConfig{Path: dir})
+	require.Nil(t, err)
+	assert.Equal(t, []string{`{"a":3}`}, readAll(t, p))
+}
+
+func TestDirectoryPipeGlobs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "directory_pipe")
+	require.Nil(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	glob := []byte{1, 2, 3, 4}
+	line := base64.StdEncoding.EncodeToString(glob) + "\n"
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "globs"), []byte(line+"not base64!\n"+line), 0644))
+
+	p, err := NewDirectoryPipe(DirectoryConfig{
+		Path:           dir,
+		CheckpointFile: filepath.Join(dir, "checkpoint"),
+		Globs:          true,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []string{string(glob), string(glob)}, readAll(t, p))
+}
+
+func TestDirectoryPipeCheckpointsOnAck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "directory_pipe")
+	require.Nil(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, "a.log"), []byte("{\"a\":1}\n{\"a\":2}\n{\"a\":3}\n"), 0644))
+	config := DirectoryConfig{Path: dir, CheckpointFile: filepath.Join(dir, "checkpoint")}
+
+	// Only the first line is written before the pipe closes; the third line's release
+	// doesn't checkpoint it while the second is still in flight.
+	p, err := NewDirectoryPipe(config)
+	require.Nil(t, err)
+	var results []*Result
+	for result := range p.ReadChannel() {
+		results = append(results, result)
+	}
+	require.Len(t, results, 3)
+	results[0].Token.Release()
+	results[2].Token.Release()
+	p.Close()
+
+	// The checkpoint file, although it's in the directory, isn't read as events.
+	p, err = NewDirectoryPipe(config)
+	require.Nil(t, err)
+	assert.Equal(t, []string{`{"a":2}`, `{"a":3}`}, readAll(t, p))
+
+	p, err = NewDirectoryPipe(config)
+	require.Nil(t, err)
+	assert.Empty(t, readAll(t, p))
+}
@@ -0,0 +1,221 @@
+package consumer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/twitchscience/aws_utils/logger"
+)
+
+// edgeLogTimestampSlop allows for inaccuracy in the LastModified timestamps of edge logs.
+const edgeLogTimestampSlop = time.Hour
+
+// EdgeLogConfig describes the window of edge logs an EdgeLogPipe replays.
+type EdgeLogConfig struct {
+	// Bucket is the bucket the edge uploads its gzipped logs to
+	Bucket string
+
+	// Start and End bound the time window to replay
+	Start time.Time
+	End   time.Time
+
+	// (Optional) Tables restricts replay to events of these types; all events are replayed if empty
+	Tables []string
+
+	// (Optional) Workers is the number of edge logs to stream concurrently
+	Workers int
+}
+
+// EdgeLogPipe is a ResultPipe that streams plaintext events from the edge logs in S3.
+type EdgeLogPipe struct {
+	channel <-chan *Result
+	closer  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewEdgeLogPipe lists the edge logs for the configured window and starts streaming them.
+func NewEdgeLogPipe(s3api s3iface.S3API, config EdgeLogConfig) (*EdgeLogPipe, error) {
+	if !config.End.After(config.Start) {
+		return nil, fmt.Errorf("need a valid time range, got %v to %v", config.Start, config.End)
+	}
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+
+	objects, err := listLogs(s3api, config.Bucket, config.Start, config.End, edgeLogTimestampSlop)
+	if err != nil {
+		return nil, fmt.Errorf("listing edge logs: %v", err)
+	}
+	logger.WithField("num_logs", len(objects)).Info("Found edge logs to replay")
+
+	var filter [][]byte
+	for _, table := range config.Tables {
+		filter = append(filter, fragments(table)...)
+	}
+
+	channel := make(chan *Result)
+	p := &EdgeLogPipe{
+		channel: channel,
+		closer:  make(chan struct{}),
+	}
+	work := make(chan *s3.Object, len(objects))
+	for _, object := range objects {
+		work <- object
+	}
+	close(work)
+
+	p.wg.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		logger.Go(func() {
+			defer p.wg.Done()
+			for object := range work {
+				key := aws.StringValue(object.Key)
+				if err := p.stream(s3api, config.Bucket, key, filter, channel); err != nil {
+					p.send(channel, &Result{Error: fmt.Errorf("streaming %s: %v", key, err)})
+					return
+				}
+			}
+		})
+	}
+	logger.Go(func() {
+		p.wg.Wait()
+		close(channel)
+	})
+	return p, nil
+}
+
+// listLogs returns all logs uploaded with the edge's key names (such as edge logs, nontracked
+// events and dead letters) last modified in the window, or up to slop after it.
+func listLogs(s3api s3iface.S3API, bucket string, start, end time.Time, slop time.Duration) ([]*s3.Object, error) {
+	var objects []*s3.Object
+	for _, prefix := range edgeLogPrefixes(start, end) {
+		err := s3api.ListObjectsPages(&s3.ListObjectsInput{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(prefix),
+		}, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+			for _, object := range page.Contents {
+				modified := aws.TimeValue(object.LastModified)
+				if !modified.Before(start) && modified.Add(-slop).Before(end) {
+					objects = append(objects, object)
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// edgeLogPrefixes returns the YYYYMMDD prefixes of every UTC day the window touches.
+func edgeLogPrefixes(start, end time.Time) []string {
+	first := start.Add(-edgeLogTimestampSlop).UTC()
+	last := end.Add(edgeLogTimestampSlop).UTC()
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+
+	var prefixes []string
+	for ; !day.After(last); day = day.AddDate(0, 0, 1) {
+		prefixes = append(prefixes, day.Format("20060102"))
+	}
+	return prefixes
+}
+
+// fragments returns base64-encoded fragments of the given table name. Every event of that type
+// contains one of them, so they let us skip unrelated events without decoding them, at the cost
+// of some false positives.
+func fragments(table string) [][]byte {
+	var frags [][]byte
+	for start := 0; start < 3 && start <= len(table); start++ {
+		end := (len(table)-start)/3*3 + start
+		frags = append(frags, []byte(base64.StdEncoding.EncodeToString([]byte(table[start:end]))))
+	}
+	return frags
+}
+
+func matchesAny(line []byte, filter [][]byte) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if bytes.Contains(line, f) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *EdgeLogPipe) stream(s3api s3iface.S3API, bucket, key string, filter [][]byte, channel chan<- *Result) error {
+	return readLogLines(s3api, bucket, key, func(line []byte) bool {
+		if !matchesAny(line, filter) {
+			return true
+		}
+		return p.send(channel, &Result{Data: line})
+	})
+}
+
+// readLogLines calls fn with each line of the gzipped object until fn returns false.
+func readLogLines(s3api s3iface.S3API, bucket, key string, fn func(line []byte) bool) error {
+	object, err := s3api.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := object.Body.Close(); cerr != nil {
+			logger.WithError(cerr).WithField("key", key).Error("Failed to close log")
+		}
+	}()
+
+	gz, err := gzip.NewReader(object.Body)
+	if err != nil {
+		return err
+	}
+
+	// bufio.NewScanner would be simpler here, but some of our lines are
+	// too long for it.
+	reader := bufio.NewReader(gz)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && !fn(line) {
+			return nil
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// send returns false if the pipe was closed before the result could be sent.
+func (p *EdgeLogPipe) send(channel chan<- *Result, result *Result) bool {
+	select {
+	case <-p.closer:
+		return false
+	case channel <- result:
+		return true
+	}
+}
+
+// ReadChannel provides results which are single, uncompressed, decoded events.
+func (p *EdgeLogPipe) ReadChannel() <-chan *Result {
+	return p.channel
+}
+
+// Close stops streaming edge logs.
+func (p *EdgeLogPipe) Close() {
+	close(p.closer)
+	p.wg.Wait()
+}
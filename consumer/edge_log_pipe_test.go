@@ -0,0 +1,94 @@
+package consumer
+
+import (
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeEdgeLog(t *testing.T, root, key string, modified time.Time, lines ...string) {
+	path := filepath.Join(root, "edge", key)
+	require.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+	f, err := os.Create(path)
+	require.Nil(t, err)
+	gz := gzip.NewWriter(f)
+	for _, line := range lines {
+		_, err = gz.Write([]byte(line + "\n"))
+		require.Nil(t, err)
+	}
+	require.Nil(t, gz.Close())
+	require.Nil(t, f.Close())
+	require.Nil(t, os.Chtimes(path, modified, modified))
+}
+
+func encodeEvent(event string) string {
+	return base64.StdEncoding.EncodeToString([]byte(`{"event":"` + event + `","properties":{}}`))
+}
+
+func TestEdgeLogPrefixes(t *testing.T) {
+	start := time.Date(2017, 3, 1, 23, 30, 0, 0, time.UTC)
+	end := time.Date(2017, 3, 2, 1, 0, 0, 0, time.UTC)
+	assert.Equal(t, []string{"20170301", "20170302"}, edgeLogPrefixes(start, end))
+
+	start = time.Date(2017, 3, 2, 0, 30, 0, 0, time.UTC)
+	assert.Equal(t, []string{"20170301", "20170302"}, edgeLogPrefixes(start, end))
+}
+
+func TestFragmentsMatchEncodedEvents(t *testing.T) {
+	for _, table := range []string{"a", "ab", "minute-watched", "video_play"} {
+		filter := fragments(table)
+		for _, prefix := range []string{"", "x", "xy"} {
+			line := []byte(base64.StdEncoding.EncodeToString([]byte(prefix + table + "suffix")))
+			assert.True(t, matchesAny(line, filter), "%s with prefix %q", table, prefix)
+		}
+	}
+	assert.False(t, matchesAny([]byte(encodeEvent("login")), fragments("minute-watched")))
+}
+
+func TestEdgeLogPipe(t *testing.T) {
+	root, err := ioutil.TempDir("", "edge_log_pipe")
+	require.Nil(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+
+	start := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	writeEdgeLog(t, root, "20170301/before.gz", start.Add(-time.Minute), encodeEvent("minute-watched"))
+	writeEdgeLog(t, root, "20170301/during.gz", start.Add(30*time.Minute),
+		encodeEvent("minute-watched"), encodeEvent("login"))
+	writeEdgeLog(t, root, "20170301/slop.gz", end.Add(30*time.Minute), encodeEvent("minute-watched"))
+	writeEdgeLog(t, root, "20170301/after.gz", end.Add(2*time.Hour), encodeEvent("minute-watched"))
+
+	p, err := NewEdgeLogPipe(&LocalS3{Root: root}, EdgeLogConfig{
+		Bucket:  "edge",
+		Start:   start,
+		End:     end,
+		Tables:  []string{"minute-watched"},
+		Workers: 2,
+	})
+	require.Nil(t, err)
+
+	var lines []string
+	for result := range p.ReadChannel() {
+		require.Nil(t, result.Error)
+		lines = append(lines, string(result.Data))
+	}
+	p.Close()
+
+	sort.Strings(lines)
+	expected := encodeEvent("minute-watched") + "\n"
+	assert.Equal(t, []string{expected, expected}, lines)
+}
+
+func TestEdgeLogPipeInvalidWindow(t *testing.T) {
+	now := time.Now()
+	_, err := NewEdgeLogPipe(&LocalS3{Root: "/nonexistent"}, EdgeLogConfig{Bucket: "edge", Start: now, End: now})
+	assert.NotNil(t, err)
+}
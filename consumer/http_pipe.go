@@ -0,0 +1,260 @@
+package consumer
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/myesui/uuid"
+	"github.com/twitchscience/aws_utils/logger"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/ack"
+	"github.com/twitchscience/spade/codec"
+	"github.com/twitchscience/spade/protoevent"
+)
+
+const (
+	defaultHTTPQueueSize    = 16
+	defaultHTTPMaxInFlight  = 1024
+	defaultHTTPMaxBodyBytes = 1 << 20
+)
+
+// HTTPConfig is used to configure an HTTPPipe.
+type HTTPConfig struct {
+	// Address is the host:port to listen on
+	Address string
+
+	// Secret is the shared secret clients send as an "Authorization: Bearer" token
+	Secret string
+
+	// (Optional) Number of requests to queue for the deglobber before rejecting new ones
+	QueueSize int
+
+	// (Optional) Number of accepted requests whose events haven't all been durably written
+	// before rejecting new ones
+	MaxInFlight int
+
+	// (Optional) Largest request body accepted
+	MaxBodyBytes int64
+
+	// (Optional) Codec to compress the globs of JSON events with; flate if empty
+	Codec string
+}
+
+// HTTPPipe is a ResultPipe that accepts events over HTTP. Clients POST either JSON (a
+// spade.Event or a list of them) with Content-Type application/json, or a compressed glob
+// as written to Kinesis by the edge (in any registered codec) with Content-Type
+// application/octet-stream. A request is rejected with 429 Too Many Requests if the queue
+// to the deglobber is full, or if MaxInFlight accepted requests are still being processed
+// or waiting to be written, so that clients back off when the writers fall behind.
+type HTTPPipe struct {
+	config             HTTPConfig
+	compressionVersion byte
+	listener           net.Listener
+
+	channel chan *Result
+	closer  chan struct{}
+	// inFlight holds a value for each accepted request until its token is released
+	inFlight chan struct{}
+}
+
+// NewHTTPPipe starts listening for events.
+func NewHTTPPipe(config HTTPConfig) (*HTTPPipe, error) {
+	p, err := newHTTPPipe(config)
+	if err != nil {
+		return nil, err
+	}
+	p.listener, err = net.Listen("tcp", config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %v", config.Address, err)
+	}
+	logger.Go(func() {
+		err := http.Serve(p.listener, p)
+		select {
+		case <-p.closer:
+		default:
+			logger.WithError(err).Error("Failure serving HTTP consumer")
+		}
+	})
+	return p, nil
+}
+
+func newHTTPPipe(config HTTPConfig) (*HTTPPipe, error) {
+	if config.Secret == "" {
+		return nil, fmt.Errorf("HTTP consumer needs a Secret")
+	}
+	compressionVersion, err := codec.VersionOf(config.Codec)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP consumer Codec: %v", err)
+	}
+	if config.QueueSize < 0 {
+		return nil, fmt.Errorf("Invalid (negative) QueueSize: %d", config.QueueSize)
+	}
+	if config.QueueSize == 0 {
+		config.QueueSize = defaultHTTPQueueSize
+	}
+	if config.MaxInFlight < 0 {
+		return nil, fmt.Errorf("Invalid (negative) MaxInFlight: %d", config.MaxInFlight)
+	}
+	if config.MaxInFlight == 0 {
+		config.MaxInFlight = defaultHTTPMaxInFlight
+	}
+	if config.MaxBodyBytes <= 0 {
+		config.MaxBodyBytes = defaultHTTPMaxBodyBytes
+	}
+	return &HTTPPipe{
+		config:             config,
+		compressionVersion: compressionVersion,
+		channel:            make(chan *Result, config.QueueSize),
+		closer:             make(chan struct{}),
+		inFlight:           make(chan struct{}, config.MaxInFlight),
+	}, nil
+}
+
+func (p *HTTPPipe) authorized(r *http.Request) bool {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, prefix) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(auth[len(prefix):]), []byte(p.config.Secret)) == 1
+}
+
+// ServeHTTP queues the glob or events in the request body.
+func (p *HTTPPipe) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	if !p.authorized(r) {
+		http.Error(w, "bad or missing secret", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, p.config.MaxBodyBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("reading body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var glob []byte
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		glob, err = p.globEvents(body, r)
+	case "application/octet-stream":
+		// Only the codec is checked here, so the glob is decompressed once, by the
+		// deglobber, which dead-letters it if it's corrupt.
+		glob = body
+		err = checkGlobVersion(glob)
+	default:
+		http.Error(w, "Content-Type must be application/json or application/octet-stream",
+			http.StatusUnsupportedMediaType)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case p.inFlight <- struct{}{}:
+	default:
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "too many requests in flight", http.StatusTooManyRequests)
+		return
+	}
+	token := ack.New(func() { <-p.inFlight })
+	select {
+	case <-p.closer:
+		token.Release()
+		http.Error(w, "shutting down", http.StatusServiceUnavailable)
+	case p.channel <- &Result{Data: glob, Token: token}:
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		token.Release()
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "queue is full", http.StatusTooManyRequests)
+	}
+}
+
+// checkGlobVersion checks that a glob is compressed with a registered codec.
+func checkGlobVersion(glob []byte) error {
+	if len(glob) == 0 {
+		return fmt.Errorf("empty glob")
+	}
+	_, err := codec.Lookup(glob[0])
+	return err
+}
+
+// globEvents decodes one or a list of events, fills in the fields the edge would have set,
+// and compresses them into a glob.
+func (p *HTTPPipe) globEvents(body []byte, r *http.Request) ([]byte, error) {
+	var events []*spade.Event
+	var err error
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &events)
+	} else {
+		var event spade.Event
+		err = json.Unmarshal(body, &event)
+		events = []*spade.Event{&event}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decoding events: %v", err)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("no events in request")
+	}
+
+	clientIP := net.ParseIP(r.RemoteAddr)
+	if host, _, splitErr := net.SplitHostPort(r.RemoteAddr); splitErr == nil {
+		clientIP = net.ParseIP(host)
+	}
+	now := time.Now().UTC()
+	for _, e := range events {
+		if e == nil {
+			return nil, fmt.Errorf("null event in request")
+		}
+		if e.Uuid == "" {
+			e.Uuid = uuid.NewV4().String()
+		}
+		if e.ReceivedAt.IsZero() {
+			e.ReceivedAt = now
+		}
+		if e.ClientIp == nil {
+			e.ClientIp = clientIP
+		}
+		if e.Version == 0 {
+			e.Version = spade.PROTOCOL_VERSION
+		}
+		if e.EdgeType == "" {
+			e.EdgeType = spade.INTERNAL_EDGE
+		}
+		if err = protoevent.DecodePlaintext(e); err != nil {
+			return nil, fmt.Errorf("decoding event %s: %v", e.Uuid, err)
+		}
+	}
+	return protoevent.Glob(events, p.compressionVersion)
+}
+
+// ReadChannel provides Results which are compressed globs of events.
+func (p *HTTPPipe) ReadChannel() <-chan *Result {
+	return p.channel
+}
+
+// Close stops accepting requests.
+func (p *HTTPPipe) Close() {
+	close(p.closer)
+	if p.listener != nil {
+		if err := p.listener.Close(); err != nil {
+			logger.WithError(err).Error("Failed to close HTTP consumer listener")
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package consumer
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/codec"
+	"github.com/twitchscience/spade/protoevent"
+)
+
+func post(p *HTTPPipe, secret, contentType string, body []byte) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	r.RemoteAddr = "10.0.0.1:1234"
+	r.Header.Set("Authorization", "Bearer "+secret)
+	r.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	p.ServeHTTP(w, r)
+	return w
+}
+
+func TestHTTPPipeEvents(t *testing.T) {
+	p, err := newHTTPPipe(HTTPConfig{Secret: "s3cret"})
+	require.Nil(t, err)
+
+	w := post(p, "s3cret", "application/json; charset=utf-8", []byte(`{"data": "abc"}`))
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	w = post(p, "s3cret", "application/json", []byte(`[{"data": "def", "uuid": "u"}, {"data": "ghi"}]`))
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	events, err := protoevent.ExpandGlob((<-p.ReadChannel()).Data)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "abc", events[0].Data)
+	assert.NotEmpty(t, events[0].Uuid)
+	assert.Equal(t, "10.0.0.1", events[0].ClientIp.String())
+	assert.Equal(t, spade.PROTOCOL_VERSION, events[0].Version)
+	assert.False(t, events[0].ReceivedAt.IsZero())
+
+	events, err = protoevent.ExpandGlob((<-p.ReadChannel()).Data)
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "u", events[0].Uuid)
+	assert.Equal(t, "ghi", events[1].Data)
+}
+
+// Test that events with protobuf data are accepted with it base64 encoded.
+func TestHTTPPipeProtobufEvents(t *testing.T) {
+	p, err := newHTTPPipe(HTTPConfig{Secret: "s3cret"})
+	require.Nil(t, err)
+
+	w := post(p, "s3cret", "application/json", []byte(`{"data": "CgVsb2dpbg==", "recordversion": 5}`))
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	events, err := protoevent.ExpandGlob((<-p.ReadChannel()).Data)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "\x0a\x05login", events[0].Data)
+
+	w = post(p, "s3cret", "application/json", []byte(`{"data": "not base64", "recordversion": 5}`))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHTTPPipeGlobs(t *testing.T) {
+	p, err := newHTTPPipe(HTTPConfig{Secret: "s3cret"})
+	require.Nil(t, err)
+
+	glob, err := protoevent.Glob([]*spade.Event{{Uuid: "x"}}, 1)
+	require.Nil(t, err)
+	w := post(p, "s3cret", "application/octet-stream", glob)
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, glob, (<-p.ReadChannel()).Data)
+
+	glob[0] = 99
+	w = post(p, "s3cret", "application/octet-stream", glob)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = post(p, "s3cret", "application/octet-stream", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHTTPPipeRejects(t *testing.T) {
+	p, err := newHTTPPipe(HTTPConfig{Secret: "s3cret", QueueSize: 1})
+	require.Nil(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, post(p, "wrong", "application/json", []byte(`{}`)).Code)
+	assert.Equal(t, http.StatusUnsupportedMediaType, post(p, "s3cret", "text/plain", []byte(`{}`)).Code)
+	assert.Equal(t, http.StatusBadRequest, post(p, "s3cret", "application/json", []byte(`{`)).Code)
+	assert.Equal(t, http.StatusBadRequest, post(p, "s3cret", "application/json", []byte(`[]`)).Code)
+
+	assert.Equal(t, http.StatusAccepted, post(p, "s3cret", "application/json", []byte(`{}`)).Code)
+	w := post(p, "s3cret", "application/json", []byte(`{}`))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	r := httptest.NewRequest("GET", "/", nil)
+	w = httptest.NewRecorder()
+	p.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestHTTPPipeMaxInFlight(t *testing.T) {
+	p, err := newHTTPPipe(HTTPConfig{Secret: "s3cret", MaxInFlight: 1})
+	require.Nil(t, err)
+
+	assert.Equal(t, http.StatusAccepted, post(p, "s3cret", "application/json", []byte(`{}`)).Code)
+	result := <-p.ReadChannel()
+	w := post(p, "s3cret", "application/json", []byte(`{}`))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code, "the first request hasn't been written")
+
+	result.Token.Release()
+	assert.Equal(t, http.StatusAccepted, post(p, "s3cret", "application/json", []byte(`{}`)).Code)
+}
+
+func TestHTTPPipeCodec(t *testing.T) {
+	p, err := newHTTPPipe(HTTPConfig{Secret: "s3cret", Codec: "zstd"})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusAccepted, post(p, "s3cret", "application/json", []byte(`{}`)).Code)
+	glob := (<-p.ReadChannel()).Data
+	assert.Equal(t, codec.Zstd, glob[0])
+
+	_, err = newHTTPPipe(HTTPConfig{Secret: "s3cret", Codec: "lzma"})
+	assert.NotNil(t, err)
+}
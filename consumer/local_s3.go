@@ -0,0 +1,71 @@
+package consumer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// LocalS3 is a stand-in for the parts of S3 that EdgeLogPipe and RedrivePipe use. It serves
+// the object <bucket>/<key> from the file Root/<bucket>/<key>, using the file's modification
+// time as the object's LastModified.
+type LocalS3 struct {
+	Root string
+	s3iface.S3API
+}
+
+// ListObjectsPages calls fn with a single page of all objects under the given prefix, in
+// lexical order.
+func (l *LocalS3) ListObjectsPages(input *s3.ListObjectsInput, fn func(*s3.ListObjectsOutput, bool) bool) error {
+	bucketDir := filepath.Join(l.Root, aws.StringValue(input.Bucket))
+	prefix := aws.StringValue(input.Prefix)
+
+	var objects []*s3.Object
+	err := filepath.Walk(bucketDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == bucketDir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		key, err := filepath.Rel(bucketDir, path)
+		if err != nil {
+			return err
+		}
+		key = filepath.ToSlash(key)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		objects = append(objects, &s3.Object{
+			Key:          aws.String(key),
+			LastModified: aws.Time(info.ModTime()),
+			Size:         aws.Int64(info.Size()),
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fn(&s3.ListObjectsOutput{
+		Contents:    objects,
+		IsTruncated: aws.Bool(false),
+	}, true)
+	return nil
+}
+
+// GetObject opens the file backing the given object.
+func (l *LocalS3) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	f, err := os.Open(filepath.Join(l.Root, aws.StringValue(input.Bucket), filepath.FromSlash(aws.StringValue(input.Key))))
+	if err != nil {
+		return nil, err
+	}
+	return &s3.GetObjectOutput{Body: f}, nil
+}
@@ -0,0 +1,309 @@
+package consumer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/myesui/uuid"
+	"github.com/twitchscience/aws_utils/logger"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/protoevent"
+)
+
+// Kinds of stored failures a RedrivePipe can re-drive.
+const (
+	// RedriveDeadLetters re-drives the dead letters written by writer.NewDeadLetterWriter.
+	RedriveDeadLetters = "deadletter"
+	// RedriveNonTracked re-drives the events which had no schema when they were processed.
+	RedriveNonTracked = "nontracked"
+)
+
+// RedriveConfig describes the stored failures a RedrivePipe re-drives.
+type RedriveConfig struct {
+	// Bucket is the bucket the dead letters or nontracked events were uploaded to
+	Bucket string
+
+	// Kind is RedriveDeadLetters or RedriveNonTracked, depending on what Bucket holds
+	Kind string
+
+	// Start and End bound the time window to re-drive. Dead letters are selected by when
+	// they failed, nontracked events by when the edge received them, or when their file was
+	// uploaded if it predates nontracked files recording that.
+	Start time.Time
+	End   time.Time
+
+	// (Optional) Events restricts the re-drive to events with these names; all events are
+	// re-driven if empty
+	Events []string
+
+	// (Optional) Workers is the number of files to stream concurrently
+	Workers int
+}
+
+// RedrivePipe is a ResultPipe that streams plaintext events from stored dead letters or
+// nontracked events, so they can be processed again with the current schemas.
+type RedrivePipe struct {
+	config  RedriveConfig
+	events  map[string]bool
+	channel <-chan *Result
+	closer  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// nontrackedEvent is a line of a nontracked file, or the raw data of a dead letter which
+// failed after being decoded.
+type nontrackedEvent struct {
+	Event      string          `json:"event"`
+	Properties json.RawMessage `json:"properties"`
+	UUID       string          `json:"uuid"`
+	Time       json.Number     `json:"time"`
+	// Unmapped is set on samples of events with unmapped properties, which were also
+	// written to their table, so they aren't redriven.
+	Unmapped []string `json:"unmapped"`
+}
+
+// deadLetter is the part of a line of a dead letter file, a writer.DeadLetter, which a
+// redrive reads.
+type deadLetter struct {
+	UUID      string    `json:"uuid"`
+	Raw       []byte    `json:"raw"`
+	StartedAt time.Time `json:"startedAt"`
+	FailedAt  time.Time `json:"failedAt"`
+}
+
+// NewRedrivePipe lists the files for the configured window and starts streaming them.
+func NewRedrivePipe(s3api s3iface.S3API, config RedriveConfig) (*RedrivePipe, error) {
+	if !config.End.After(config.Start) {
+		return nil, fmt.Errorf("need a valid time range, got %v to %v", config.Start, config.End)
+	}
+	// Files may have been uploaded a while after the last event they hold failed.
+	switch config.Kind {
+	case RedriveDeadLetters, RedriveNonTracked:
+	default:
+		return nil, fmt.Errorf("unknown redrive kind %q, expected %s or %s",
+			config.Kind, RedriveDeadLetters, RedriveNonTracked)
+	}
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+
+	objects, err := listLogs(s3api, config.Bucket, config.Start, config.End, edgeLogTimestampSlop)
+	if err != nil {
+		return nil, fmt.Errorf("listing %s files: %v", config.Kind, err)
+	}
+	logger.WithField("num_logs", len(objects)).WithField("kind", config.Kind).Info("Found files to redrive")
+
+	channel := make(chan *Result)
+	p := &RedrivePipe{
+		config:  config,
+		channel: channel,
+		closer:  make(chan struct{}),
+	}
+	if len(config.Events) > 0 {
+		p.events = make(map[string]bool)
+		for _, event := range config.Events {
+			p.events[event] = true
+		}
+	}
+
+	work := make(chan *s3.Object, len(objects))
+	for _, object := range objects {
+		work <- object
+	}
+	close(work)
+
+	p.wg.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		logger.Go(func() {
+			defer p.wg.Done()
+			for object := range work {
+				if err := p.stream(s3api, object, channel); err != nil {
+					p.send(channel, &Result{Error: fmt.Errorf("streaming %s: %v",
+						aws.StringValue(object.Key), err)})
+					return
+				}
+			}
+		})
+	}
+	logger.Go(func() {
+		p.wg.Wait()
+		close(channel)
+	})
+	return p, nil
+}
+
+func (p *RedrivePipe) stream(s3api s3iface.S3API, object *s3.Object, channel chan<- *Result) error {
+	key := aws.StringValue(object.Key)
+	modified := aws.TimeValue(object.LastModified)
+	return readLogLines(s3api, p.config.Bucket, key, func(line []byte) bool {
+		events, err := p.redrive(line, key, modified)
+		if err != nil {
+			logger.WithError(err).WithField("key", key).Warn("Skipping line which can't be redriven")
+			return true
+		}
+		for _, event := range events {
+			data, err := spade.Marshal(protoevent.EncodePlaintext(event))
+			if err != nil {
+				logger.WithError(err).WithField("uuid", event.Uuid).Warn("Skipping event which can't be marshaled")
+				continue
+			}
+			if !p.send(channel, &Result{Data: data}) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// redrive returns the edge events to process again for a line of the file with the given key,
+// last modified at the given time.
+func (p *RedrivePipe) redrive(line []byte, key string, modified time.Time) ([]*spade.Event, error) {
+	if p.config.Kind == RedriveNonTracked {
+		var nontracked nontrackedEvent
+		if err := json.Unmarshal(line, &nontracked); err != nil {
+			return nil, fmt.Errorf("unmarshaling nontracked event: %v", err)
+		}
+		receivedAt := modified
+		if nontracked.Time != "" {
+			seconds, err := nontracked.Time.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("parsing nontracked event time: %v", err)
+			}
+			receivedAt = time.Unix(seconds, 0)
+		}
+		if receivedAt.Before(p.config.Start) || !receivedAt.Before(p.config.End) ||
+			len(nontracked.Unmapped) > 0 || !p.wanted(nontracked.Event) {
+			return nil, nil
+		}
+		line = bytes.TrimSpace(line)
+		if nontracked.UUID == "" {
+			nontracked.UUID = p.lineUUID(key, line)
+		}
+		return []*spade.Event{wrapDecoded(line, nontracked.UUID, receivedAt)}, nil
+	}
+
+	var deadLetter deadLetter
+	if err := json.Unmarshal(line, &deadLetter); err != nil {
+		return nil, fmt.Errorf("unmarshaling dead letter: %v", err)
+	}
+	if deadLetter.FailedAt.Before(p.config.Start) || !deadLetter.FailedAt.Before(p.config.End) {
+		return nil, nil
+	}
+	if deadLetter.UUID == "" {
+		deadLetter.UUID = p.lineUUID(key, bytes.TrimSpace(line))
+	}
+	events, err := p.fromRaw(&deadLetter)
+	if err != nil {
+		return nil, fmt.Errorf("dead letter %s: %v", deadLetter.UUID, err)
+	}
+	var wanted []*spade.Event
+	for _, event := range events {
+		if p.wantedData(event) {
+			wanted = append(wanted, event)
+		}
+	}
+	return wanted, nil
+}
+
+// fromRaw returns the edge events a dead letter's raw data holds: a glob, an edge event in
+// JSON or protobuf, or the event and properties of a decoded event.
+func (p *RedrivePipe) fromRaw(deadLetter *deadLetter) ([]*spade.Event, error) {
+	raw := deadLetter.Raw
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("no raw data")
+	}
+	if raw[0] != '{' {
+		events, err := protoevent.ExpandGlob(raw)
+		if err == nil {
+			return events, nil
+		}
+		// Events which failed to parse from the protobuf envelope are dead lettered as is.
+		var event spade.Event
+		if protoevent.Unmarshal(raw, &event) != nil || event.Version != protoevent.Version {
+			return nil, err
+		}
+		return []*spade.Event{&event}, nil
+	}
+
+	var decoded nontrackedEvent
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling raw data: %v", err)
+	}
+	if decoded.Properties != nil {
+		return []*spade.Event{wrapDecoded(raw, deadLetter.UUID, deadLetter.StartedAt)}, nil
+	}
+	var event spade.Event
+	if err := spade.Unmarshal(raw, &event); err != nil {
+		return nil, fmt.Errorf("unmarshaling raw event: %v", err)
+	}
+	return []*spade.Event{&event}, nil
+}
+
+// lineUUID returns the UUID of an event without one, hashed from the line holding it and its
+// file, so redriving the same file again gives the event the same UUID.
+func (p *RedrivePipe) lineUUID(key string, line []byte) string {
+	return uuid.NewV5(uuid.NameSpaceURL, "s3://"+p.config.Bucket+"/"+key+"\n", line).String()
+}
+
+func (p *RedrivePipe) wanted(event string) bool {
+	return p.events == nil || p.events[event]
+}
+
+// wantedData returns whether the data of an edge event holds a wanted event. If the data
+// can't be decoded, it's only wanted when all events are.
+func (p *RedrivePipe) wantedData(event *spade.Event) bool {
+	if p.events == nil {
+		return true
+	}
+	names, err := parser.EventNames(event)
+	if err != nil {
+		return false
+	}
+	for _, name := range names {
+		if p.events[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapDecoded wraps the JSON of a decoded event back up as an edge event. The client IP and
+// user agent were lost when it was decoded, so only its UUID and receive time are kept.
+func wrapDecoded(decoded []byte, eventUUID string, receivedAt time.Time) *spade.Event {
+	return &spade.Event{
+		ReceivedAt: receivedAt.UTC(),
+		Uuid:       eventUUID,
+		Data:       base64.StdEncoding.EncodeToString(decoded),
+		Version:    spade.PROTOCOL_VERSION,
+		EdgeType:   spade.INTERNAL_EDGE,
+	}
+}
+
+// send returns false if the pipe was closed before the result could be sent.
+func (p *RedrivePipe) send(channel chan<- *Result, result *Result) bool {
+	select {
+	case <-p.closer:
+		return false
+	case channel <- result:
+		return true
+	}
+}
+
+// ReadChannel provides results which are single, uncompressed, decoded events.
+func (p *RedrivePipe) ReadChannel() <-chan *Result {
+	return p.channel
+}
+
+// Close stops streaming files.
+func (p *RedrivePipe) Close() {
+	close(p.closer)
+	p.wg.Wait()
+}
@@ -0,0 +1,163 @@
+package consumer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/protoevent"
+	"github.com/twitchscience/spade/writer"
+)
+
+func decodedEvent(event string) string {
+	return `{"event":"` + event + `","properties":{}}`
+}
+
+func deadLetterLine(t *testing.T, uuid string, failedAt time.Time, raw []byte) string {
+	line, err := json.Marshal(&writer.DeadLetter{UUID: uuid, Raw: raw, FailedAt: failedAt, StartedAt: failedAt})
+	require.Nil(t, err)
+	return string(line)
+}
+
+func redriveAll(t *testing.T, root string, config RedriveConfig) []*spade.Event {
+	p, err := NewRedrivePipe(&LocalS3{Root: root}, config)
+	require.Nil(t, err)
+
+	var events []*spade.Event
+	for result := range p.ReadChannel() {
+		require.Nil(t, result.Error)
+		var event spade.Event
+		require.Nil(t, spade.Unmarshal(result.Data, &event))
+		events = append(events, &event)
+	}
+	p.Close()
+	sort.Sort(byUUID(events))
+	return events
+}
+
+type byUUID []*spade.Event
+
+func (b byUUID) Len() int           { return len(b) }
+func (b byUUID) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byUUID) Less(i, j int) bool { return b[i].Uuid < b[j].Uuid }
+
+func TestRedrivePipeNonTracked(t *testing.T) {
+	root, err := ioutil.TempDir("", "redrive_pipe")
+	require.Nil(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+
+	start := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	during := start.Add(30 * time.Minute)
+	receivedAt := func(uuid string, at time.Time) string {
+		return fmt.Sprintf(`{"event":"new-event","properties":{},"uuid":"%s","time":%d}`, uuid, at.Unix())
+	}
+	writeEdgeLog(t, root, "20170301/before.gz", start.Add(-time.Minute), decodedEvent("new-event"))
+	writeEdgeLog(t, root, "20170301/during.gz", during, decodedEvent("new-event"), decodedEvent("other"),
+		`{"event":"new-event","properties":{"extra":1},"unmapped":["extra"]}`)
+	// The file was uploaded after the window, but holds events received during it.
+	writeEdgeLog(t, root, "20170301/after.gz", end.Add(time.Minute), decodedEvent("new-event"),
+		receivedAt("a", during), receivedAt("b", end))
+
+	config := RedriveConfig{
+		Bucket: "edge",
+		Kind:   RedriveNonTracked,
+		Start:  start,
+		End:    end,
+		Events: []string{"new-event"},
+	}
+	events := redriveAll(t, root, config)
+	require.Len(t, events, 2)
+	if events[0].Uuid == "a" {
+		events[0], events[1] = events[1], events[0]
+	}
+	assert.NotEmpty(t, events[0].Uuid)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(decodedEvent("new-event"))), events[0].Data)
+	assert.True(t, during.Equal(events[0].ReceivedAt))
+	assert.Equal(t, spade.PROTOCOL_VERSION, events[0].Version)
+
+	assert.Equal(t, "a", events[1].Uuid)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(receivedAt("a", during))), events[1].Data)
+	assert.True(t, during.Equal(events[1].ReceivedAt))
+
+	again := redriveAll(t, root, config)
+	require.Len(t, again, 2)
+	assert.Contains(t, []string{again[0].Uuid, again[1].Uuid}, events[0].Uuid,
+		"redriving again should give the same UUIDs")
+}
+
+func TestRedrivePipeDeadLetters(t *testing.T) {
+	root, err := ioutil.TempDir("", "redrive_pipe")
+	require.Nil(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+
+	start := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	during := start.Add(30 * time.Minute)
+
+	glob, err := protoevent.Glob([]*spade.Event{
+		{Uuid: "a", Data: base64.StdEncoding.EncodeToString([]byte(decodedEvent("login")))},
+		{Uuid: "b", Data: base64.StdEncoding.EncodeToString([]byte(decodedEvent("logout")))},
+	}, 1)
+	require.Nil(t, err)
+	edgeEvent, err := spade.Marshal(&spade.Event{
+		Uuid: "c",
+		Data: base64.StdEncoding.EncodeToString([]byte(decodedEvent("login"))),
+	})
+	require.Nil(t, err)
+	bags, err := protoevent.MarshalBags([]protoevent.Bag{{Event: "login", Properties: json.RawMessage(`{}`)}})
+	require.Nil(t, err)
+	protobufEvent := protoevent.Marshal(&spade.Event{Uuid: "g", Data: string(bags), Version: protoevent.Version})
+
+	// The file was uploaded after the window, but within the slop.
+	writeEdgeLog(t, root, "20170301/deadletters.gz", end.Add(time.Minute),
+		deadLetterLine(t, "", during, glob),
+		deadLetterLine(t, "c", during, edgeEvent),
+		deadLetterLine(t, "d", during, []byte(decodedEvent("login"))),
+		deadLetterLine(t, "e", end.Add(time.Second), []byte(decodedEvent("login"))),
+		deadLetterLine(t, "f", during, nil),
+		deadLetterLine(t, "g", during, protobufEvent),
+		"not a dead letter",
+	)
+
+	events := redriveAll(t, root, RedriveConfig{
+		Bucket: "edge",
+		Kind:   RedriveDeadLetters,
+		Start:  start,
+		End:    end,
+		Events: []string{"login"},
+	})
+	var uuids []string
+	for _, e := range events {
+		uuids = append(uuids, e.Uuid)
+	}
+	assert.Equal(t, []string{"a", "c", "d", "g"}, uuids)
+	assert.True(t, during.Equal(events[2].ReceivedAt))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(bags), events[3].Data)
+
+	events = redriveAll(t, root, RedriveConfig{
+		Bucket: "edge",
+		Kind:   RedriveDeadLetters,
+		Start:  start,
+		End:    end,
+	})
+	assert.Len(t, events, 5)
+}
+
+func TestRedrivePipeInvalidConfig(t *testing.T) {
+	now := time.Now()
+	_, err := NewRedrivePipe(&LocalS3{Root: "/nonexistent"},
+		RedriveConfig{Bucket: "edge", Kind: RedriveNonTracked, Start: now, End: now})
+	assert.NotNil(t, err)
+	_, err = NewRedrivePipe(&LocalS3{Root: "/nonexistent"},
+		RedriveConfig{Bucket: "edge", Kind: "edge", Start: now, End: now.Add(time.Hour)})
+	assert.NotNil(t, err)
+}
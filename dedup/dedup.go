@@ -0,0 +1,165 @@
+// Package dedup remembers the UUIDs of recently seen events in a DynamoDB table shared by
+// every processor, so that duplicates are still caught after a restart, or after a Kinesis
+// shard moves to another processor.
+package dedup
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/vrischmann/jsonutil"
+)
+
+const (
+	uuidAttribute    = "uuid"
+	expiresAttribute = "expires"
+	// maxBatchSize is the most items DynamoDB writes in one BatchWriteItem.
+	maxBatchSize  = 25
+	flushInterval = time.Second
+	// unprocessedBackoff is how long to wait before retrying writes DynamoDB left unprocessed.
+	unprocessedBackoff = 100 * time.Millisecond
+)
+
+// Config configures a Store.
+type Config struct {
+	// Table is the DynamoDB table the UUIDs seen are stored in. Its hash key is the string
+	// attribute uuid; enable Time to Live on its numeric expires attribute so DynamoDB deletes
+	// UUIDs once they leave the window.
+	Table string
+	// Window is how long a UUID is remembered for
+	Window jsonutil.Duration
+}
+
+// Store is a set of UUIDs seen within a window of time, safe for concurrent access. Each UUID
+// is an item of the table holding when it expires; UUIDs marked seen are written in batches,
+// so only those waiting for the next batch are held in memory.
+type Store struct {
+	db          dynamodbiface.DynamoDBAPI
+	table       string
+	window      time.Duration
+	currentTime func() time.Time
+
+	mu        sync.Mutex
+	pending   map[string]int64 // UUIDs waiting to be written, to when they expire
+	lastFlush time.Time
+}
+
+// Open returns a Store of the config's table, checking that the table exists.
+func Open(db dynamodbiface.DynamoDBAPI, config Config) (*Store, error) {
+	return openWithTimeFunction(db, config, time.Now)
+}
+
+func openWithTimeFunction(db dynamodbiface.DynamoDBAPI, config Config, currentTime func() time.Time) (*Store, error) {
+	if config.Table == "" {
+		return nil, fmt.Errorf("dedup store needs a Table")
+	}
+	if config.Window.Duration < time.Second {
+		return nil, fmt.Errorf("dedup window %v is too short", config.Window.Duration)
+	}
+	_, err := db.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String(config.Table)})
+	if err != nil {
+		return nil, fmt.Errorf("describing dedup table %s: %v", config.Table, err)
+	}
+	return &Store{
+		db:          db,
+		table:       config.Table,
+		window:      config.Window.Duration,
+		currentTime: currentTime,
+		pending:     make(map[string]int64),
+		lastFlush:   currentTime(),
+	}, nil
+}
+
+// Seen returns whether the UUID was marked seen within the window.
+func (s *Store) Seen(uuid string) (bool, error) {
+	now := s.currentTime().Unix()
+	s.mu.Lock()
+	expires, ok := s.pending[uuid]
+	s.mu.Unlock()
+	if ok {
+		return expires > now, nil
+	}
+
+	out, err := s.db.GetItem(&dynamodb.GetItemInput{
+		TableName:                aws.String(s.table),
+		Key:                      map[string]*dynamodb.AttributeValue{uuidAttribute: {S: aws.String(uuid)}},
+		ConsistentRead:           aws.Bool(true),
+		ProjectionExpression:     aws.String("#expires"),
+		ExpressionAttributeNames: map[string]*string{"#expires": aws.String(expiresAttribute)},
+	})
+	if err != nil {
+		return false, fmt.Errorf("getting %s from dedup table: %v", uuid, err)
+	}
+	value, ok := out.Item[expiresAttribute]
+	if !ok || value.N == nil {
+		return false, nil
+	}
+	expires, err = strconv.ParseInt(*value.N, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("parsing expiry of %s: %v", uuid, err)
+	}
+	// DynamoDB deletes expired items some time after they expire, so they may still be read.
+	return expires > now, nil
+}
+
+// MarkSeen remembers the UUID for the window. It should only be called once the event has
+// been durably written, so that an event lost before then is processed when redelivered.
+func (s *Store) MarkSeen(uuid string) error {
+	now := s.currentTime()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending[uuid] = now.Add(s.window).Unix()
+	if len(s.pending) < maxBatchSize && now.Sub(s.lastFlush) < flushInterval {
+		return nil
+	}
+	s.lastFlush = now
+	return s.flush()
+}
+
+// flush writes the pending UUIDs to the table. UUIDs which fail to be written are dropped
+// rather than held in memory, so their events may be processed again if redelivered. The
+// caller must hold mu.
+func (s *Store) flush() error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(s.pending))
+	for uuid, expires := range s.pending {
+		requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{
+			Item: map[string]*dynamodb.AttributeValue{
+				uuidAttribute:    {S: aws.String(uuid)},
+				expiresAttribute: {N: aws.String(strconv.FormatInt(expires, 10))},
+			},
+		}})
+	}
+	s.pending = make(map[string]int64)
+
+	for len(requests) > 0 {
+		n := len(requests)
+		if n > maxBatchSize {
+			n = maxBatchSize
+		}
+		out, err := s.db.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{s.table: requests[:n]},
+		})
+		if err != nil {
+			return fmt.Errorf("writing to dedup table: %v", err)
+		}
+		unprocessed := out.UnprocessedItems[s.table]
+		if len(unprocessed) > 0 {
+			time.Sleep(unprocessedBackoff)
+		}
+		requests = append(unprocessed, requests[n:]...)
+	}
+	return nil
+}
+
+// Close writes the pending UUIDs to the table.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.flush()
+}
@@ -0,0 +1,166 @@
+package dedup
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vrischmann/jsonutil"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) time() time.Time {
+	return c.now
+}
+
+// tableMock is a DynamoDB table of UUIDs to when they expire. It leaves the first
+// unprocessed writes of each batch unprocessed, once.
+type tableMock struct {
+	dynamodbiface.DynamoDBAPI
+	items       map[string]string
+	writes      int
+	unprocessed int
+	sync.Mutex
+}
+
+func newTableMock() *tableMock {
+	return &tableMock{items: make(map[string]string)}
+}
+
+func (m *tableMock) DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	if aws.StringValue(input.TableName) != "dedup" {
+		return nil, errors.New("ResourceNotFoundException")
+	}
+	return &dynamodb.DescribeTableOutput{}, nil
+}
+
+func (m *tableMock) GetItem(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	m.Lock()
+	defer m.Unlock()
+	expires, ok := m.items[aws.StringValue(input.Key[uuidAttribute].S)]
+	if !ok {
+		return &dynamodb.GetItemOutput{}, nil
+	}
+	return &dynamodb.GetItemOutput{Item: map[string]*dynamodb.AttributeValue{
+		expiresAttribute: {N: aws.String(expires)},
+	}}, nil
+}
+
+func (m *tableMock) BatchWriteItem(input *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.writes++
+	requests := input.RequestItems["dedup"]
+	out := &dynamodb.BatchWriteItemOutput{}
+	if m.unprocessed > 0 && len(requests) > m.unprocessed {
+		out.UnprocessedItems = map[string][]*dynamodb.WriteRequest{"dedup": requests[:m.unprocessed]}
+		requests = requests[m.unprocessed:]
+		m.unprocessed = 0
+	}
+	for _, r := range requests {
+		item := r.PutRequest.Item
+		m.items[aws.StringValue(item[uuidAttribute].S)] = aws.StringValue(item[expiresAttribute].N)
+	}
+	return out, nil
+}
+
+func openTestStore(t *testing.T, table *tableMock, c *clock) *Store {
+	s, err := openWithTimeFunction(table, Config{Table: "dedup", Window: jsonutil.FromDuration(12 * time.Minute)}, c.time)
+	require.Nil(t, err)
+	return s
+}
+
+// seenBefore returns whether the UUID was seen, marking it seen if not, as the deglobber
+// does once the event is written.
+func seenBefore(t *testing.T, s *Store, uuid string) bool {
+	seen, err := s.Seen(uuid)
+	require.Nil(t, err)
+	if !seen {
+		require.Nil(t, s.MarkSeen(uuid))
+	}
+	return seen
+}
+
+func TestSeenBefore(t *testing.T) {
+	table := newTableMock()
+	c := &clock{time.Unix(0, 0)}
+	s := openTestStore(t, table, c)
+	assert.False(t, seenBefore(t, s, "a"))
+	assert.True(t, seenBefore(t, s, "a"), "pending UUIDs should be seen")
+	assert.False(t, seenBefore(t, s, "b"))
+	assert.Empty(t, table.items, "UUIDs should be written in batches")
+
+	c.now = c.now.Add(10 * time.Minute)
+	assert.False(t, seenBefore(t, s, "c"))
+	assert.Len(t, table.items, 3)
+	assert.True(t, seenBefore(t, s, "a"))
+
+	// a has now left the window, though the table still has it
+	c.now = c.now.Add(3 * time.Minute)
+	assert.False(t, seenBefore(t, s, "a"))
+	assert.True(t, seenBefore(t, s, "c"))
+	require.Nil(t, s.Close())
+	assert.Equal(t, strconv.FormatInt(c.now.Add(12*time.Minute).Unix(), 10), table.items["a"])
+}
+
+func TestSeenDoesNotMark(t *testing.T) {
+	s := openTestStore(t, newTableMock(), &clock{time.Unix(0, 0)})
+	for i := 0; i < 2; i++ {
+		seen, err := s.Seen("a")
+		require.Nil(t, err)
+		assert.False(t, seen)
+	}
+	require.Nil(t, s.MarkSeen("a"))
+	seen, err := s.Seen("a")
+	require.Nil(t, err)
+	assert.True(t, seen)
+	require.Nil(t, s.Close())
+}
+
+// Test that UUIDs marked by one processor are seen by another, or after a restart.
+func TestShared(t *testing.T) {
+	table := newTableMock()
+	c := &clock{time.Unix(0, 0)}
+	first := openTestStore(t, table, c)
+	assert.False(t, seenBefore(t, first, "a"))
+	require.Nil(t, first.Close())
+
+	second := openTestStore(t, table, c)
+	assert.True(t, seenBefore(t, second, "a"))
+	assert.False(t, seenBefore(t, second, "b"))
+	require.Nil(t, second.Close())
+}
+
+func TestBatches(t *testing.T) {
+	table := newTableMock()
+	table.unprocessed = 5
+	s := openTestStore(t, table, &clock{time.Unix(0, 0)})
+	for i := 0; i < maxBatchSize-1; i++ {
+		require.Nil(t, s.MarkSeen(strconv.Itoa(i)))
+	}
+	assert.Equal(t, 0, table.writes)
+	require.Nil(t, s.MarkSeen("last"))
+	assert.Len(t, table.items, maxBatchSize, "unprocessed writes should be retried")
+	assert.Equal(t, 2, table.writes)
+	assert.Empty(t, s.pending)
+}
+
+func TestOpenErrors(t *testing.T) {
+	table := newTableMock()
+	_, err := Open(table, Config{Window: jsonutil.FromDuration(time.Hour)})
+	assert.NotNil(t, err)
+	_, err = Open(table, Config{Table: "dedup", Window: jsonutil.FromDuration(0)})
+	assert.NotNil(t, err)
+	_, err = Open(table, Config{Table: "missing", Window: jsonutil.FromDuration(time.Hour)})
+	assert.NotNil(t, err)
+}
@@ -3,10 +3,7 @@ package deglobber
 
 import (
 	"bytes"
-	"compress/flate"
 	"encoding/json"
-	"fmt"
-	"io"
 	"sync"
 	"time"
 
@@ -14,12 +11,19 @@ import (
 	cache "github.com/patrickmn/go-cache"
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/ack"
+	"github.com/twitchscience/spade/parser"
 	"github.com/twitchscience/spade/processor"
+	"github.com/twitchscience/spade/protoevent"
+	"github.com/twitchscience/spade/reporter"
+	"github.com/twitchscience/spade/writer"
 )
 
+// parseRequest is input for the processor which isn't in an edge envelope.
 type parseRequest struct {
 	data  []byte
 	start time.Time
+	token *ack.Token
 }
 
 func (p *parseRequest) Data() []byte {
@@ -30,27 +34,55 @@ func (p *parseRequest) StartTime() time.Time {
 	return p.start
 }
 
-// Pool turns byte streams into lists of events, then sends them to a processor.Pool. It also handles deduping globs.
+func (p *parseRequest) Token() *ack.Token {
+	return p.token
+}
+
+// eventRequest is an edge event for the processor.
+type eventRequest struct {
+	parseRequest
+	version int
+}
+
+func (e *eventRequest) Version() int {
+	return e.version
+}
+
+type submission struct {
+	glob  []byte
+	token *ack.Token
+}
+
+// EventDeduper remembers the UUIDs of events which have been written, reporting whether
+// each has been seen before.
+type EventDeduper interface {
+	Seen(uuid string) (bool, error)
+	MarkSeen(uuid string) error
+}
+
+// Pool turns byte streams into lists of events, then sends them to a processor.Pool. It also handles deduping
+// globs, and individual events if given an EventDeduper.
 type Pool struct {
-	globs  chan []byte
+	globs  chan submission
 	config PoolConfig
 	wg     sync.WaitGroup
 }
 
 // PoolConfig collects the configuration information for a Pool.
 type PoolConfig struct {
-	ProcessorPool      processor.Pool
-	Stats              statsd.Statter
-	DuplicateCache     *cache.Cache
-	PoolSize           int
-	CompressionVersion byte
-	ReplayMode         bool
+	ProcessorPool  processor.Pool
+	Stats          statsd.Statter
+	DuplicateCache *cache.Cache
+	EventDeduper   EventDeduper       // optional
+	DeadLetters    writer.SpadeWriter // optional, for globs and events which can't be decoded
+	PoolSize       int
+	ReplayMode     bool
 }
 
 // NewPool returns a pool for turning globs into lists of events.
 func NewPool(config PoolConfig) *Pool {
 	return &Pool{
-		globs:  make(chan []byte, 1),
+		globs:  make(chan submission, 1),
 		config: config,
 		wg:     sync.WaitGroup{},
 	}
@@ -64,9 +96,10 @@ func (dp *Pool) Start() {
 	}
 }
 
-// Submit submits a glob to the pool for processing.
-func (dp *Pool) Submit(glob []byte) {
-	dp.globs <- glob
+// Submit submits a glob to the pool for processing. The pool takes over the caller's hold
+// on token, which is passed on with every event in the glob.
+func (dp *Pool) Submit(glob []byte, token *ack.Token) {
+	dp.globs <- submission{glob: glob, token: token}
 }
 
 // Close stops all processing goroutines.
@@ -75,48 +108,94 @@ func (dp *Pool) Close() {
 	dp.wg.Wait()
 }
 
-func (dp *Pool) expandGlob(glob []byte) (events []*spade.Event, err error) {
-	compressed := bytes.NewBuffer(glob)
-
-	v, err := compressed.ReadByte()
+// isDuplicate checks the event's UUID with the EventDeduper, if any, counting duplicates
+// by event type.
+func (dp *Pool) isDuplicate(e *spade.Event) bool {
+	if dp.config.EventDeduper == nil || e.Uuid == "" {
+		return false
+	}
+	seen, err := dp.config.EventDeduper.Seen(e.Uuid)
 	if err != nil {
-		return nil, fmt.Errorf("error reading version byte: %s", err)
+		logger.WithError(err).WithField("uuid", e.Uuid).Error("Failed to check for duplicate event")
+		return false
 	}
-	if v != dp.config.CompressionVersion {
-		return nil, fmt.Errorf("unknown version: got %v expected %v", v, dp.config.CompressionVersion)
+	if !seen {
+		return false
 	}
 
-	deflator := flate.NewReader(compressed)
-	defer func() {
-		if cerr := deflator.Close(); cerr != nil && err == nil {
-			err = fmt.Errorf("error closing glob reader: %v", cerr)
-		}
-	}()
-
-	var decompressed bytes.Buffer
-	_, err = io.Copy(&decompressed, deflator)
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing: %v", err)
+	eventType := "unknown"
+	names, err := parser.EventNames(e)
+	if err == nil && len(names) > 0 && names[0] != "" {
+		eventType = names[0]
 	}
+	dp.statHelper("event.dupe")
+	dp.statHelper("event.dupe." + eventType)
+	return true
+}
 
-	err = json.Unmarshal(decompressed.Bytes(), &events)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshalling: %v", err)
+// markSeen returns a token for an event, held on the token of its glob, which marks the
+// event's UUID seen once every write of the event is durable. Until then, a redelivery of the
+// event isn't dropped as a duplicate, so the event isn't lost if it's never written.
+func (dp *Pool) markSeen(e *spade.Event, token *ack.Token) *ack.Token {
+	token.Hold()
+	if dp.config.EventDeduper == nil || e.Uuid == "" {
+		return token
 	}
+	uuid := e.Uuid
+	return ack.New(func() {
+		if err := dp.config.EventDeduper.MarkSeen(uuid); err != nil {
+			logger.WithError(err).WithField("uuid", uuid).Error("Failed to mark event seen")
+		}
+		token.Release()
+	})
+}
 
-	return
+// legacyUUID returns the UUID of a legacy nginx log line, its last field, or "" if the line
+// doesn't have the four fields of one.
+func legacyUUID(line []byte) string {
+	fields := bytes.Fields(line)
+	if len(fields) != 4 {
+		return ""
+	}
+	return string(fields[3])
 }
 
-func (dp *Pool) processEvent(e *spade.Event) {
+func (dp *Pool) processEvent(e *spade.Event, token *ack.Token) {
+	if dp.isDuplicate(e) {
+		return
+	}
 	now := time.Now()
 	if err := dp.config.Stats.TimingDuration("event.age", now.Sub(e.ReceivedAt), 0.01); err != nil {
 		logger.WithError(err).Error("Failed to submit timing")
 	}
-	d, err := spade.Marshal(e)
-	if err != nil {
+	var d []byte
+	var err error
+	if e.Version == protoevent.Version {
+		d = protoevent.Marshal(e)
+	} else if d, err = spade.Marshal(e); err != nil {
 		logger.WithError(err).WithField("event", e).Error("Failed to marshal event")
 	}
-	dp.config.ProcessorPool.Process(&parseRequest{data: d, start: time.Now()})
+	dp.config.ProcessorPool.Process(&eventRequest{
+		parseRequest: parseRequest{data: d, start: time.Now(), token: dp.markSeen(e, token)},
+		version:      e.Version,
+	})
+}
+
+// deadLetter writes the glob which couldn't be decoded to the DeadLetters writer, if any.
+func (dp *Pool) deadLetter(glob []byte, err error, token *ack.Token) {
+	if dp.config.DeadLetters == nil {
+		return
+	}
+	token.Hold()
+	dp.config.DeadLetters.Write(&writer.WriteRequest{
+		Category: "Unknown",
+		UUID:     "error",
+		Failure:  reporter.UnableToParseData,
+		Pstart:   time.Now(),
+		Error:    err.Error(),
+		Raw:      glob,
+		Token:    token,
+	})
 }
 
 func (dp *Pool) statHelper(stat string) {
@@ -128,41 +207,65 @@ func (dp *Pool) statHelper(stat string) {
 
 func (dp *Pool) crank() {
 	defer dp.wg.Done()
-	for glob := range dp.globs {
-		if dp.config.ReplayMode {
-			// In replay mode, the "glob" is really only one uncompressed event
-			var event spade.Event
-			err := json.Unmarshal(glob, &event)
-			if err != nil {
-				logger.WithError(err).Error("Failed to unmarshal event")
-				continue
+	for s := range dp.globs {
+		dp.deglob(s.glob, s.token)
+		s.token.Release()
+	}
+}
+
+func (dp *Pool) deglob(glob []byte, token *ack.Token) {
+	if dp.config.ReplayMode {
+		// In replay mode, the "glob" is really only one uncompressed event. Lines which
+		// aren't JSON predate the edge's envelopes, so go to the parser as they are, once
+		// they're checked for duplicates by their UUID.
+		if trimmed := bytes.TrimSpace(glob); len(trimmed) > 0 && trimmed[0] != '{' {
+			legacy := &spade.Event{Uuid: legacyUUID(trimmed)}
+			if dp.isDuplicate(legacy) {
+				return
 			}
-			dp.processEvent(&event)
-			continue
+			dp.config.ProcessorPool.Process(&parseRequest{
+				data:  trimmed,
+				start: time.Now(),
+				token: dp.markSeen(legacy, token),
+			})
+			return
+		}
+		var event spade.Event
+		err := json.Unmarshal(glob, &event)
+		if err == nil {
+			err = protoevent.DecodePlaintext(&event)
 		}
-
-		events, err := dp.expandGlob(glob)
 		if err != nil {
-			logger.WithError(err).Error("Failed to expand glob")
-			dp.statHelper("record.failures")
-			continue
+			logger.WithError(err).Error("Failed to unmarshal event")
+			dp.deadLetter(glob, err, token)
+			return
 		}
+		dp.processEvent(&event, token)
+		return
+	}
 
-		if len(events) == 0 {
-			continue
-		}
+	events, err := protoevent.ExpandGlob(glob)
+	if err != nil {
+		logger.WithError(err).Error("Failed to expand glob")
+		dp.statHelper("record.failures")
+		dp.deadLetter(glob, err, token)
+		return
+	}
+
+	if len(events) == 0 {
+		return
+	}
 
-		dp.statHelper("record.count")
+	dp.statHelper("record.count")
 
-		uuid := events[0].Uuid
-		if _, found := dp.config.DuplicateCache.Get(uuid); found {
-			logger.WithField("uuid", uuid).Info("Ignoring duplicate UUID")
-			dp.statHelper("record.dupe")
-		} else {
-			for _, e := range events {
-				dp.processEvent(e)
-			}
+	uuid := events[0].Uuid
+	if _, found := dp.config.DuplicateCache.Get(uuid); found {
+		logger.WithField("uuid", uuid).Info("Ignoring duplicate UUID")
+		dp.statHelper("record.dupe")
+	} else {
+		for _, e := range events {
+			dp.processEvent(e, token)
 		}
-		dp.config.DuplicateCache.Set(uuid, 0, cache.DefaultExpiration)
 	}
+	dp.config.DuplicateCache.Set(uuid, 0, cache.DefaultExpiration)
 }
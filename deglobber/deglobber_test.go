@@ -3,6 +3,7 @@ package deglobber
 import (
 	"bytes"
 	"compress/flate"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -12,7 +13,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/ack"
+	"github.com/twitchscience/spade/codec"
 	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/protoevent"
+	"github.com/twitchscience/spade/reporter"
+	"github.com/twitchscience/spade/writer"
 )
 
 var (
@@ -45,25 +51,34 @@ func compressBytes(t *testing.T, compressionVersion byte, data []byte) []byte {
 	return append([]byte{compressionVersion}, scratch.Bytes()...)
 }
 
-// Test that the glob is correctly decompressed.
-func TestExpandGlobNominal(t *testing.T) {
-	dp := NewPool(PoolConfig{
-		CompressionVersion: 1,
-	})
-	events, err := dp.expandGlob(compressBytes(t, 1, testMsg1))
+func protobufEvent(t *testing.T, uuid string) *spade.Event {
+	data, err := protoevent.MarshalBags([]protoevent.Bag{{Event: "login", Properties: json.RawMessage(`{"a": 1}`)}})
 	require.Nil(t, err)
-	require.Equal(t, 1, len(events))
-	assert.Equal(t, "x", events[0].Uuid)
-	assert.Equal(t, "test", events[0].Data)
+	return &spade.Event{Uuid: uuid, Data: string(data), Version: protoevent.Version}
 }
 
-// Test that a bad decompression version causes an error.
-func TestExpandGlobBadVersion(t *testing.T) {
+// Test that events with protobuf data reach the processor in the protobuf envelope.
+func TestCrankProtobuf(t *testing.T) {
+	mpp := mockProcessorPool{}
 	dp := NewPool(PoolConfig{
-		CompressionVersion: 2,
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mpp,
+		DuplicateCache: cache.New(time.Minute, time.Minute),
+		PoolSize:       1,
 	})
-	_, err := dp.expandGlob(compressBytes(t, 1, testMsg1))
-	assert.NotNil(t, err)
+	glob, err := protoevent.Glob([]*spade.Event{protobufEvent(t, "x")}, codec.Zstd)
+	require.Nil(t, err)
+	dp.Start()
+	dp.Submit(glob, nil)
+	dp.Close()
+
+	require.Equal(t, 1, len(mpp.receivedParseables))
+	versioned, ok := mpp.receivedParseables[0].(parser.Versioned)
+	require.True(t, ok)
+	assert.Equal(t, protoevent.Version, versioned.Version())
+	var event spade.Event
+	require.Nil(t, protoevent.Unmarshal(mpp.receivedParseables[0].Data(), &event))
+	assert.Equal(t, "x", event.Uuid)
 }
 
 // Test that crank processes events and dupes correctly.
@@ -71,16 +86,15 @@ func TestCrank(t *testing.T) {
 	mpp := mockProcessorPool{}
 	dc := cache.New(time.Minute, time.Minute)
 	dp := NewPool(PoolConfig{
-		CompressionVersion: 1,
-		Stats:              &statsd.NoopClient{},
-		ProcessorPool:      &mpp,
-		DuplicateCache:     dc,
-		PoolSize:           1,
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mpp,
+		DuplicateCache: dc,
+		PoolSize:       1,
 	})
 	dp.Start()
-	dp.Submit(compressBytes(t, 1, testMsg1))
-	dp.Submit(compressBytes(t, 1, dupeMsg))
-	dp.Submit(compressBytes(t, 1, testMsg2))
+	dp.Submit(compressBytes(t, 1, testMsg1), nil)
+	dp.Submit(compressBytes(t, 1, dupeMsg), nil)
+	dp.Submit(compressBytes(t, 1, testMsg2), nil)
 	dp.Close()
 	require.Equal(t, 2, len(mpp.receivedParseables))
 	var event, event2 spade.Event
@@ -89,3 +103,181 @@ func TestCrank(t *testing.T) {
 	require.Nil(t, spade.Unmarshal(mpp.receivedParseables[1].Data(), &event2))
 	assert.Equal(t, "test3", event2.Data)
 }
+
+// Test that replayed events keep their record version, protobuf data is decoded from base64,
+// and legacy lines are passed on as is.
+func TestCrankReplay(t *testing.T) {
+	mpp := mockProcessorPool{}
+	dp := NewPool(PoolConfig{
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mpp,
+		DuplicateCache: cache.New(time.Minute, time.Minute),
+		ReplayMode:     true,
+		PoolSize:       1,
+	})
+	dp.Start()
+	dp.Submit([]byte(`{"uuid": "a", "recordversion": 3}`+"\n"), nil)
+	dp.Submit([]byte("10.0.0.1 [1395707641.000] data=e30= b\n"), nil)
+	protobuf, err := json.Marshal(protoevent.EncodePlaintext(protobufEvent(t, "p")))
+	require.Nil(t, err)
+	dp.Submit(protobuf, nil)
+	dp.Close()
+
+	require.Equal(t, 3, len(mpp.receivedParseables))
+	versioned, ok := mpp.receivedParseables[0].(parser.Versioned)
+	require.True(t, ok)
+	assert.Equal(t, 3, versioned.Version())
+	_, ok = mpp.receivedParseables[1].(parser.Versioned)
+	assert.False(t, ok)
+	assert.Equal(t, "10.0.0.1 [1395707641.000] data=e30= b", string(mpp.receivedParseables[1].Data()))
+	var event spade.Event
+	require.Nil(t, protoevent.Unmarshal(mpp.receivedParseables[2].Data(), &event))
+	assert.Equal(t, protobufEvent(t, "p").Data, event.Data)
+}
+
+// Test that tokens are released once every event from their glob is released.
+func TestCrankTokens(t *testing.T) {
+	mpp := mockProcessorPool{}
+	dp := NewPool(PoolConfig{
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mpp,
+		DuplicateCache: cache.New(time.Minute, time.Minute),
+		PoolSize:       1,
+	})
+	acked := map[string]bool{}
+	dp.Start()
+	dp.Submit(compressBytes(t, 1, testMsg1), ack.New(func() { acked["first"] = true }))
+	dp.Submit(compressBytes(t, 1, dupeMsg), ack.New(func() { acked["dupe"] = true }))
+	dp.Submit([]byte("garbage"), ack.New(func() { acked["garbage"] = true }))
+	dp.Close()
+
+	assert.Equal(t, map[string]bool{"dupe": true, "garbage": true}, acked)
+	require.Equal(t, 1, len(mpp.receivedParseables))
+	parser.TokenOf(mpp.receivedParseables[0]).Release()
+	assert.True(t, acked["first"])
+}
+
+type mockDeduper struct {
+	seen map[string]bool
+}
+
+func (m *mockDeduper) Seen(uuid string) (bool, error) {
+	return m.seen[uuid], nil
+}
+
+func (m *mockDeduper) MarkSeen(uuid string) error {
+	m.seen[uuid] = true
+	return nil
+}
+
+// Test that every event in a glob is checked with the EventDeduper, not just the first.
+func TestCrankEventDedup(t *testing.T) {
+	mpp := mockProcessorPool{}
+	dp := NewPool(PoolConfig{
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mpp,
+		DuplicateCache: cache.New(time.Minute, time.Minute),
+		EventDeduper:   &mockDeduper{seen: map[string]bool{}},
+		PoolSize:       1,
+	})
+	dp.Start()
+	dp.Submit(compressBytes(t, 1, []byte(`[{"uuid": "a"}, {"uuid": "b"}]`)), nil)
+	dp.Close()
+	for _, r := range mpp.receivedParseables {
+		parser.TokenOf(r).Release()
+	}
+
+	dp = NewPool(dp.config)
+	dp.Start()
+	dp.Submit(compressBytes(t, 1, []byte(`[{"uuid": "c"}, {"uuid": "b", "data": "eyJldmVudCI6ICJ4In0="}]`)), nil)
+	dp.Close()
+
+	require.Equal(t, 3, len(mpp.receivedParseables))
+	var event spade.Event
+	require.Nil(t, spade.Unmarshal(mpp.receivedParseables[2].Data(), &event))
+	assert.Equal(t, "c", event.Uuid)
+}
+
+// Test that an event is only marked seen once it's written, after which the glob's token is
+// released.
+func TestCrankEventDedupOnAck(t *testing.T) {
+	mpp := mockProcessorPool{}
+	deduper := &mockDeduper{seen: map[string]bool{}}
+	dp := NewPool(PoolConfig{
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mpp,
+		DuplicateCache: cache.New(time.Minute, time.Minute),
+		EventDeduper:   deduper,
+		PoolSize:       1,
+	})
+	acked := false
+	dp.Start()
+	dp.Submit(compressBytes(t, 1, []byte(`[{"uuid": "a"}]`)), ack.New(func() { acked = true }))
+	dp.Close()
+
+	require.Equal(t, 1, len(mpp.receivedParseables))
+	assert.False(t, deduper.seen["a"], "an unwritten event should not be marked seen")
+	parser.TokenOf(mpp.receivedParseables[0]).Release()
+	assert.True(t, deduper.seen["a"])
+	assert.True(t, acked)
+}
+
+// Test that replayed legacy lines are checked with the EventDeduper by their UUID.
+func TestCrankReplayLegacyDedup(t *testing.T) {
+	mpp := mockProcessorPool{}
+	deduper := &mockDeduper{seen: map[string]bool{"a": true}}
+	dp := NewPool(PoolConfig{
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mpp,
+		DuplicateCache: cache.New(time.Minute, time.Minute),
+		EventDeduper:   deduper,
+		ReplayMode:     true,
+		PoolSize:       1,
+	})
+	dp.Start()
+	dp.Submit([]byte("10.0.0.1 [1395707641.000] data=e30= a\n"), nil)
+	dp.Submit([]byte("10.0.0.1 [1395707641.000] data=e30= b\n"), nil)
+	dp.Close()
+
+	require.Equal(t, 1, len(mpp.receivedParseables))
+	assert.Equal(t, "10.0.0.1 [1395707641.000] data=e30= b", string(mpp.receivedParseables[0].Data()))
+	assert.False(t, deduper.seen["b"], "an unwritten line should not be marked seen")
+	parser.TokenOf(mpp.receivedParseables[0]).Release()
+	assert.True(t, deduper.seen["b"])
+}
+
+type mockSpadeWriter struct {
+	requests []*writer.WriteRequest
+}
+
+func (m *mockSpadeWriter) Write(r *writer.WriteRequest) {
+	m.requests = append(m.requests, r)
+}
+
+func (m *mockSpadeWriter) Close() error { return nil }
+
+func (m *mockSpadeWriter) Rotate() (bool, error) { return true, nil }
+
+// Test that globs which can't be expanded are dead lettered, holding their token.
+func TestCrankDeadLetters(t *testing.T) {
+	deadLetters := &mockSpadeWriter{}
+	dp := NewPool(PoolConfig{
+		Stats:          &statsd.NoopClient{},
+		ProcessorPool:  &mockProcessorPool{},
+		DuplicateCache: cache.New(time.Minute, time.Minute),
+		DeadLetters:    deadLetters,
+		PoolSize:       1,
+	})
+	acked := false
+	dp.Start()
+	dp.Submit([]byte("garbage"), ack.New(func() { acked = true }))
+	dp.Close()
+
+	require.Equal(t, 1, len(deadLetters.requests))
+	assert.Equal(t, []byte("garbage"), deadLetters.requests[0].Raw)
+	assert.Equal(t, reporter.UnableToParseData, deadLetters.requests[0].Failure)
+	assert.NotEmpty(t, deadLetters.requests[0].Error)
+	assert.False(t, acked)
+	deadLetters.requests[0].Token.Release()
+	assert.True(t, acked)
+}
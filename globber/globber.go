@@ -2,34 +2,48 @@ package globber
 
 import (
 	"bytes"
-	"compress/flate"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
+	"github.com/twitchscience/spade/ack"
+	"github.com/twitchscience/spade/codec"
 )
 
 var (
-	prefix         = '['
-	separator      = ','
-	postfix        = ']'
-	version   byte = 1
+	prefix    = '['
+	separator = ','
+	postfix   = ']'
 )
 
+type resetter interface {
+	Reset(io.Writer)
+}
+
 // Complete is the type of a function that Globber will
-// call for every completed glob
-type Complete func([]byte)
+// call for every completed glob, along with the tokens
+// submitted with its entries
+type Complete func([]byte, []*ack.Token)
+
+type entry struct {
+	data   []byte
+	tokens []*ack.Token
+}
 
 // A Globber is an object that will combine a bunch of json marshallable
 // objects into compressed json array
 type Globber struct {
 	config     scoop_protocol.GlobberConfig
 	completor  Complete
-	compressor *flate.Writer
-	incoming   chan []byte
+	version    byte
+	codec      codec.Codec
+	compressor io.WriteCloser
+	incoming   chan entry
 	pending    bytes.Buffer
+	tokens     []*ack.Token
 	timer      *time.Timer
 	maxAge     time.Duration
 
@@ -46,13 +60,23 @@ func New(config scoop_protocol.GlobberConfig, completor Complete) (*Globber, err
 	if err != nil {
 		return nil, fmt.Errorf("config MaxAge failed parsing as a duration: %s", err)
 	}
+	version, err := codec.VersionOf(config.Codec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %s", err)
+	}
+	c, err := codec.Lookup(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %s", err)
+	}
 
 	g := &Globber{
 		config:    config,
 		completor: completor,
+		version:   version,
+		codec:     c,
 		maxAge:    maxAge,
 		timer:     time.NewTimer(maxAge),
-		incoming:  make(chan []byte, config.BufferLength),
+		incoming:  make(chan entry, config.BufferLength),
 	}
 
 	g.Add(1)
@@ -60,9 +84,10 @@ func New(config scoop_protocol.GlobberConfig, completor Complete) (*Globber, err
 	return g, nil
 }
 
-// Submit submits an object for globbing
-func (g *Globber) Submit(e []byte) {
-	g.incoming <- e
+// Submit submits an object for globbing, taking over the caller's
+// holds on the given tokens until the glob is completed
+func (g *Globber) Submit(e []byte, tokens ...*ack.Token) {
+	g.incoming <- entry{data: e, tokens: tokens}
 }
 
 // Close stops the globbing process. Will return after all
@@ -73,8 +98,8 @@ func (g *Globber) Close() {
 }
 
 /* #nosec */
-func (g *Globber) add(entry []byte) error {
-	s := len(entry) + g.pending.Len()
+func (g *Globber) add(e entry) error {
+	s := len(e.data) + g.pending.Len()
 	if s > g.config.MaxSize {
 		if err := g.complete(); err != nil {
 			return fmt.Errorf("error completing glob: %s", err)
@@ -87,7 +112,8 @@ func (g *Globber) add(entry []byte) error {
 	} else {
 		_, _ = g.pending.WriteRune(separator)
 	}
-	_, _ = g.pending.Write(entry)
+	_, _ = g.pending.Write(e.data)
+	g.tokens = append(g.tokens, e.tokens...)
 	return nil
 }
 
@@ -110,14 +136,12 @@ func (g *Globber) _complete() error {
 	var err error
 
 	/* #nosec */
-	_ = compressed.WriteByte(version)
+	_ = compressed.WriteByte(g.version)
 
-	if g.compressor == nil {
-		if g.compressor, err = flate.NewWriter(&compressed, flate.BestSpeed); err != nil {
-			return err
-		}
-	} else {
-		g.compressor.Reset(&compressed)
+	if r, ok := g.compressor.(resetter); ok {
+		r.Reset(&compressed)
+	} else if g.compressor, err = g.codec.NewWriter(&compressed); err != nil {
+		return err
 	}
 	if _, err = g.compressor.Write(g.pending.Bytes()); err != nil {
 		return err
@@ -127,8 +151,9 @@ func (g *Globber) _complete() error {
 		return err
 	}
 
-	g.completor(compressed.Bytes())
+	g.completor(compressed.Bytes(), g.tokens)
 	g.pending.Reset()
+	g.tokens = nil
 	return nil
 }
 
@@ -1,16 +1,14 @@
 package globber
 
 import (
-	"bytes"
-	"compress/flate"
 	"encoding/json"
-	"fmt"
-	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
+	"github.com/twitchscience/spade/ack"
+	"github.com/twitchscience/spade/codec"
 )
 
 func TestInvalidConfig(t *testing.T) {
@@ -38,30 +36,6 @@ func TestInvalidConfig(t *testing.T) {
 	assert.Error(t, config.Validate())
 }
 
-func decompress(b []byte) ([]byte, error) {
-	compressed := bytes.NewBuffer(b)
-
-	v, err := compressed.ReadByte()
-	if err != nil {
-		return nil, fmt.Errorf("Error reading version byte: %s", err)
-	}
-	if v != version {
-		return nil, fmt.Errorf("Unknown version, got %v expected %v", v, version)
-	}
-
-	deflator := flate.NewReader(compressed)
-	defer func() {
-		_ = deflator.Close()
-	}()
-
-	var decompressed bytes.Buffer
-	_, err = io.Copy(&decompressed, deflator)
-	if err != nil {
-		return nil, fmt.Errorf("Error decompressiong: %v", err)
-	}
-	return decompressed.Bytes(), nil
-}
-
 func TestGlobber(t *testing.T) {
 	data := []map[string]string{
 		{
@@ -87,8 +61,8 @@ func TestGlobber(t *testing.T) {
 
 	var result []byte
 
-	g, err := New(config, func(b []byte) {
-		r, e := decompress(b)
+	g, err := New(config, func(b []byte, _ []*ack.Token) {
+		r, e := codec.Decompress(b)
 		require.NoError(t, e)
 		result = r
 	})
@@ -102,3 +76,37 @@ func TestGlobber(t *testing.T) {
 
 	assert.EqualValues(t, expected, result)
 }
+
+func TestGlobberCodecs(t *testing.T) {
+	for _, name := range []string{"gzip", "zstd", "snappy"} {
+		config := scoop_protocol.GlobberConfig{
+			MaxSize:      1 * 1024 * 1024,
+			MaxAge:       "1m",
+			BufferLength: 5,
+			Codec:        name,
+		}
+		var globs [][]byte
+		g, err := New(config, func(b []byte, _ []*ack.Token) {
+			globs = append(globs, b)
+		})
+		require.NoError(t, err)
+		g.Submit([]byte(`"a"`))
+		g.Close()
+
+		require.Len(t, globs, 1, name)
+		version, err := codec.VersionOf(name)
+		require.NoError(t, err)
+		assert.Equal(t, version, globs[0][0], name)
+		r, err := codec.Decompress(globs[0])
+		require.NoError(t, err, name)
+		assert.Equal(t, `["a"]`, string(r), name)
+	}
+
+	_, err := New(scoop_protocol.GlobberConfig{
+		MaxSize:      1,
+		MaxAge:       "1m",
+		BufferLength: 5,
+		Codec:        "lz4",
+	}, func([]byte, []*ack.Token) {})
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package lookup
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -22,6 +23,8 @@ type JSONValueFetcherConfig struct {
 	TimeoutMS     int    // Timeout in ms for GET requests
 	RatePerSecond int    // Amount of requests allowed per second
 	BurstSize     int    // Maximum amount of concurrent requests possible
+	// Fields are the JQ expressions of named fields, for fetching several values at once
+	Fields map[string]string
 }
 
 // JSONValueFetcher extracts a particular field value from a JSON obtained through a GET request
@@ -71,6 +74,11 @@ func NewJSONValueFetcher(
 	if err != nil {
 		return nil, err
 	}
+	for name, exp := range config.Fields {
+		if err = validateJQ(exp); err != nil {
+			return nil, fmt.Errorf("validating JQ of field %s: %v", name, err)
+		}
+	}
 	handler := BasicHTTPRequestHandler{&http.Client{
 		Timeout: time.Duration(config.TimeoutMS) * time.Millisecond,
 	}}
@@ -117,3 +125,58 @@ func (f *JSONValueFetcher) FetchInt64(args map[string]string) (int64, error) {
 	}
 	return value, nil
 }
+
+// FetchString constructs a GET HTTP query with the provided map as URL arguments and returns the
+// value, which may be of any JSON type, as a string
+func (f *JSONValueFetcher) FetchString(args map[string]string) (string, error) {
+	parser, err := f.fetchHelper(args)
+	if err != nil {
+		return "", err
+	}
+	return queryToString(parser, f.config.JQ)
+}
+
+// FetchFields constructs a GET HTTP query with the provided map as URL arguments and returns the
+// values of the configured Fields as strings. Fields missing from the response are left out,
+// unless they all are.
+func (f *JSONValueFetcher) FetchFields(args map[string]string) (map[string]string, error) {
+	parser, err := f.fetchHelper(args)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string, len(f.config.Fields))
+	for name, exp := range f.config.Fields {
+		if value, err := queryToString(parser, exp); err == nil {
+			fields[name] = value
+		}
+	}
+	if len(fields) == 0 {
+		return nil, ErrExtractingValue
+	}
+	return fields, nil
+}
+
+// queryToString returns the value of the JQ expression as a string; numbers are formatted
+// without exponents, and objects and arrays as JSON.
+func queryToString(parser *gojq.JQ, exp string) (string, error) {
+	value, err := parser.Query(exp)
+	if err != nil {
+		return "", ErrExtractingValue
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case nil:
+		return "", ErrExtractingValue
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", ErrExtractingValue
+		}
+		return string(b), nil
+	}
+}
@@ -87,6 +87,53 @@ func TestInvalidFieldJSONValueFetch(t *testing.T) {
 	}
 }
 
+func TestStringJSONValueFetch(t *testing.T) {
+	for body, expected := range map[string]string{
+		`{"results": [{"id": 96046250}]}`:  "96046250",
+		`{"results": [{"id": "a1b2"}]}`:    "a1b2",
+		`{"results": [{"id": true}]}`:      "true",
+		`{"results": [{"id": {"a": 1}}]}`:  `{"a":1}`,
+		`{"results": [{"id": 12345.5}]}`:   "12345.5",
+		`{"results": [{"login": "cado"}]}`: "",
+		`{"results": [{"id": null}]}`:      "",
+	} {
+		fetcher := newFetcher(&DummyHTTPRequestHandler{JSON: []byte(body)})
+		value, err := fetcher.FetchString(map[string]string{"login": "cado"})
+		if expected == "" {
+			if err != ErrExtractingValue {
+				t.Errorf("%s: expected ErrExtractingValue, got %q, %v", body, value, err)
+			}
+			continue
+		}
+		if err != nil || value != expected {
+			t.Errorf("%s: expected %q, got %q, %v", body, expected, value, err)
+		}
+	}
+}
+
+func TestFieldsJSONValueFetch(t *testing.T) {
+	fetcher := newFetcher(&DummyHTTPRequestHandler{
+		JSON: []byte(`{"results": [{"id": 488552, "name": "Overwatch"}]}`),
+	})
+	fetcher.config.Fields = map[string]string{
+		"id":      "results.[0].id",
+		"name":    "results.[0].name",
+		"box_art": "results.[0].box_art",
+	}
+	fields, err := fetcher.FetchFields(map[string]string{"name": "overwatch"})
+	if err != nil {
+		t.Fatalf("Failed to fetch fields with error '%v'", err)
+	}
+	if len(fields) != 2 || fields["id"] != "488552" || fields["name"] != "Overwatch" {
+		t.Fatalf("Fetched fields are incorrect: %v", fields)
+	}
+
+	fetcher.config.Fields = map[string]string{"box_art": "results.[0].box_art"}
+	if _, err = fetcher.FetchFields(map[string]string{"name": "overwatch"}); err != ErrExtractingValue {
+		t.Fatalf("Expected ErrExtractingValue when no field is found, got %v", err)
+	}
+}
+
 func TestMultipleValidJSONValueFetches(t *testing.T) {
 	handler := &DummyHTTPRequestHandler{
 		JSON: []byte(`{"results": [{"id": 96046250,"login": "cado"}]}`),
@@ -3,4 +3,8 @@ package lookup
 // ValueFetcher fetches values with a given set of arguments.
 type ValueFetcher interface {
 	FetchInt64(map[string]string) (int64, error)
+	// FetchString fetches a value of any type, formatted as a string.
+	FetchString(map[string]string) (string, error)
+	// FetchFields fetches several named values at once, formatted as strings.
+	FetchFields(map[string]string) (map[string]string, error)
 }
@@ -4,10 +4,11 @@ Spade pipeline. It consumes data written by the Spade Edge, processes it
 according to rules in Blueprint, and writes it to Kinesis streams and TSV
 files intended for Redshift (via rs_ingester). It expects events to be a
 base64 encoded JSON object or list of objects with an event field and a
-properties field. It rejects any data it doesn’t recognize or cannot decode,
-including unmapped properties of correctly formatted data. Decodable but
-unmapped event types are flushed to S3 periodically so that Blueprint can
-suggest schemas for them.
+properties field. It rejects any data it doesn’t recognize or cannot decode.
+Properties of correctly formatted data which no column maps are counted, and
+the events of tables with strict schemas are rejected for them. Decodable but
+unmapped event types, and a sample of events with unmapped properties, are
+flushed to S3 periodically so that Blueprint can suggest schemas for them.
 */
 package main
 
@@ -27,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/kinesis"
@@ -47,28 +49,37 @@ import (
 	"github.com/twitchscience/spade/config"
 	"github.com/twitchscience/spade/config_fetcher/fetcher"
 	"github.com/twitchscience/spade/consumer"
+	"github.com/twitchscience/spade/dedup"
 	"github.com/twitchscience/spade/deglobber"
 	eventMetadataConfig "github.com/twitchscience/spade/event_metadata"
 	"github.com/twitchscience/spade/geoip"
 	"github.com/twitchscience/spade/kinesisconfigs"
 	"github.com/twitchscience/spade/lookup"
+	"github.com/twitchscience/spade/parser"
+	// Register the parsers which can be selected in the config.
+	_ "github.com/twitchscience/spade/parser/json"
+	_ "github.com/twitchscience/spade/parser/nginx"
+	_ "github.com/twitchscience/spade/parser/partner"
+	_ "github.com/twitchscience/spade/parser/protobuf"
+	"github.com/twitchscience/spade/pii"
 	"github.com/twitchscience/spade/processor"
 	"github.com/twitchscience/spade/reporter"
 	tableConfig "github.com/twitchscience/spade/tables"
 	"github.com/twitchscience/spade/transformer"
 	"github.com/twitchscience/spade/uploader"
+	"github.com/twitchscience/spade/useragent"
 	"github.com/twitchscience/spade/writer"
 )
 
 const (
-	redshiftUploaderNumWorkers          = 6
-	blueprintUploaderNumWorkers         = 1
-	rotationCheckFrequency              = 2 * time.Second
-	duplicateCacheExpiry                = 5 * time.Minute
-	duplicateCacheCleanupFrequency      = 1 * time.Minute
-	networkTimeout                      = 6200 * time.Millisecond
-	compressionVersion             byte = 1
-	spadeWriterKey                      = "SpadeWriter"
+	redshiftUploaderNumWorkers     = 6
+	blueprintUploaderNumWorkers    = 1
+	deadLetterUploaderNumWorkers   = 1
+	rotationCheckFrequency         = 2 * time.Second
+	duplicateCacheExpiry           = 5 * time.Minute
+	duplicateCacheCleanupFrequency = 1 * time.Minute
+	networkTimeout                 = 6200 * time.Millisecond
+	spadeWriterKey                 = "SpadeWriter"
 )
 
 var (
@@ -87,11 +98,14 @@ type spadeProcessor struct {
 
 	resultPipe            consumer.ResultPipe
 	deglobberPool         *deglobber.Pool
+	dedupStore            *dedup.Store
 	processorPool         processor.Pool
 	multee                *writer.Multee
 	spadeUploaderPool     *aws_uploader.UploaderPool
 	blueprintUploaderPool *aws_uploader.UploaderPool
-	closers               []closer
+	// deadLetterUploader is nil unless a DeadLetterBucketName is configured.
+	deadLetterUploader *aws_uploader.UploaderPool
+	closers            []closer
 
 	rotation <-chan time.Time
 	sigc     chan os.Signal
@@ -100,6 +114,7 @@ type spadeProcessor struct {
 type spadeProcessorDeps struct {
 	s3              s3iface.S3API
 	s3Uploader      s3manageriface.UploaderAPI
+	dynamodb        dynamodbiface.DynamoDBAPI
 	sns             snsiface.SNSAPI
 	elasticache     elasticacheiface.ElastiCacheAPI
 	kinesisFactory  writer.KinesisFactory
@@ -114,9 +129,14 @@ type spadeProcessorDeps struct {
 	cfg                 *config.Config
 	runTag              string
 	replay              bool
+	// redrive is set if resultPipe provides events which were already processed once.
+	redrive bool
+	// plaintextInput is set if resultPipe provides single plaintext events rather than globs.
+	plaintextInput bool
 }
 
-func buildDeps(cfg *config.Config, runTag string, replay bool) (*spadeProcessorDeps, error) {
+func buildDeps(cfg *config.Config, runTag string, replay bool, replayOpts *replayOptions,
+	redriveOpts *redriveOptions) (*spadeProcessorDeps, error) {
 	// aws resources
 	session, err := session.NewSession(&aws.Config{
 		HTTPClient: &http.Client{
@@ -144,13 +164,45 @@ func buildDeps(cfg *config.Config, runTag string, replay bool) (*spadeProcessorD
 		return nil, fmt.Errorf("creating statsd statter: %v", err)
 	}
 	ss := &memcache.ServerList{}
+	dynamodb := dynamodb.New(session)
 
 	var resultPipe consumer.ResultPipe
-	if replay {
+	plaintextInput := replay
+	if replayOpts != nil {
+		var edgeS3 s3iface.S3API = s3.New(session)
+		if replayOpts.localDir != "" {
+			edgeS3 = &consumer.LocalS3{Root: replayOpts.localDir}
+		}
+		resultPipe, err = consumer.NewEdgeLogPipe(edgeS3, replayOpts.edgeLogs)
+		if err != nil {
+			return nil, fmt.Errorf("creating edge log consumer: %v", err)
+		}
+	} else if redriveOpts != nil {
+		var redriveS3 s3iface.S3API = s3.New(session)
+		if redriveOpts.localDir != "" {
+			redriveS3 = &consumer.LocalS3{Root: redriveOpts.localDir}
+		}
+		resultPipe, err = consumer.NewRedrivePipe(redriveS3, redriveOpts.redrive)
+		if err != nil {
+			return nil, fmt.Errorf("creating redrive consumer: %v", err)
+		}
+		plaintextInput = true
+	} else if replay {
 		resultPipe = consumer.NewStandardInputPipe()
+	} else if cfg.DirectoryConsumer != nil {
+		resultPipe, err = consumer.NewDirectoryPipe(*cfg.DirectoryConsumer)
+		if err != nil {
+			return nil, fmt.Errorf("creating directory consumer: %v", err)
+		}
+		plaintextInput = !cfg.DirectoryConsumer.Globs
+	} else if cfg.HTTPConsumer != nil {
+		resultPipe, err = consumer.NewHTTPPipe(*cfg.HTTPConsumer)
+		if err != nil {
+			return nil, fmt.Errorf("creating http consumer: %v", err)
+		}
 	} else {
 		resultPipe, err = consumer.NewKinesisPipe(
-			kinesis.New(session), dynamodb.New(session), statsd, cfg.Consumer)
+			kinesis.New(session), dynamodb, statsd, cfg.Consumer)
 		if err != nil {
 			return nil, fmt.Errorf("creating consumer: %v", err)
 		}
@@ -160,6 +212,7 @@ func buildDeps(cfg *config.Config, runTag string, replay bool) (*spadeProcessorD
 	return &spadeProcessorDeps{
 		s3:                  s3,
 		s3Uploader:          s3manager.NewUploaderWithClient(s3),
+		dynamodb:            dynamodb,
 		sns:                 sns.New(session),
 		elasticache:         elasticache.New(session),
 		kinesisFactory:      &writer.DefaultKinesisFactory{Session: session},
@@ -173,10 +226,22 @@ func buildDeps(cfg *config.Config, runTag string, replay bool) (*spadeProcessorD
 		cfg:                 cfg,
 		runTag:              runTag,
 		replay:              replay,
+		redrive:             redriveOpts != nil,
+		plaintextInput:      plaintextInput,
 	}, nil
 }
 
 func newProcessor(deps *spadeProcessorDeps) (*spadeProcessor, error) {
+	// The salt must be set before the writers and schemas hashing with it are built.
+	var saltLoader *pii.SaltLoader
+	if deps.cfg.PII != nil {
+		var err error
+		saltLoader, err = pii.NewSaltLoader(*deps.cfg.PII)
+		if err != nil {
+			return nil, fmt.Errorf("loading hash salt: %v", err)
+		}
+		logger.Go(saltLoader.Crank)
+	}
 
 	reporterStats := reporter.WrapCactusStatter(deps.stats, 0.01)
 	spadeReporter := reporter.BuildSpadeReporter(
@@ -195,13 +260,37 @@ func newProcessor(deps *spadeProcessorDeps) (*spadeProcessor, error) {
 		return nil, fmt.Errorf("initializing directories: %v", err)
 	}
 
+	// Redrives read dead letters and nontracked events, so events which fail or have no
+	// schema again are only counted, and can be redriven again later.
+	var deadLetterUploaderPool *aws_uploader.UploaderPool
+	var deadLetterWriter writer.SpadeWriter
+	if deps.cfg.DeadLetterBucketName != "" && !deps.redrive {
+		deadLetterUploaderPool = uploader.BuildUploaderForDeadLetters(
+			deadLetterUploaderNumWorkers, deps.s3Uploader, deps.cfg.DeadLetterBucketName, deps.replay)
+		err = initializeDeadLetterDirectory(deps.cfg.SpadeDir+"/"+writer.DeadLetterDir+"/", deadLetterUploaderPool)
+		if err != nil {
+			return nil, fmt.Errorf("initializing dead letter directory: %v", err)
+		}
+		deadLetterWriter = writer.NewDeadLetterWriter(deps.cfg.SpadeDir, spadeReporter,
+			deadLetterUploaderPool, deps.cfg.MaxLogBytes, deps.cfg.DeadLetterMaxLogAgeSecs)
+	}
+
+	nonTrackedUploaderPool := blueprintUploaderPool
+	if deps.redrive {
+		nonTrackedUploaderPool = nil
+	}
 	multee := writer.NewMultee()
 	spadeWriter := writer.NewWriterController(deps.cfg.SpadeDir, spadeReporter,
-		spadeUploaderPool, blueprintUploaderPool,
+		spadeUploaderPool, nonTrackedUploaderPool, deadLetterWriter,
 		deps.cfg.MaxLogBytes, deps.cfg.MaxLogAgeSecs, deps.cfg.NontrackedMaxLogAgeSecs)
 	multee.Add(spadeWriterKey, spadeWriter)
 
-	for _, c := range deps.cfg.KinesisOutputs {
+	// Redrives only reload Redshift, so nothing is published to Kinesis.
+	kinesisOutputs := deps.cfg.KinesisOutputs
+	if deps.redrive {
+		kinesisOutputs = nil
+	}
+	for _, c := range kinesisOutputs {
 		w, werr := writer.NewKinesisWriter(
 			deps.kinesisFactory,
 			deps.firehoseFactory,
@@ -223,15 +312,28 @@ func newProcessor(deps *spadeProcessorDeps) (*spadeProcessor, error) {
 	if err != nil {
 		return nil, fmt.Errorf("starting processor pool: %v", err)
 	}
+	if saltLoader != nil {
+		closers = append(closers, saltLoader)
+	}
 
-	deglobberPool := deglobber.NewPool(deglobber.PoolConfig{
-		ProcessorPool:      processorPool,
-		Stats:              deps.stats,
-		DuplicateCache:     cache.New(duplicateCacheExpiry, duplicateCacheCleanupFrequency),
-		PoolSize:           runtime.NumCPU(),
-		CompressionVersion: compressionVersion,
-		ReplayMode:         deps.replay,
-	})
+	deglobberConfig := deglobber.PoolConfig{
+		ProcessorPool:  processorPool,
+		Stats:          deps.stats,
+		DuplicateCache: cache.New(duplicateCacheExpiry, duplicateCacheCleanupFrequency),
+		PoolSize:       runtime.NumCPU(),
+		ReplayMode:     deps.plaintextInput,
+		DeadLetters:    deadLetterWriter,
+	}
+	// Replays and redrives deliberately reprocess events, so they aren't deduped.
+	var dedupStore *dedup.Store
+	if deps.cfg.Dedup != nil && !deps.replay && !deps.redrive {
+		dedupStore, err = dedup.Open(deps.dynamodb, *deps.cfg.Dedup)
+		if err != nil {
+			return nil, fmt.Errorf("opening dedup store: %v", err)
+		}
+		deglobberConfig.EventDeduper = dedupStore
+	}
+	deglobberPool := deglobber.NewPool(deglobberConfig)
 	deglobberPool.Start()
 
 	gip := geoip.NewUpdater(time.Now(), deps.geoip, *deps.cfg.Geoip, deps.s3)
@@ -244,10 +346,12 @@ func newProcessor(deps *spadeProcessorDeps) (*spadeProcessor, error) {
 		spadeReporter:         spadeReporter,
 		resultPipe:            deps.resultPipe,
 		deglobberPool:         deglobberPool,
+		dedupStore:            dedupStore,
 		processorPool:         processorPool,
 		multee:                multee,
 		spadeUploaderPool:     spadeUploaderPool,
 		blueprintUploaderPool: blueprintUploaderPool,
+		deadLetterUploader:    deadLetterUploaderPool,
 		rotation:              time.Tick(rotationCheckFrequency),
 		sigc:                  sigc,
 		closers:               closers,
@@ -256,6 +360,31 @@ func newProcessor(deps *spadeProcessorDeps) (*spadeProcessor, error) {
 
 func startProcessorPool(deps *spadeProcessorDeps, multee *writer.Multee,
 	spadeReporter reporter.Reporter, reporterStats reporter.StatsLogger) (processor.Pool, []closer, error) {
+	var parserConfig parser.Config
+	if deps.cfg.Parser != nil {
+		parserConfig = *deps.cfg.Parser
+	}
+	logParser, err := parser.New(parserConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating parser: %v", err)
+	}
+	if deps.cfg.ClientIP != nil {
+		logParser, err = parser.NewClientIPParser(logParser, *deps.cfg.ClientIP, reporterStats)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating client IP resolver: %v", err)
+		}
+	}
+
+	var uaParser *useragent.Parser
+	if deps.cfg.UserAgent != nil {
+		uaParser, err = useragent.New(*deps.cfg.UserAgent)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating user agent parser: %v", err)
+		}
+		useragent.SetDefault(uaParser)
+		logger.Go(uaParser.Crank)
+	}
+
 	schemaFetcher := fetcher.New(deps.cfg.ConfigBucket, deps.cfg.SchemasKey, deps.s3)
 	kinesisConfigFetcher := fetcher.New(deps.cfg.ConfigBucket, deps.cfg.KinesisConfigKey, deps.s3)
 	eventMetadataFetcher := fetcher.New(deps.cfg.ConfigBucket, deps.cfg.MetadataConfigKey, deps.s3)
@@ -298,32 +427,37 @@ func startProcessorPool(deps *spadeProcessorDeps, multee *writer.Multee,
 	}
 	logger.Go(schemaLoader.Crank)
 
-	kinesisConfigLoader, err := kinesisconfigs.NewDynamicLoader(
-		kinesisConfigFetcher,
-		deps.cfg.KinesisConfigReloadFrequency.Duration,
-		deps.cfg.KinesisConfigRetryDelay.Duration,
-		reporterStats,
-		multee,
-		func(cfg scoop_protocol.KinesisWriterConfig) (writer.SpadeWriter, error) {
-			return writer.NewKinesisWriter(
-				deps.kinesisFactory,
-				deps.firehoseFactory,
-				reporterStats.GetStatter(),
-				&writer.KinesisConfig{
-					StreamConfig:  cfg,
-					CommonFilters: deps.cfg.KinesisFilterFuncs,
-					DefaultFilter: deps.cfg.KinesisDefaultFilterFunc,
-				},
-				deps.cfg.KinesisWriterErrorsBeforeThrottling,
-				deps.cfg.KinesisWriterErrorThrottlePeriodSeconds)
-		},
-		deps.cfg.KinesisOutputs,
-		deps.cfg.KinesisFilterFuncs,
-	)
-	if err != nil {
-		return nil, nil, fmt.Errorf("creating dynamic kinesis config loader: %v", err)
+	// Redrives only reload Redshift, so they don't load the Kinesis outputs.
+	closers := []closer{schemaLoader}
+	if !deps.redrive {
+		kinesisConfigLoader, err := kinesisconfigs.NewDynamicLoader(
+			kinesisConfigFetcher,
+			deps.cfg.KinesisConfigReloadFrequency.Duration,
+			deps.cfg.KinesisConfigRetryDelay.Duration,
+			reporterStats,
+			multee,
+			func(cfg scoop_protocol.KinesisWriterConfig) (writer.SpadeWriter, error) {
+				return writer.NewKinesisWriter(
+					deps.kinesisFactory,
+					deps.firehoseFactory,
+					reporterStats.GetStatter(),
+					&writer.KinesisConfig{
+						StreamConfig:  cfg,
+						CommonFilters: deps.cfg.KinesisFilterFuncs,
+						DefaultFilter: deps.cfg.KinesisDefaultFilterFunc,
+					},
+					deps.cfg.KinesisWriterErrorsBeforeThrottling,
+					deps.cfg.KinesisWriterErrorThrottlePeriodSeconds)
+			},
+			deps.cfg.KinesisOutputs,
+			deps.cfg.KinesisFilterFuncs,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating dynamic kinesis config loader: %v", err)
+		}
+		logger.Go(kinesisConfigLoader.Crank)
+		closers = append(closers, kinesisConfigLoader)
 	}
-	logger.Go(kinesisConfigLoader.Crank)
 
 	eventMetadataLoader, err := eventMetadataConfig.NewDynamicLoader(
 		eventMetadataFetcher, deps.cfg.EventMetadataReloadFrequency.Duration,
@@ -333,10 +467,18 @@ func startProcessorPool(deps *spadeProcessorDeps, multee *writer.Multee,
 	}
 	logger.Go(eventMetadataLoader.Crank)
 
-	processorPool := processor.BuildProcessorPool(schemaLoader, eventMetadataLoader, spadeReporter, multee, reporterStats)
+	var transformerConfig transformer.Config
+	if deps.cfg.Transformer != nil {
+		transformerConfig = *deps.cfg.Transformer
+	}
+	processorPool := processor.BuildProcessorPool(logParser, schemaLoader, eventMetadataLoader, transformerConfig,
+		spadeReporter, multee, reporterStats)
 	processorPool.StartListeners()
-	return processorPool, []closer{
-		schemaLoader, kinesisConfigLoader, eventMetadataLoader, remoteCache}, nil
+	closers = append(closers, eventMetadataLoader, remoteCache)
+	if uaParser != nil {
+		closers = append(closers, uaParser)
+	}
+	return processorPool, closers, nil
 }
 
 func initializeDirectories(events, nontracked string, uploaderPool *aws_uploader.UploaderPool) error {
@@ -363,6 +505,21 @@ func initializeDirectories(events, nontracked string, uploaderPool *aws_uploader
 	return nil
 }
 
+// initializeDeadLetterDirectory creates the dead letter directory and uploads the files left
+// in it when the processor last exited.
+func initializeDeadLetterDirectory(dir string, uploaderPool *aws_uploader.UploaderPool) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating dead letter dir: %v", err)
+	}
+	if err := uploader.SalvageCorruptedEvents(dir); err != nil {
+		return fmt.Errorf("salvaging corrupted dead letters: %v", err)
+	}
+	if err := uploader.ClearEventsFolder(uploaderPool, dir); err != nil {
+		return fmt.Errorf("clearing dead letter folder: %v", err)
+	}
+	return nil
+}
+
 func createStatsdStatter(hostport, prefix string) (statsd.Statter, error) {
 	// - If the env is not set up we wil use a noop connection
 	if hostport == "" {
@@ -416,7 +573,7 @@ func (s *spadeProcessor) run() {
 				logger.WithError(err).Error("Consumer failed")
 				return
 			} else {
-				s.deglobberPool.Submit(record.Data)
+				s.deglobberPool.Submit(record.Data, record.Token)
 				numGlobs++
 			}
 		}
@@ -435,16 +592,26 @@ func (s *spadeProcessor) shutdown() {
 	}
 	signal.Stop(s.sigc)
 
-	s.resultPipe.Close()
+	// Flush everything that has been read before closing the pipe, so the pipe can
+	// checkpoint all of it.
 	s.deglobberPool.Close()
+	if s.dedupStore != nil {
+		if err := s.dedupStore.Close(); err != nil {
+			logger.WithError(err).Error("Failed to close dedup store")
+		}
+	}
 	s.processorPool.Close()
 	if err := s.multee.Close(); err != nil {
 		logger.WithError(err).Error("multee.Close() failed")
 	}
+	s.resultPipe.Close()
 	s.geoIPUpdater.Close()
 
 	s.spadeUploaderPool.Close()
 	s.blueprintUploaderPool.Close()
+	if s.deadLetterUploader != nil {
+		s.deadLetterUploader.Close()
+	}
 	wg.Wait()
 	logger.WithFields(map[string]interface{}{
 		"stats": s.spadeReporter.Report(),
@@ -453,7 +620,41 @@ func (s *spadeProcessor) shutdown() {
 
 func main() {
 	flag.Parse()
-	cfg, err := config.LoadConfig(*_configFilename, *_replay)
+	replay := *_replay
+	runTag := *_runTag
+	var replayOpts *replayOptions
+	var redriveOpts *redriveOptions
+	if flag.Arg(0) == "replay" {
+		var err error
+		replayOpts, err = parseReplayArgs(flag.Args()[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "replay: %v\n", err)
+			os.Exit(2)
+		}
+		replay = true
+		if runTag == "" {
+			runTag = defaultRunTag(time.Now())
+			fmt.Fprintf(os.Stderr, "no -run_tag was supplied, using generated run tag %s\n", runTag)
+		}
+	} else if flag.Arg(0) == "redrive" {
+		var err error
+		redriveOpts, err = parseRedriveArgs(flag.Args()[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "redrive: %v\n", err)
+			os.Exit(2)
+		}
+		// Redrives are uploaded under a run tag without notifications, as replays are.
+		replay = true
+		if runTag == "" {
+			runTag = defaultRunTag(time.Now())
+			fmt.Fprintf(os.Stderr, "no -run_tag was supplied, using generated run tag %s\n", runTag)
+		}
+	} else if flag.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", flag.Arg(0))
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadConfig(*_configFilename, replay)
 	if err != nil {
 		logger.WithField("configFilename", *_configFilename).WithError(
 			err).Error("Failed to load config")
@@ -465,13 +666,17 @@ func main() {
 	logger.CaptureDefault()
 	defer logger.LogPanic()
 
-	// Start listener for pprof.
+	// Start listener for pprof and, if configured, the report of column failures.
+	if cfg.ColumnFailures != nil {
+		transformer.Failures.Configure(*cfg.ColumnFailures)
+		http.Handle("/column_failures", transformer.Failures)
+	}
 	logger.Go(func() {
 		logger.WithError(http.ListenAndServe(":7766", http.DefaultServeMux)).
 			Error("Serving pprof failed")
 	})
 
-	deps, err := buildDeps(cfg, *_runTag, *_replay)
+	deps, err := buildDeps(cfg, runTag, replay, replayOpts, redriveOpts)
 	if err != nil {
 		logger.WithError(err).Error("Failed to build deps")
 		logger.Wait()
@@ -26,6 +26,7 @@ import (
 	"github.com/twitchscience/spade/consumer"
 	"github.com/twitchscience/spade/geoip"
 	"github.com/twitchscience/spade/lookup"
+	"github.com/twitchscience/spade/reporter"
 	"github.com/twitchscience/spade/writer"
 	"github.com/vrischmann/jsonutil"
 )
@@ -297,6 +298,7 @@ func getTestConfig(spadeDir string) *config.Config {
 		NonTrackedErrorTopicARN: "NonTrackedErrorTopicARN",
 		AceBucketName:           "AceBucketName",
 		NonTrackedBucketName:    "NonTrackedBucketName",
+		DeadLetterBucketName:    "DeadLetterBucketName",
 		MaxLogBytes:             100000000,
 		MaxLogAgeSecs:           1,
 		NontrackedMaxLogAgeSecs: 1,
@@ -415,12 +417,22 @@ func waitForProcessor(t *testing.T, sentRecords chan sentRecord, testP testParam
 					assert.Equal(t, testP.tsv, parts)
 				}
 			case "NonTrackedBucketName":
-				var expectedEvent, receivedEvent spade.Event
+				var expectedEvent map[string]interface{}
 				err := json.Unmarshal([]byte(testP.event.Data), &expectedEvent)
 				require.NoError(t, err)
-				err = json.Unmarshal([]byte(record.data), &receivedEvent)
+				expectedEvent["uuid"] = testP.event.Uuid
+				expectedEvent["time"] = testP.event.ReceivedAt.Unix()
+				expected, err := json.Marshal(expectedEvent)
 				require.NoError(t, err)
-				assert.Equal(t, expectedEvent, receivedEvent)
+				assert.JSONEq(t, string(expected), record.data)
+			case "DeadLetterBucketName":
+				var deadLetter writer.DeadLetter
+				require.NoError(t, json.Unmarshal([]byte(record.data), &deadLetter))
+				assert.Equal(t, reporter.UnableToParseData.String(), deadLetter.Failure)
+				assert.NotEmpty(t, deadLetter.Error)
+				var rawEvent spade.Event
+				require.NoError(t, spade.Unmarshal(deadLetter.Raw, &rawEvent))
+				assert.Equal(t, testP.event.Uuid, rawEvent.Uuid)
 			case "AceTopicARN":
 				var req scoop_protocol.RowCopyRequest
 				err := json.Unmarshal([]byte(record.data), &req)
@@ -513,6 +525,22 @@ var testParams = []testParam{
 		eventName:  "unknown",
 		resetSpade: true,
 	},
+	{
+		name: "malformed event",
+		event: spade.Event{
+			ReceivedAt: time.Date(2017, 8, 1, 18, 7, 35, 51326122, time.UTC),
+			ClientIp:   net.IPv4(192, 168, 1, 100),
+			Uuid:       "1",
+			Data:       `{"event": "video-play", "properties": {`,
+			Version:    2,
+			EdgeType:   "internal",
+		},
+		tsv: nil,
+		expectedCount: map[string]int{
+			"DeadLetterBucketName": 1,
+		},
+		resetSpade: true,
+	},
 }
 
 func TestE2E(t *testing.T) {
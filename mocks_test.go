@@ -208,6 +208,14 @@ func (l *loginFetcher) FetchInt64(args map[string]string) (int64, error) {
 	return fetcherUserIDs[args["login"]], nil
 }
 
+func (l *loginFetcher) FetchString(args map[string]string) (string, error) {
+	return "", lookup.ErrExtractingValue
+}
+
+func (l *loginFetcher) FetchFields(args map[string]string) (map[string]string, error) {
+	return nil, lookup.ErrExtractingValue
+}
+
 func valueFetcherFactory(l lookup.JSONValueFetcherConfig, s reporter.StatsLogger) (lookup.ValueFetcher, error) {
 	return &loginFetcher{}, nil
 }
@@ -0,0 +1,132 @@
+package parser
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/twitchscience/spade/reporter"
+)
+
+// Policies for choosing the client IP from X-Forwarded-For.
+const (
+	// RightmostUntrusted takes the rightmost address which isn't a trusted proxy. Only the
+	// proxies add to the right of the header, so this can't be spoofed by clients.
+	RightmostUntrusted = "rightmost-untrusted"
+	// Leftmost takes the address the client says it has. Clients can spoof it, so it is only
+	// suitable when every proxy in front of the edge replaces the header.
+	Leftmost = "leftmost"
+)
+
+// ClientIPConfig configures how client IPs are resolved from X-Forwarded-For.
+type ClientIPConfig struct {
+	// TrustedProxies are the CIDRs of the CDNs and load balancers in front of the edge
+	TrustedProxies []string
+	// Policy is RightmostUntrusted (the default) or Leftmost
+	Policy string
+}
+
+// clientIPParser replaces the ClientIP of the events parsed by another Parser with the one
+// given in X-Forwarded-For, when the edge request came from a trusted proxy.
+type clientIPParser struct {
+	Parser
+	trusted  []*net.IPNet
+	leftmost bool
+	stats    reporter.StatsLogger
+}
+
+// NewClientIPParser wraps p so that the events it parses have their ClientIP resolved from
+// X-Forwarded-For, keeping the edge's in SocketIP when it is replaced.
+func NewClientIPParser(p Parser, config ClientIPConfig, stats reporter.StatsLogger) (Parser, error) {
+	c := &clientIPParser{Parser: p, stats: stats}
+	switch config.Policy {
+	case "", RightmostUntrusted:
+	case Leftmost:
+		c.leftmost = true
+	default:
+		return nil, fmt.Errorf("unknown client IP policy %q, expected %s or %s",
+			config.Policy, RightmostUntrusted, Leftmost)
+	}
+	for _, cidr := range config.TrustedProxies {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("parsing trusted proxy: %v", err)
+		}
+		c.trusted = append(c.trusted, network)
+	}
+	return c, nil
+}
+
+// Parse parses the Parseable with the wrapped Parser, then resolves the events' client IPs.
+func (c *clientIPParser) Parse(raw Parseable) ([]MixpanelEvent, error) {
+	events, err := c.Parser.Parse(raw)
+	for i := range events {
+		c.resolve(&events[i])
+	}
+	return events, err
+}
+
+func (c *clientIPParser) resolve(e *MixpanelEvent) {
+	if e.XForwardedFor == "" || e.Failure != reporter.None {
+		return
+	}
+	if !c.isTrusted(net.ParseIP(e.ClientIP)) {
+		c.stats.IncrBy("clientip.xff.untrusted", 1)
+		return
+	}
+	ip, err := c.fromXFF(e.XForwardedFor)
+	if err != nil {
+		c.stats.IncrBy("clientip.xff.invalid", 1)
+		return
+	}
+	if ip.String() != e.ClientIP {
+		e.SocketIP = e.ClientIP
+		e.ClientIP = ip.String()
+		c.stats.IncrBy("clientip.xff.overridden", 1)
+	}
+}
+
+// fromXFF returns the client IP in the X-Forwarded-For header, per the policy.
+func (c *clientIPParser) fromXFF(xff string) (net.IP, error) {
+	hops := strings.Split(xff, ",")
+	if c.leftmost {
+		return parseHop(hops[0])
+	}
+	var ip net.IP
+	for i := len(hops) - 1; i >= 0; i-- {
+		var err error
+		if ip, err = parseHop(hops[i]); err != nil {
+			return nil, err
+		}
+		if !c.isTrusted(ip) {
+			return ip, nil
+		}
+	}
+	// Every hop is a trusted proxy, so the leftmost is the closest to the client we have.
+	return ip, nil
+}
+
+func parseHop(hop string) (net.IP, error) {
+	hop = strings.TrimSpace(hop)
+	// Some proxies include the port.
+	if host, _, err := net.SplitHostPort(hop); err == nil {
+		hop = host
+	}
+	ip := net.ParseIP(hop)
+	if ip == nil {
+		return nil, fmt.Errorf("bad address %q in X-Forwarded-For", hop)
+	}
+	return ip, nil
+}
+
+func (c *clientIPParser) isTrusted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range c.trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cactus/go-statsd-client/statsd"
+	"github.com/twitchscience/spade/reporter"
+)
+
+type countingStats struct {
+	counts map[string]int
+}
+
+func (s *countingStats) Timing(stat string, t time.Duration) {
+}
+
+func (s *countingStats) IncrBy(stat string, value int) {
+	s.counts[stat] += value
+}
+
+func (s *countingStats) GetStatter() statsd.Statter {
+	return nil
+}
+
+// fixedParser parses every Parseable into the same event.
+type fixedParser MixpanelEvent
+
+func (f *fixedParser) Parse(Parseable) ([]MixpanelEvent, error) {
+	return []MixpanelEvent{MixpanelEvent(*f)}, nil
+}
+
+func TestClientIPParser(t *testing.T) {
+	trusted := []string{"10.0.0.0/8", "2001:db8::/32"}
+	for _, tt := range []struct {
+		name       string
+		policy     string
+		socketIP   string
+		xff        string
+		expectedIP string
+		stat       string
+	}{
+		{"no header", "", "10.0.0.1", "", "10.0.0.1", ""},
+		{"untrusted socket", "", "1.2.3.4", "5.6.7.8", "1.2.3.4", "clientip.xff.untrusted"},
+		{"rightmost untrusted", "", "10.0.0.1", "9.9.9.9, 5.6.7.8, 10.1.1.1", "5.6.7.8", "clientip.xff.overridden"},
+		{"all trusted", RightmostUntrusted, "10.0.0.1", "10.2.2.2, 10.1.1.1", "10.2.2.2", "clientip.xff.overridden"},
+		{"leftmost", Leftmost, "10.0.0.1", "9.9.9.9, 5.6.7.8, 10.1.1.1", "9.9.9.9", "clientip.xff.overridden"},
+		{"ipv6 with port", "", "2001:db8::1", "[2001:4860::8888]:443", "2001:4860::8888", "clientip.xff.overridden"},
+		{"invalid hop", "", "10.0.0.1", "5.6.7.8, unknown", "10.0.0.1", "clientip.xff.invalid"},
+	} {
+		stats := &countingStats{counts: map[string]int{}}
+		p, err := NewClientIPParser(&fixedParser{ClientIP: tt.socketIP, XForwardedFor: tt.xff},
+			ClientIPConfig{TrustedProxies: trusted, Policy: tt.policy}, stats)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		events, _ := p.Parse(nil)
+		if events[0].ClientIP != tt.expectedIP {
+			t.Errorf("%s: expected client IP %s, got %s", tt.name, tt.expectedIP, events[0].ClientIP)
+		}
+		expectedSocketIP := ""
+		if tt.expectedIP != tt.socketIP {
+			expectedSocketIP = tt.socketIP
+		}
+		if events[0].SocketIP != expectedSocketIP {
+			t.Errorf("%s: expected socket IP %q, got %q", tt.name, expectedSocketIP, events[0].SocketIP)
+		}
+		if tt.stat != "" && stats.counts[tt.stat] != 1 {
+			t.Errorf("%s: expected %s to be counted, got %v", tt.name, tt.stat, stats.counts)
+		}
+	}
+}
+
+func TestClientIPParserSkipsFailures(t *testing.T) {
+	p, err := NewClientIPParser(&fixedParser{
+		ClientIP:      "10.0.0.1",
+		XForwardedFor: "5.6.7.8",
+		Failure:       reporter.UnableToParseData,
+	}, ClientIPConfig{TrustedProxies: []string{"10.0.0.0/8"}}, &countingStats{counts: map[string]int{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if events, _ := p.Parse(nil); events[0].ClientIP != "10.0.0.1" {
+		t.Errorf("expected a failed event's client IP to be left alone, got %s", events[0].ClientIP)
+	}
+}
+
+func TestClientIPParserConfig(t *testing.T) {
+	stats := &countingStats{}
+	if _, err := NewClientIPParser(&fixedParser{}, ClientIPConfig{Policy: "middle"}, stats); err == nil {
+		t.Error("expected an error for an unknown policy")
+	}
+	if _, err := NewClientIPParser(&fixedParser{}, ClientIPConfig{TrustedProxies: []string{"10.0.0.1"}}, stats); err == nil {
+		t.Error("expected an error for a bad CIDR")
+	}
+}
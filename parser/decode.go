@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/protoevent"
 )
 
 var multiEventEscape = []byte{'[', '{'}
@@ -45,6 +46,27 @@ func DecodeBase64(matches ParseResult, escaper URLEscaper) ([]MixpanelEvent, err
 	return events, nil
 }
 
+// eventData is the data of an edge event, without a UUID or time.
+type eventData []byte
+
+func (d eventData) Data() []byte {
+	return d
+}
+
+func (d eventData) UUID() string {
+	return ""
+}
+
+func (d eventData) Time() string {
+	return ""
+}
+
+// DecodeData decodes the data of an edge event (spade.Event.Data) into MixpanelEvents with only
+// their Event and Properties set.
+func DecodeData(data string) ([]MixpanelEvent, error) {
+	return DecodeBase64(eventData(data), &ByteQueryUnescaper{})
+}
+
 // ByteQueryUnescaper is a URL query decoder that operates with []byte instead of string.
 type ByteQueryUnescaper struct{}
 
@@ -121,3 +143,26 @@ func unhex(c byte) byte {
 	}
 	return 0
 }
+
+// EventNames returns the names of the events in the data of an edge event.
+func EventNames(e *spade.Event) ([]string, error) {
+	var names []string
+	if e.Version == protoevent.Version {
+		bags, err := protoevent.UnmarshalBags([]byte(e.Data))
+		if err != nil {
+			return nil, err
+		}
+		for _, bag := range bags {
+			names = append(names, bag.Event)
+		}
+		return names, nil
+	}
+	decoded, err := DecodeData(e.Data)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range decoded {
+		names = append(names, d.Event)
+	}
+	return names, nil
+}
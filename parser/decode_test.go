@@ -1,9 +1,15 @@
 package parser
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"io/ioutil"
 	"net/url"
+	"reflect"
 	"testing"
+
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/protoevent"
 )
 
 func loadFile(file string) []byte {
@@ -100,3 +106,28 @@ func BenchmarkByteQueryUnescape(b *testing.B) {
 		_, _ = escaper.QueryUnescape(sample)
 	}
 }
+
+func TestEventNames(t *testing.T) {
+	data, err := protoevent.MarshalBags([]protoevent.Bag{
+		{Event: "login", Properties: json.RawMessage(`{"a": 1}`)},
+		{Event: "logout", Properties: json.RawMessage(`{}`)},
+	})
+	if err != nil {
+		t.Fatalf("got error: %v", err)
+	}
+	plaintext := base64.StdEncoding.EncodeToString([]byte(`[{"event": "play", "properties": {}}]`))
+	for _, c := range []struct {
+		event    *spade.Event
+		expected []string
+	}{
+		{&spade.Event{Data: string(data), Version: protoevent.Version}, []string{"login", "logout"}},
+		{&spade.Event{Data: plaintext}, []string{"play"}},
+	} {
+		names, err := EventNames(c.event)
+		if err != nil {
+			t.Errorf("got error: %v", err)
+		} else if !reflect.DeepEqual(names, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, names)
+		}
+	}
+}
@@ -24,6 +24,10 @@ func (j *jsonLogEvent) Time() string {
 	return fmt.Sprintf("%d", j.event.ReceivedAt.Unix())
 }
 
+func init() {
+	parser.Register(parser.DefaultParser, &LogParser{})
+}
+
 // LogParser parses JSON log messages from the edge.
 type LogParser struct{}
 
@@ -32,15 +36,17 @@ func (j *LogParser) Parse(raw parser.Parseable) ([]parser.MixpanelEvent, error)
 	var rawEvent spade.Event
 	err := spade.Unmarshal(raw.Data(), &rawEvent)
 	if err != nil {
-		return []parser.MixpanelEvent{*parser.MakeErrorEvent(raw, "", "", rawEvent.EdgeType)}, err
+		errorEvent := parser.MakeErrorEvent(raw, "", "", rawEvent.EdgeType)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
 	}
 
 	parsedEvent := &jsonLogEvent{event: rawEvent}
 	events, err := parser.DecodeBase64(parsedEvent, &parser.ByteQueryUnescaper{})
 	if err != nil {
-		return []parser.MixpanelEvent{
-			*parser.MakeErrorEvent(raw, rawEvent.Uuid, parsedEvent.Time(), rawEvent.EdgeType),
-		}, err
+		errorEvent := parser.MakeErrorEvent(raw, rawEvent.Uuid, parsedEvent.Time(), rawEvent.EdgeType)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
 	}
 
 	m := make([]parser.MixpanelEvent, len(events))
@@ -48,6 +54,7 @@ func (j *LogParser) Parse(raw parser.Parseable) ([]parser.MixpanelEvent, error)
 		m[i] = e
 		m[i].EventTime = json.Number(parsedEvent.Time())
 		m[i].ClientIP = rawEvent.ClientIp.String()
+		m[i].XForwardedFor = rawEvent.XForwardedFor
 		m[i].Pstart = raw.StartTime()
 		m[i].UserAgent = rawEvent.UserAgent
 		m[i].EdgeType = rawEvent.EdgeType
@@ -57,5 +64,6 @@ func (j *LogParser) Parse(raw parser.Parseable) ([]parser.MixpanelEvent, error)
 			m[i].UUID = parsedEvent.UUID()
 		}
 	}
+	parser.SetEnvelopes(m, raw.Data())
 	return m, nil
 }
@@ -124,6 +124,40 @@ func TestSuccessfulJSONLogParser(t *testing.T) {
 
 }
 
+// Test that an event's original is the edge event it came from, with one of its own if the
+// edge event held others.
+func TestEnvelopes(t *testing.T) {
+	jsonParser := &LogParser{}
+	b, err := spade.Marshal(spade.NewEvent(receivedAt, net.IPv4(10, 0, 0, 1), "10.0.0.2", "123abc",
+		string(loadFile("../test_resources/b64payload.txt", t)), "TestBrowser", spade.INTERNAL_EDGE))
+	assert.Nil(t, err)
+	mes, err := jsonParser.Parse(&line{b})
+	assert.Nil(t, err)
+	assert.Equal(t, b, mes[0].Original())
+
+	b, err = spade.Marshal(spade.NewEvent(receivedAt, net.IPv4(10, 0, 0, 1), "10.0.0.2", "123abc",
+		string(loadFile("../test_resources/multievent_b64.txt", t)), "TestBrowser", spade.INTERNAL_EDGE))
+	assert.Nil(t, err)
+	mes, err = jsonParser.Parse(&line{b})
+	assert.Nil(t, err)
+	for i, me := range mes {
+		var envelope spade.Event
+		assert.Nil(t, spade.Unmarshal(me.Original(), &envelope))
+		assert.Equal(t, fmt.Sprintf("123abc-%d", i), envelope.Uuid)
+		assert.Equal(t, "10.0.0.1", envelope.ClientIp.String())
+		assert.Equal(t, "10.0.0.2", envelope.XForwardedFor)
+		assert.Equal(t, "TestBrowser", envelope.UserAgent)
+		assert.Equal(t, receivedAt.Unix(), envelope.ReceivedAt.Unix())
+
+		redriven, err := jsonParser.Parse(&line{me.Original()})
+		assert.Nil(t, err)
+		if assert.Len(t, redriven, 1) {
+			assert.Equal(t, me.Event, redriven[0].Event)
+			assert.JSONEq(t, string(me.Properties), string(redriven[0].Properties))
+		}
+	}
+}
+
 func TestFailurePaths(t *testing.T) {
 	tests := []struct {
 		errorName       string
@@ -168,3 +202,9 @@ func TestFailurePaths(t *testing.T) {
 		}
 	}
 }
+
+func TestRegisteredAsDefault(t *testing.T) {
+	p, err := parser.New(parser.Config{})
+	assert.Nil(t, err)
+	assert.IsType(t, &LogParser{}, p)
+}
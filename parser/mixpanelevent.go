@@ -1,25 +1,34 @@
 package parser
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"net"
 	"strconv"
 	"time"
 
 	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/ack"
 	"github.com/twitchscience/spade/reporter"
 )
 
 // MixpanelEvent is a decoded Mixpanel Event with Properties/source information.
 type MixpanelEvent struct {
-	Pstart     time.Time         // the time that we started processing
-	EventTime  json.Number       // the time that the server recieved the event
-	UUID       string            // UUID of the event as assigned by the edge
-	ClientIP   string            // the ipv4 of the client
-	Event      string            // the type of the event
-	EdgeType   string            // the type of the edge (internal/external)
-	UserAgent  string            // the user agent from an edge request
-	Properties json.RawMessage   // the raw bytes of the json properties sub object
-	Failure    reporter.FailMode // a flag for failure modes
+	Pstart        time.Time         // the time that we started processing
+	EventTime     json.Number       // the time that the server recieved the event
+	UUID          string            // UUID of the event as assigned by the edge
+	ClientIP      string            // the ipv4 of the client
+	SocketIP      string            // the ip the edge request came from, if ClientIP was resolved
+	XForwardedFor string            // the X-Forwarded-For header of the edge request
+	Event         string            // the type of the event
+	EdgeType      string            // the type of the edge (internal/external)
+	UserAgent     string            // the user agent from an edge request
+	Properties    json.RawMessage   // the raw bytes of the json properties sub object
+	Failure       reporter.FailMode // a flag for failure modes
+	Error         string            // why the event failed, if it did
+	Raw           []byte            `json:"-"` // the raw bytes the event failed to parse from
+	Envelope      []byte            `json:"-"` // the edge event the event was decoded from
+	Token         *ack.Token        // a hold on the token of the record the event came from
 }
 
 // MakePanickedEvent returns an event inidicating a panic happened while parsing the event.
@@ -33,10 +42,12 @@ func MakePanickedEvent(line Parseable) *MixpanelEvent {
 		EdgeType:   spade.INTERNAL_EDGE,
 		Properties: json.RawMessage(line.Data()),
 		Failure:    reporter.PanickedInProcessing,
+		Raw:        line.Data(),
 	}
 }
 
 // MakeErrorEvent returns an event indicating an error happened while parsing the event.
+// The caller may set its Error.
 func MakeErrorEvent(line Parseable, uuid string, when string, edgeType string) *MixpanelEvent {
 	if uuid == "" || len(uuid) > 64 {
 		uuid = "error"
@@ -56,5 +67,70 @@ func MakeErrorEvent(line Parseable, uuid string, when string, edgeType string) *
 		EdgeType:   edgeType,
 		Properties: json.RawMessage{},
 		Failure:    reporter.UnableToParseData,
+		Raw:        line.Data(),
 	}
 }
+
+// SetEnvelopes sets the Envelope of each event decoded from the edge event raw. An event alone
+// in raw keeps raw itself; otherwise, or if raw is nil, each is given an edge event of its
+// own as JSON, so that reprocessing one event's original doesn't reprocess the others.
+func SetEnvelopes(events []MixpanelEvent, raw []byte) {
+	if len(events) == 1 && raw != nil {
+		events[0].Envelope = raw
+		return
+	}
+	for i := range events {
+		events[i].Envelope = events[i].envelope()
+	}
+}
+
+// envelope returns an edge event as JSON holding only the event, with the fields the edge
+// set on the event it came from.
+func (e *MixpanelEvent) envelope() []byte {
+	receivedAt, err := e.EventTime.Int64()
+	if err != nil {
+		return nil
+	}
+	b, err := spade.Marshal(&spade.Event{
+		ReceivedAt:    time.Unix(receivedAt, 0).UTC(),
+		ClientIp:      net.ParseIP(e.ClientIP),
+		XForwardedFor: e.XForwardedFor,
+		Uuid:          e.UUID,
+		Data:          base64.StdEncoding.EncodeToString(e.decoded()),
+		UserAgent:     e.UserAgent,
+		Version:       spade.PROTOCOL_VERSION,
+		EdgeType:      e.EdgeType,
+	})
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// Original returns what the event came from: its raw bytes if it failed to parse, otherwise
+// the edge event it was decoded from, or failing that its event type and properties as JSON.
+func (e *MixpanelEvent) Original() []byte {
+	if e.Raw != nil {
+		return e.Raw
+	}
+	if e.Envelope != nil {
+		return e.Envelope
+	}
+	return e.decoded()
+}
+
+// decoded returns the event's type and properties as JSON.
+func (e *MixpanelEvent) decoded() []byte {
+	properties := e.Properties
+	if len(properties) == 0 {
+		properties = nil
+	}
+	b, err := json.Marshal(struct {
+		Event      string          `json:"event"`
+		Properties json.RawMessage `json:"properties"`
+	}{e.Event, properties})
+	if err != nil {
+		return nil
+	}
+	return b
+}
@@ -219,3 +219,20 @@ func makeBadEncodedEvent() *MixpanelEvent {
 		Failure:    reporter.FailedTransport,
 	}
 }
+
+func TestOriginal(t *testing.T) {
+	e := &MixpanelEvent{Event: "login", Properties: json.RawMessage(`{"a":1}`)}
+	if got := string(e.Original()); got != `{"event":"login","properties":{"a":1}}` {
+		t.Errorf("unexpected original of parsed event: %s", got)
+	}
+
+	e = &MixpanelEvent{Event: "Unknown", Raw: []byte("raw")}
+	if got := string(e.Original()); got != "raw" {
+		t.Errorf("unexpected original of unparsed event: %s", got)
+	}
+
+	e = &MixpanelEvent{Event: "login", Envelope: []byte("envelope")}
+	if got := string(e.Original()); got != "envelope" {
+		t.Errorf("unexpected original of decoded event: %s", got)
+	}
+}
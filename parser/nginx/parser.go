@@ -0,0 +1,124 @@
+// Package nginx parses the edge's legacy log lines, written by nginx before events were
+// wrapped in JSON envelopes. A line looks like
+//
+//	222.22.222.222 [1395707641.000] data=eyJwcm9wZXJ0a...GNoZWQifQ== 09fff3e1-49eff880-535707f3-20f9e114d784a3fa
+//
+// where data is the URL query escaped, base64 encoded event JSON from the request.
+package nginx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/parser"
+)
+
+// Name is the name the LogParser is registered under.
+const Name = "nginx"
+
+var dataPrefix = []byte("data=")
+
+func init() {
+	parser.Register(Name, &LogParser{})
+}
+
+type nginxLogEvent struct {
+	ip   net.IP
+	time string
+	data []byte
+	uuid string
+}
+
+func (n *nginxLogEvent) Data() []byte {
+	return n.data
+}
+
+func (n *nginxLogEvent) UUID() string {
+	return n.uuid
+}
+
+func (n *nginxLogEvent) Time() string {
+	return n.time
+}
+
+// LogParser parses legacy nginx log lines from the edge.
+type LogParser struct{}
+
+// Parse turns the legacy log line into mixpanel events.
+func (n *LogParser) Parse(raw parser.Parseable) ([]parser.MixpanelEvent, error) {
+	line, err := split(raw.Data())
+	if err != nil {
+		errorEvent := parser.MakeErrorEvent(raw, "", "", spade.EXTERNAL_EDGE)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
+	}
+
+	events, err := parser.DecodeBase64(line, &parser.ByteQueryUnescaper{})
+	if err != nil {
+		errorEvent := parser.MakeErrorEvent(raw, line.uuid, line.time, spade.EXTERNAL_EDGE)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
+	}
+
+	m := make([]parser.MixpanelEvent, len(events))
+	for i, e := range events {
+		m[i] = e
+		m[i].EventTime = json.Number(line.time)
+		m[i].ClientIP = line.ip.String()
+		m[i].Pstart = raw.StartTime()
+		m[i].EdgeType = spade.EXTERNAL_EDGE
+		if len(events) > 1 {
+			m[i].UUID = fmt.Sprintf("%s-%d", line.uuid, i)
+		} else {
+			m[i].UUID = line.uuid
+		}
+	}
+	// Legacy lines can't be re-driven, so each event is given an edge event of its own.
+	parser.SetEnvelopes(m, nil)
+	return m, nil
+}
+
+// split breaks a line into its IP, time, data and UUID. The time is truncated to seconds, and
+// the data is cut off at any further query parameters.
+func split(b []byte) (*nginxLogEvent, error) {
+	fields := bytes.Fields(b)
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("expected 4 fields, got %d", len(fields))
+	}
+
+	ip := net.ParseIP(string(fields[0]))
+	if ip == nil {
+		return nil, fmt.Errorf("bad IP %q", fields[0])
+	}
+
+	ts := fields[1]
+	if len(ts) < 2 || ts[0] != '[' || ts[len(ts)-1] != ']' {
+		return nil, fmt.Errorf("bad time %q", ts)
+	}
+	seconds, err := strconv.ParseFloat(string(ts[1:len(ts)-1]), 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad time %q: %v", ts, err)
+	}
+
+	data := fields[2]
+	if !bytes.HasPrefix(data, dataPrefix) {
+		return nil, fmt.Errorf("expected data= in %q", data)
+	}
+	data = data[len(dataPrefix):]
+	if i := bytes.IndexByte(data, '&'); i >= 0 {
+		data = data[:i]
+	}
+	// DecodeBase64 decodes in place, so copy the data to leave the line intact.
+	data = append([]byte(nil), data...)
+
+	return &nginxLogEvent{
+		ip:   ip,
+		time: strconv.FormatInt(int64(seconds), 10),
+		data: data,
+		uuid: string(fields[3]),
+	}, nil
+}
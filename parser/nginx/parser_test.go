@@ -0,0 +1,85 @@
+package nginx
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/reporter"
+)
+
+var receivedAt = time.Now()
+
+type line struct {
+	b []byte
+}
+
+func (l *line) Data() []byte {
+	return l.b
+}
+
+func (l *line) StartTime() time.Time {
+	return receivedAt
+}
+
+func loadFile(t *testing.T, path string) []byte {
+	b, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	return bytes.TrimSpace(b)
+}
+
+func TestParse(t *testing.T) {
+	mes, err := (&LogParser{}).Parse(&line{loadFile(t, "test_resources/logline.txt")})
+	require.Nil(t, err)
+	require.Len(t, mes, 1)
+	assert.Equal(t, "login", mes[0].Event)
+	assert.Equal(t, "09fff3e1-49eff880-535707f3-20f9e114d784a3fa", mes[0].UUID)
+	assert.Equal(t, json.Number("1395707641"), mes[0].EventTime)
+	assert.Equal(t, "222.22.222.222", mes[0].ClientIP)
+	assert.Equal(t, spade.EXTERNAL_EDGE, mes[0].EdgeType)
+	assert.Equal(t, receivedAt, mes[0].Pstart)
+	assert.JSONEq(t, `{"distinct_id":"abc","time":1395707600}`, string(mes[0].Properties))
+}
+
+func TestParseMultipleEvents(t *testing.T) {
+	mes, err := (&LogParser{}).Parse(&line{loadFile(t, "test_resources/multi_logline.txt")})
+	require.Nil(t, err)
+	require.Len(t, mes, 2)
+	assert.Equal(t, "logout", mes[1].Event)
+	assert.Equal(t, "09fff3e1-49eff880-535707f3-20f9e114d784a3fb-1", mes[1].UUID)
+	assert.Equal(t, json.Number("1395707641"), mes[1].EventTime)
+}
+
+func TestParseFailures(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		line         string
+		expectedUUID string
+	}{
+		{"too few fields", "10.0.0.1 [1395707641.000] data=e30=", "error"},
+		{"bad IP", "localhost [1395707641.000] data=e30= abc", "error"},
+		{"bad time", "10.0.0.1 1395707641.000 data=e30= abc", "error"},
+		{"missing data", "10.0.0.1 [1395707641.000] ip=1 abc", "error"},
+		{"bad base64", "10.0.0.1 [1395707641.000] data=!!! abc", "abc"},
+	} {
+		raw := []byte(tt.line)
+		mes, err := (&LogParser{}).Parse(&line{raw})
+		assert.NotNil(t, err, tt.name)
+		require.Len(t, mes, 1, tt.name)
+		assert.Equal(t, reporter.UnableToParseData, mes[0].Failure, tt.name)
+		assert.Equal(t, tt.expectedUUID, mes[0].UUID, tt.name)
+		assert.Equal(t, tt.line, string(mes[0].Raw), tt.name)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	p, err := parser.Lookup(Name)
+	require.Nil(t, err)
+	assert.IsType(t, &LogParser{}, p)
+}
@@ -1,6 +1,10 @@
 package parser
 
-import "time"
+import (
+	"time"
+
+	"github.com/twitchscience/spade/ack"
+)
 
 // Parseable is a byte stream to be parsed associated with a time.
 type Parseable interface {
@@ -8,6 +12,20 @@ type Parseable interface {
 	StartTime() time.Time
 }
 
+// Acknowledgeable is implemented by Parseables which hold a Token that must be released
+// once their events are written.
+type Acknowledgeable interface {
+	Token() *ack.Token
+}
+
+// TokenOf returns the Parseable's Token, or nil if it doesn't have one.
+func TokenOf(p Parseable) *ack.Token {
+	if a, ok := p.(Acknowledgeable); ok {
+		return a.Token()
+	}
+	return nil
+}
+
 // Parser is an interface for turning Parseables into one or more MixpanelEvents.
 type Parser interface {
 	Parse(Parseable) ([]MixpanelEvent, error)
@@ -0,0 +1,266 @@
+// Package partner parses events sent by partner SDKs in the Segment or Amplitude HTTP API
+// formats. The edge wraps the request body in its usual envelope, so the data of the
+// spade.Event is one of
+//
+//	{"type": "track", "event": "...", "properties": {...}, "timestamp": "...", "messageId": "...", "context": {"ip": "..."}}
+//	{"type": "identify", "traits": {...}, ...}
+//	{"batch": [<Segment messages>]}
+//	{"api_key": "...", "events": [{"event_type": "...", "event_properties": {...}, "time": <ms>, "insert_id": "...", "ip": "..."}]}
+//
+// as plain or base64 encoded JSON. The events are mapped onto MixpanelEvents so they are
+// transformed exactly like our own: the client timestamp becomes the "time" property (and so
+// client_time) and the user or anonymous ID becomes "distinct_id", unless the properties
+// already have them.
+package partner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/parser"
+)
+
+// Name is the name the LogParser is registered under.
+const Name = "partner"
+
+// maxUUIDLength is the longest message ID used as an event's UUID.
+const maxUUIDLength = 64
+
+func init() {
+	parser.Register(Name, &LogParser{})
+}
+
+// segmentMessage is a Segment track or identify call.
+type segmentMessage struct {
+	Type        string          `json:"type"`
+	Event       string          `json:"event"`
+	Properties  json.RawMessage `json:"properties"`
+	Traits      json.RawMessage `json:"traits"`
+	Timestamp   string          `json:"timestamp"`
+	MessageID   string          `json:"messageId"`
+	UserID      string          `json:"userId"`
+	AnonymousID string          `json:"anonymousId"`
+	Context     struct {
+		IP        string `json:"ip"`
+		UserAgent string `json:"userAgent"`
+	} `json:"context"`
+}
+
+// amplitudeEvent is an event from an Amplitude HTTP API upload.
+type amplitudeEvent struct {
+	EventType       string          `json:"event_type"`
+	EventProperties json.RawMessage `json:"event_properties"`
+	Time            int64           `json:"time"`
+	InsertID        string          `json:"insert_id"`
+	UserID          string          `json:"user_id"`
+	DeviceID        string          `json:"device_id"`
+	IP              string          `json:"ip"`
+}
+
+// payload holds any of the supported shapes; at most one of its parts is set.
+type payload struct {
+	segmentMessage
+	Batch  []segmentMessage `json:"batch"`
+	Events []amplitudeEvent `json:"events"`
+}
+
+// partnerEvent is a partner event in terms of a MixpanelEvent.
+type partnerEvent struct {
+	event      string
+	properties json.RawMessage
+	clientTime string
+	messageID  string
+	distinctID string
+	ip         string
+	userAgent  string
+}
+
+// LogParser parses partner events in JSON envelopes from the edge.
+type LogParser struct{}
+
+// Parse turns the raw edge event into mixpanel events.
+func (p *LogParser) Parse(raw parser.Parseable) ([]parser.MixpanelEvent, error) {
+	var rawEvent spade.Event
+	err := spade.Unmarshal(raw.Data(), &rawEvent)
+	if err != nil {
+		errorEvent := parser.MakeErrorEvent(raw, "", "", rawEvent.EdgeType)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
+	}
+	serverTime := fmt.Sprintf("%d", rawEvent.ReceivedAt.Unix())
+
+	events, err := decode(rawEvent.Data)
+	if err != nil {
+		errorEvent := parser.MakeErrorEvent(raw, rawEvent.Uuid, serverTime, rawEvent.EdgeType)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
+	}
+
+	m := make([]parser.MixpanelEvent, len(events))
+	for i, e := range events {
+		properties, err := withDefaults(e.properties, e.clientTime, e.distinctID)
+		if err != nil {
+			errorEvent := parser.MakeErrorEvent(raw, rawEvent.Uuid, serverTime, rawEvent.EdgeType)
+			errorEvent.Error = fmt.Sprintf("properties of %s: %v", e.event, err)
+			return []parser.MixpanelEvent{*errorEvent}, err
+		}
+		m[i] = parser.MixpanelEvent{
+			Pstart:     raw.StartTime(),
+			EventTime:  json.Number(serverTime),
+			ClientIP:   rawEvent.ClientIp.String(),
+			Event:      e.event,
+			EdgeType:   rawEvent.EdgeType,
+			UserAgent:  rawEvent.UserAgent,
+			Properties: properties,
+		}
+		// An IP given by the SDK takes precedence over where the request came from.
+		if e.ip != "" {
+			m[i].ClientIP = e.ip
+		} else {
+			m[i].XForwardedFor = rawEvent.XForwardedFor
+		}
+		if e.userAgent != "" {
+			m[i].UserAgent = e.userAgent
+		}
+		switch {
+		case e.messageID != "" && len(e.messageID) <= maxUUIDLength:
+			m[i].UUID = e.messageID
+		case len(events) > 1:
+			m[i].UUID = fmt.Sprintf("%s-%d", rawEvent.Uuid, i)
+		default:
+			m[i].UUID = rawEvent.Uuid
+		}
+	}
+	parser.SetEnvelopes(m, raw.Data())
+	return m, nil
+}
+
+// decode returns the events in the data of an edge event, which is plain or base64 JSON.
+func decode(data string) ([]partnerEvent, error) {
+	b := bytes.TrimSpace([]byte(data))
+	if len(b) > 0 && b[0] != '{' {
+		enc := spade.DetermineBase64Encoding(b)
+		n, err := enc.Decode(b, b)
+		if err != nil {
+			return nil, fmt.Errorf("decoding base64: %v", err)
+		}
+		b = b[:n]
+	}
+
+	var p payload
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("unmarshaling payload: %v", err)
+	}
+
+	var events []partnerEvent
+	switch {
+	case p.Events != nil:
+		for _, a := range p.Events {
+			e, err := fromAmplitude(a)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, e)
+		}
+	case p.Batch != nil:
+		for _, s := range p.Batch {
+			e, err := fromSegment(s)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, e)
+		}
+	default:
+		e, err := fromSegment(p.segmentMessage)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("no events in payload")
+	}
+	return events, nil
+}
+
+func fromSegment(s segmentMessage) (partnerEvent, error) {
+	e := partnerEvent{
+		messageID:  s.MessageID,
+		distinctID: s.UserID,
+		ip:         s.Context.IP,
+		userAgent:  s.Context.UserAgent,
+	}
+	if e.distinctID == "" {
+		e.distinctID = s.AnonymousID
+	}
+	switch s.Type {
+	case "track", "":
+		if s.Event == "" {
+			return e, fmt.Errorf("track call without an event")
+		}
+		e.event = s.Event
+		e.properties = s.Properties
+	case "identify":
+		e.event = "identify"
+		e.properties = s.Traits
+	default:
+		return e, fmt.Errorf("unsupported Segment call type %q", s.Type)
+	}
+	if s.Timestamp != "" {
+		t, err := time.Parse(time.RFC3339Nano, s.Timestamp)
+		if err != nil {
+			return e, fmt.Errorf("parsing timestamp: %v", err)
+		}
+		e.clientTime = unixSeconds(t.UnixNano() / int64(time.Millisecond))
+	}
+	return e, nil
+}
+
+func fromAmplitude(a amplitudeEvent) (partnerEvent, error) {
+	if a.EventType == "" {
+		return partnerEvent{}, fmt.Errorf("Amplitude event without an event_type")
+	}
+	e := partnerEvent{
+		event:      a.EventType,
+		properties: a.EventProperties,
+		messageID:  a.InsertID,
+		distinctID: a.UserID,
+		ip:         a.IP,
+	}
+	if e.distinctID == "" {
+		e.distinctID = a.DeviceID
+	}
+	if a.Time != 0 {
+		e.clientTime = unixSeconds(a.Time)
+	}
+	return e, nil
+}
+
+// unixSeconds formats milliseconds since the epoch as fractional seconds.
+func unixSeconds(ms int64) string {
+	return fmt.Sprintf("%d.%03d", ms/1000, ms%1000)
+}
+
+// withDefaults adds the client time and distinct ID to the properties, if they aren't there.
+func withDefaults(properties json.RawMessage, clientTime, distinctID string) (json.RawMessage, error) {
+	props := make(map[string]json.RawMessage)
+	if len(properties) > 0 && string(properties) != "null" {
+		if err := json.Unmarshal(properties, &props); err != nil {
+			return nil, err
+		}
+	}
+	if _, found := props["time"]; !found && clientTime != "" {
+		props["time"] = json.RawMessage(clientTime)
+	}
+	if _, found := props["distinct_id"]; !found && distinctID != "" {
+		id, err := json.Marshal(distinctID)
+		if err != nil {
+			return nil, err
+		}
+		props["distinct_id"] = id
+	}
+	return json.Marshal(props)
+}
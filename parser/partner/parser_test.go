@@ -0,0 +1,127 @@
+package partner
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/reporter"
+)
+
+var receivedAt = time.Unix(1424730600, 0)
+
+type line struct {
+	b []byte
+}
+
+func (l *line) Data() []byte {
+	return l.b
+}
+
+func (l *line) StartTime() time.Time {
+	return receivedAt
+}
+
+func envelope(t *testing.T, data string) parser.Parseable {
+	b, err := spade.Marshal(&spade.Event{
+		ReceivedAt: receivedAt,
+		ClientIp:   net.IPv4(10, 0, 0, 1),
+		Uuid:       "edge-uuid",
+		Data:       data,
+		UserAgent:  "partner-sdk",
+		Version:    spade.PROTOCOL_VERSION,
+		EdgeType:   spade.EXTERNAL_EDGE,
+	})
+	require.Nil(t, err)
+	return &line{b}
+}
+
+func TestSegmentTrack(t *testing.T) {
+	payload := `{
+		"type": "track",
+		"event": "item_purchased",
+		"userId": "user-1",
+		"properties": {"price": 9.99},
+		"timestamp": "2015-02-23T22:28:55.111Z",
+		"messageId": "message-1",
+		"context": {"ip": "8.8.8.8"}
+	}`
+	for _, data := range []string{payload, base64.StdEncoding.EncodeToString([]byte(payload))} {
+		mes, err := (&LogParser{}).Parse(envelope(t, data))
+		require.Nil(t, err)
+		require.Len(t, mes, 1)
+		assert.Equal(t, "item_purchased", mes[0].Event)
+		assert.Equal(t, "message-1", mes[0].UUID)
+		assert.Equal(t, "8.8.8.8", mes[0].ClientIP)
+		assert.Equal(t, "partner-sdk", mes[0].UserAgent)
+		assert.Equal(t, json.Number("1424730600"), mes[0].EventTime)
+		assert.Equal(t, spade.EXTERNAL_EDGE, mes[0].EdgeType)
+		assert.JSONEq(t, `{"price": 9.99, "time": 1424730535.111, "distinct_id": "user-1"}`,
+			string(mes[0].Properties))
+	}
+}
+
+func TestSegmentBatch(t *testing.T) {
+	mes, err := (&LogParser{}).Parse(envelope(t, `{"batch": [
+		{"type": "identify", "anonymousId": "anon-1", "traits": {"plan": "pro"}},
+		{"event": "login", "properties": {"distinct_id": "mine", "time": 1}}
+	]}`))
+	require.Nil(t, err)
+	require.Len(t, mes, 2)
+	assert.Equal(t, "identify", mes[0].Event)
+	assert.Equal(t, "edge-uuid-0", mes[0].UUID)
+	assert.Equal(t, "10.0.0.1", mes[0].ClientIP)
+	assert.JSONEq(t, `{"plan": "pro", "distinct_id": "anon-1"}`, string(mes[0].Properties))
+	assert.Equal(t, "login", mes[1].Event)
+	assert.Equal(t, "edge-uuid-1", mes[1].UUID)
+	assert.JSONEq(t, `{"distinct_id": "mine", "time": 1}`, string(mes[1].Properties))
+}
+
+func TestAmplitude(t *testing.T) {
+	mes, err := (&LogParser{}).Parse(envelope(t, `{"api_key": "key", "events": [
+		{"event_type": "watch_tutorial", "device_id": "device-1", "time": 1396381378123,
+		 "event_properties": {"load_time": 0.8}, "insert_id": "insert-1", "ip": "127.0.0.1"}
+	]}`))
+	require.Nil(t, err)
+	require.Len(t, mes, 1)
+	assert.Equal(t, "watch_tutorial", mes[0].Event)
+	assert.Equal(t, "insert-1", mes[0].UUID)
+	assert.Equal(t, "127.0.0.1", mes[0].ClientIP)
+	assert.JSONEq(t, `{"load_time": 0.8, "time": 1396381378.123, "distinct_id": "device-1"}`,
+		string(mes[0].Properties))
+}
+
+func TestFailures(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		raw          parser.Parseable
+		expectedUUID string
+	}{
+		{"bad envelope", &line{[]byte(`{`)}, "error"},
+		{"bad payload", envelope(t, `{"event": `), "edge-uuid"},
+		{"bad base64", envelope(t, `!!!`), "edge-uuid"},
+		{"no event name", envelope(t, `{"type": "track"}`), "edge-uuid"},
+		{"unsupported call", envelope(t, `{"type": "page", "name": "home"}`), "edge-uuid"},
+		{"bad timestamp", envelope(t, `{"event": "login", "timestamp": "yesterday"}`), "edge-uuid"},
+		{"empty amplitude upload", envelope(t, `{"api_key": "key", "events": []}`), "edge-uuid"},
+		{"properties not an object", envelope(t, `{"event": "login", "properties": [1]}`), "edge-uuid"},
+	} {
+		mes, err := (&LogParser{}).Parse(tt.raw)
+		assert.NotNil(t, err, tt.name)
+		require.Len(t, mes, 1, tt.name)
+		assert.Equal(t, reporter.UnableToParseData, mes[0].Failure, tt.name)
+		assert.Equal(t, tt.expectedUUID, mes[0].UUID, tt.name)
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	p, err := parser.Lookup(Name)
+	require.Nil(t, err)
+	assert.IsType(t, &LogParser{}, p)
+}
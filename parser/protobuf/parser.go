@@ -0,0 +1,64 @@
+// Package protobuf parses edge events in the protobuf envelope of package protoevent, whose
+// data are PropertyBags rather than base64 encoded JSON. It is the parser for record version
+// protoevent.Version unless the config says otherwise.
+package protobuf
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/protoevent"
+)
+
+// Name is the name the LogParser is registered under.
+const Name = "protobuf"
+
+func init() {
+	parser.Register(Name, &LogParser{})
+	parser.RegisterVersion(protoevent.Version, Name)
+}
+
+// LogParser parses protobuf events from the edge.
+type LogParser struct{}
+
+// Parse turns the raw edge event into mixpanel events.
+func (p *LogParser) Parse(raw parser.Parseable) ([]parser.MixpanelEvent, error) {
+	var rawEvent spade.Event
+	err := protoevent.Unmarshal(raw.Data(), &rawEvent)
+	if err != nil {
+		errorEvent := parser.MakeErrorEvent(raw, "", "", rawEvent.EdgeType)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
+	}
+	serverTime := fmt.Sprintf("%d", rawEvent.ReceivedAt.Unix())
+
+	bags, err := protoevent.UnmarshalBags([]byte(rawEvent.Data))
+	if err != nil {
+		errorEvent := parser.MakeErrorEvent(raw, rawEvent.Uuid, serverTime, rawEvent.EdgeType)
+		errorEvent.Error = err.Error()
+		return []parser.MixpanelEvent{*errorEvent}, err
+	}
+
+	m := make([]parser.MixpanelEvent, len(bags))
+	for i, bag := range bags {
+		m[i] = parser.MixpanelEvent{
+			Pstart:        raw.StartTime(),
+			EventTime:     json.Number(serverTime),
+			ClientIP:      rawEvent.ClientIp.String(),
+			XForwardedFor: rawEvent.XForwardedFor,
+			Event:         bag.Event,
+			EdgeType:      rawEvent.EdgeType,
+			UserAgent:     rawEvent.UserAgent,
+			Properties:    bag.Properties,
+		}
+		if len(bags) > 1 {
+			m[i].UUID = fmt.Sprintf("%s-%d", rawEvent.Uuid, i)
+		} else {
+			m[i].UUID = rawEvent.Uuid
+		}
+	}
+	parser.SetEnvelopes(m, raw.Data())
+	return m, nil
+}
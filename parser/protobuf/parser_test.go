@@ -0,0 +1,98 @@
+package protobuf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/protoevent"
+	"github.com/twitchscience/spade/reporter"
+)
+
+var receivedAt = time.Unix(1424730600, 0)
+
+type line struct {
+	b []byte
+}
+
+func (l *line) Data() []byte {
+	return l.b
+}
+
+func (l *line) StartTime() time.Time {
+	return receivedAt
+}
+
+func (l *line) Version() int {
+	return protoevent.Version
+}
+
+func envelope(t *testing.T, bags ...protoevent.Bag) *line {
+	data, err := protoevent.MarshalBags(bags)
+	require.Nil(t, err)
+	return &line{protoevent.Marshal(&spade.Event{
+		ReceivedAt:    receivedAt,
+		ClientIp:      net.IPv4(10, 0, 0, 1),
+		XForwardedFor: "1.2.3.4",
+		Uuid:          "edge-uuid",
+		Data:          string(data),
+		UserAgent:     "agent",
+		Version:       protoevent.Version,
+		EdgeType:      spade.INTERNAL_EDGE,
+	})}
+}
+
+func TestParse(t *testing.T) {
+	mes, err := (&LogParser{}).Parse(envelope(t,
+		protoevent.Bag{Event: "login", Properties: json.RawMessage(`{"distinct_id": "a", "time": 1424730535}`)}))
+	require.Nil(t, err)
+	require.Len(t, mes, 1)
+	assert.Equal(t, "login", mes[0].Event)
+	assert.Equal(t, "edge-uuid", mes[0].UUID)
+	assert.Equal(t, "10.0.0.1", mes[0].ClientIP)
+	assert.Equal(t, "1.2.3.4", mes[0].XForwardedFor)
+	assert.Equal(t, "agent", mes[0].UserAgent)
+	assert.Equal(t, json.Number("1424730600"), mes[0].EventTime)
+	assert.Equal(t, spade.INTERNAL_EDGE, mes[0].EdgeType)
+	assert.JSONEq(t, `{"distinct_id": "a", "time": 1424730535}`, string(mes[0].Properties))
+}
+
+func TestParseMultiple(t *testing.T) {
+	mes, err := (&LogParser{}).Parse(envelope(t,
+		protoevent.Bag{Event: "a", Properties: json.RawMessage(`{}`)},
+		protoevent.Bag{Event: "b", Properties: json.RawMessage(`{"x": 1}`)}))
+	require.Nil(t, err)
+	require.Len(t, mes, 2)
+	assert.Equal(t, "edge-uuid-0", mes[0].UUID)
+	assert.Equal(t, "b", mes[1].Event)
+	assert.Equal(t, "edge-uuid-1", mes[1].UUID)
+}
+
+func TestParseFailure(t *testing.T) {
+	mes, err := (&LogParser{}).Parse(&line{[]byte{0x0a, 0x05}})
+	assert.NotNil(t, err)
+	require.Len(t, mes, 1)
+	assert.Equal(t, reporter.UnableToParseData, mes[0].Failure)
+}
+
+type failingParser struct{}
+
+func (failingParser) Parse(parser.Parseable) ([]parser.MixpanelEvent, error) {
+	return nil, fmt.Errorf("parsed by the default parser")
+}
+
+// Test that the parser is used for its version even when it isn't the default.
+func TestRegisteredForVersion(t *testing.T) {
+	parser.Register("test-default", failingParser{})
+	p, err := parser.New(parser.Config{Default: "test-default"})
+	require.Nil(t, err)
+	mes, err := p.Parse(envelope(t, protoevent.Bag{Event: "login", Properties: json.RawMessage(`{}`)}))
+	require.Nil(t, err)
+	assert.Equal(t, "login", mes[0].Event)
+}
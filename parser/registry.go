@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// DefaultParser is the name of the parser used when none is configured, the edge's JSON
+// envelope parser registered by package parser/json.
+const DefaultParser = "json"
+
+// Config selects the Parser for each record version.
+type Config struct {
+	// Default is the name of the parser for versions without their own; DefaultParser if empty
+	Default string
+	// Versions maps a record version (spade.Event.Version) to the name of its parser
+	Versions map[int]string
+	// Unversioned is the name of the parser for input which isn't in an edge envelope, such
+	// as legacy log lines replayed from archives; Default if empty
+	Unversioned string
+}
+
+// Versioned is implemented by Parseables which know the record version of their data.
+type Versioned interface {
+	Version() int
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Parser{}
+	versions   = map[int]string{}
+)
+
+// Register makes a Parser available under the given name, usually from the init function of
+// the package implementing it. The Parser is shared by all converters, so it must be safe for
+// concurrent use. Register panics if the name is already taken.
+func Register(name string, p Parser) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, found := registry[name]; found {
+		panic(fmt.Sprintf("parser: %s registered twice", name))
+	}
+	registry[name] = p
+}
+
+// RegisterVersion makes the named parser the one for a record version unless the config
+// says otherwise, for record versions which only one parser understands.
+func RegisterVersion(version int, name string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if existing, found := versions[version]; found {
+		panic(fmt.Sprintf("parser: version %d registered for %s and %s", version, existing, name))
+	}
+	versions[version] = name
+}
+
+// Lookup returns the Parser registered under the given name.
+func Lookup(name string) (Parser, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	p, found := registry[name]
+	if !found {
+		var names []string
+		for n := range registry {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown parser %q, expected one of %v", name, names)
+	}
+	return p, nil
+}
+
+// New returns a Parser which parses each Versioned Parseable with the parser configured or
+// registered for its record version, or the default parser if it has none, and every other Parseable with
+// the unversioned parser.
+func New(config Config) (Parser, error) {
+	if config.Default == "" {
+		config.Default = DefaultParser
+	}
+	def, err := Lookup(config.Default)
+	if err != nil {
+		return nil, err
+	}
+	unversioned := def
+	if config.Unversioned != "" {
+		if unversioned, err = Lookup(config.Unversioned); err != nil {
+			return nil, fmt.Errorf("unversioned parser: %v", err)
+		}
+	}
+	names := registeredVersions()
+	for version, name := range config.Versions {
+		names[version] = name
+	}
+	if len(names) == 0 && config.Unversioned == "" {
+		return def, nil
+	}
+	byVersion := make(map[int]Parser, len(names))
+	for version, name := range names {
+		if byVersion[version], err = Lookup(name); err != nil {
+			return nil, fmt.Errorf("parser for version %d: %v", version, err)
+		}
+	}
+	return &versionedParser{def: def, unversioned: unversioned, byVersion: byVersion}, nil
+}
+
+// registeredVersions returns a copy of the parsers registered for record versions.
+func registeredVersions() map[int]string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make(map[int]string, len(versions))
+	for version, name := range versions {
+		names[version] = name
+	}
+	return names
+}
+
+type versionedParser struct {
+	def         Parser
+	unversioned Parser
+	byVersion   map[int]Parser
+}
+
+func (v *versionedParser) Parse(raw Parseable) ([]MixpanelEvent, error) {
+	versioned, ok := raw.(Versioned)
+	if !ok {
+		return v.unversioned.Parse(raw)
+	}
+	if p, found := v.byVersion[versioned.Version()]; found {
+		return p.Parse(raw)
+	}
+	return v.def.Parse(raw)
+}
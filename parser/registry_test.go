@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"testing"
+	"time"
+)
+
+type namedParser string
+
+func (n namedParser) Parse(Parseable) ([]MixpanelEvent, error) {
+	return []MixpanelEvent{{Event: string(n)}}, nil
+}
+
+type versionedLine struct {
+	version int
+}
+
+func (v *versionedLine) Data() []byte {
+	return nil
+}
+
+func (v *versionedLine) StartTime() time.Time {
+	return time.Time{}
+}
+
+func (v *versionedLine) Version() int {
+	return v.version
+}
+
+type plainLine struct{}
+
+func (plainLine) Data() []byte {
+	return nil
+}
+
+func (plainLine) StartTime() time.Time {
+	return time.Time{}
+}
+
+func parsedBy(t *testing.T, p Parser, raw Parseable) string {
+	events, err := p.Parse(raw)
+	if err != nil || len(events) != 1 {
+		t.Fatalf("expected one event, got %v, %v", events, err)
+	}
+	return events[0].Event
+}
+
+func TestRegistry(t *testing.T) {
+	Register("test-default", namedParser("test-default"))
+	Register("test-v9", namedParser("test-v9"))
+	Register("test-unversioned", namedParser("test-unversioned"))
+
+	p, err := New(Config{Default: "test-default", Versions: map[int]string{9: "test-v9"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	unversioned, err := New(Config{Default: "test-default", Unversioned: "test-unversioned"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, tt := range []struct {
+		p        Parser
+		raw      Parseable
+		expected string
+	}{
+		{p, &versionedLine{version: 9}, "test-v9"},
+		{p, &versionedLine{version: 3}, "test-default"},
+		{p, plainLine{}, "test-default"},
+		{unversioned, &versionedLine{version: 9}, "test-default"},
+		{unversioned, plainLine{}, "test-unversioned"},
+	} {
+		if parsed := parsedBy(t, tt.p, tt.raw); parsed != tt.expected {
+			t.Errorf("expected %v to be parsed by %s, got %s", tt.raw, tt.expected, parsed)
+		}
+	}
+
+	if _, err = New(Config{Default: "missing"}); err == nil {
+		t.Error("expected an error for an unknown default parser")
+	}
+	if _, err = New(Config{Default: "test-default", Versions: map[int]string{1: "missing"}}); err == nil {
+		t.Error("expected an error for an unknown version parser")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected registering a name twice to panic")
+		}
+	}()
+	Register("test-default", namedParser("again"))
+}
+
+func TestRegisterVersion(t *testing.T) {
+	Register("test-v8", namedParser("test-v8"))
+	Register("test-v8-override", namedParser("test-v8-override"))
+	RegisterVersion(8, "test-v8")
+
+	p, err := New(Config{Default: "test-v8-override"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed := parsedBy(t, p, &versionedLine{version: 8}); parsed != "test-v8" {
+		t.Errorf("expected version 8 to be parsed by its registered parser, got %s", parsed)
+	}
+	p, err = New(Config{Default: "test-v8", Versions: map[int]string{8: "test-v8-override"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed := parsedBy(t, p, &versionedLine{version: 8}); parsed != "test-v8-override" {
+		t.Errorf("expected the config to override the registered parser, got %s", parsed)
+	}
+}
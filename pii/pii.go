@@ -0,0 +1,190 @@
+// Package pii transforms values which identify someone, such as user IDs and IP addresses,
+// into ones which don't, so they can be written to stores which mustn't hold the originals.
+package pii
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/vrischmann/jsonutil"
+)
+
+// Func transforms an identifying value.
+type Func func(string) (string, error)
+
+// Config configures the transforms.
+type Config struct {
+	// HashSalt is the key of the HMAC used by the hmac transform, shared by every column and
+	// Kinesis field.
+	HashSalt string
+	// HashSaltVersion, if set, names HashSalt and prefixes every hash made with it, followed
+	// by HashVersionSeparator, so that hashes made with different salts can be told apart.
+	HashSaltVersion string
+	// SaltPath, if set, is a JSON file holding the HashSalt and HashSaltVersion to use
+	// instead of the ones above.
+	SaltPath string
+	// ReloadFrequency, if set, is how often the salt is reloaded from SaltPath, so it can be
+	// rotated without a restart.
+	ReloadFrequency jsonutil.Duration
+}
+
+// Validate checks that the config has a salt, or a file to read it from.
+func (c *Config) Validate() error {
+	if c.ReloadFrequency.Duration > 0 && c.SaltPath == "" {
+		return errors.New("ReloadFrequency needs a SaltPath to reload")
+	}
+	if c.SaltPath != "" {
+		return nil
+	}
+	return validateSalt(salt{c.HashSalt, c.HashSaltVersion})
+}
+
+func validateSalt(s salt) error {
+	if s.key == "" {
+		return errors.New("HashSalt must be set")
+	}
+	if strings.Contains(s.version, HashVersionSeparator) {
+		return fmt.Errorf("HashSaltVersion %q can't contain %q", s.version, HashVersionSeparator)
+	}
+	return nil
+}
+
+const (
+	// DefaultMaskKeep is how many characters the mask transform leaves at the end of a value.
+	DefaultMaskKeep = 4
+	ipv4PrefixBits  = 24
+	ipv6PrefixBits  = 48
+	// HashVersionSeparator separates the version of a salt from the hashes made with it.
+	HashVersionSeparator = ":"
+)
+
+var (
+	// ErrNoSalt is returned by Hash until a salt is set, so values are never hashed without one.
+	ErrNoSalt = errors.New("no hash salt set")
+	// ErrBadIP is when a value to anonymize isn't an IP address.
+	ErrBadIP = errors.New("not an IP address")
+)
+
+// salt is the key of the HMAC used by Hash, with the version which prefixes its hashes.
+type salt struct {
+	key, version string
+}
+
+var hashSalt atomic.Value
+
+// SetHashSalt sets the salt used by Hash, without a version. It may be called while values
+// are being hashed.
+func SetHashSalt(key string) {
+	SetVersionedHashSalt(key, "")
+}
+
+// SetVersionedHashSalt sets the salt used by Hash and its version, which prefixes the hashes
+// made with it. It may be called while values are being hashed.
+func SetVersionedHashSalt(key, version string) {
+	hashSalt.Store(salt{key, version})
+}
+
+func currentSalt() salt {
+	s, _ := hashSalt.Load().(salt)
+	return s
+}
+
+// HasHashSalt returns whether a salt has been set, so that Hash can hash values.
+func HasHashSalt() bool {
+	return currentSalt().key != ""
+}
+
+// Hash returns the hex encoded HMAC-SHA256 of the value, keyed with the salt, and prefixed
+// with the salt's version and HashVersionSeparator if it has one.
+func Hash(value string) (string, error) {
+	s := currentSalt()
+	if s.key == "" {
+		return "", ErrNoSalt
+	}
+	mac := hmac.New(sha256.New, []byte(s.key))
+	_, _ = mac.Write([]byte(value))
+	hashed := hex.EncodeToString(mac.Sum(nil))
+	if s.version == "" {
+		return hashed, nil
+	}
+	return s.version + HashVersionSeparator + hashed, nil
+}
+
+// Mask replaces all but the last keep characters of the value with *s. Values with no more
+// than keep characters are masked entirely, since leaving them would leave all of them.
+func Mask(value string, keep int) string {
+	n := utf8.RuneCountInString(value)
+	if n <= keep {
+		return strings.Repeat("*", n)
+	}
+	masked := strings.Repeat("*", n-keep)
+	for i := 0; i < n-keep; i++ {
+		_, size := utf8.DecodeRuneInString(value)
+		value = value[size:]
+	}
+	return masked + value
+}
+
+// AnonymizeIP zeroes all but the first 24 bits of an IPv4 address, or 48 of an IPv6 one.
+func AnonymizeIP(value string) (string, error) {
+	ip := net.ParseIP(strings.TrimSpace(value))
+	if ip == nil {
+		return "", ErrBadIP
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(ipv4PrefixBits, 8*net.IPv4len)).String(), nil
+	}
+	return ip.Mask(net.CIDRMask(ipv6PrefixBits, 8*net.IPv6len)).String(), nil
+}
+
+// Get returns the transform with the given name: hmac, mask (keeping DefaultMaskKeep
+// characters), f@mask@<n> (keeping n) or ipAnonymize. It returns nil for an unknown name.
+func Get(name string) Func {
+	switch name {
+	case "hmac":
+		return Hash
+	case "mask":
+		return genMask(DefaultMaskKeep)
+	case "ipAnonymize":
+		return AnonymizeIP
+	}
+	if strings.HasPrefix(name, "f@mask@") {
+		keep, err := strconv.Atoi(strings.TrimPrefix(name, "f@mask@"))
+		if err != nil || keep < 0 {
+			return nil
+		}
+		return genMask(keep)
+	}
+	return nil
+}
+
+// GetAll returns the transforms for each field, named as for Get. It fails if a field is
+// hashed and no salt has been set.
+func GetAll(names map[string]string) (map[string]Func, error) {
+	transforms := make(map[string]Func, len(names))
+	for field, name := range names {
+		f := Get(name)
+		if f == nil {
+			return nil, fmt.Errorf("unknown transform %q for field %s", name, field)
+		}
+		if name == "hmac" && !HasHashSalt() {
+			return nil, fmt.Errorf("field %s: %v", field, ErrNoSalt)
+		}
+		transforms[field] = f
+	}
+	return transforms, nil
+}
+
+func genMask(keep int) Func {
+	return func(value string) (string, error) {
+		return Mask(value, keep), nil
+	}
+}
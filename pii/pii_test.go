@@ -0,0 +1,99 @@
+package pii
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vrischmann/jsonutil"
+)
+
+func TestHash(t *testing.T) {
+	SetHashSalt("")
+	_, err := Hash("12345")
+	assert.Equal(t, ErrNoSalt, err)
+
+	SetHashSalt("key")
+	defer SetHashSalt("")
+	hashed, err := Hash("The quick brown fox jumps over the lazy dog")
+	require.NoError(t, err)
+	assert.Equal(t, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", hashed)
+
+	SetHashSalt("rotated")
+	rotated, err := Hash("The quick brown fox jumps over the lazy dog")
+	require.NoError(t, err)
+	assert.NotEqual(t, hashed, rotated)
+
+	SetVersionedHashSalt("key", "2")
+	versioned, err := Hash("The quick brown fox jumps over the lazy dog")
+	require.NoError(t, err)
+	assert.Equal(t, "2:"+hashed, versioned)
+}
+
+func TestValidate(t *testing.T) {
+	for _, c := range []Config{
+		{},
+		{HashSalt: "key", HashSaltVersion: "2:1"},
+		{HashSalt: "key", ReloadFrequency: jsonutil.FromDuration(time.Minute)},
+	} {
+		assert.Error(t, c.Validate(), "%+v", c)
+	}
+	for _, c := range []Config{
+		{HashSalt: "key"},
+		{HashSalt: "key", HashSaltVersion: "2"},
+		{SaltPath: "salt.json", ReloadFrequency: jsonutil.FromDuration(time.Minute)},
+	} {
+		assert.NoError(t, c.Validate(), "%+v", c)
+	}
+}
+
+func TestMask(t *testing.T) {
+	assert.Equal(t, "*******6789", Mask("12345-56789", 4))
+	assert.Equal(t, "**日本", Mask("ab日本", 2))
+	assert.Equal(t, "****", Mask("1234", 4))
+	assert.Equal(t, "***", Mask("abc", 0))
+	assert.Equal(t, "", Mask("", 4))
+}
+
+func TestAnonymizeIP(t *testing.T) {
+	for ip, expected := range map[string]string{
+		"222.22.222.222":          "222.22.222.0",
+		" 10.0.0.1 ":              "10.0.0.0",
+		"2001:db8:85a3::8a2e:370": "2001:db8:85a3::",
+		"::ffff:192.168.1.20":     "192.168.1.0",
+	} {
+		actual, err := AnonymizeIP(ip)
+		assert.NoError(t, err, ip)
+		assert.Equal(t, expected, actual, ip)
+	}
+	_, err := AnonymizeIP("localhost")
+	assert.Equal(t, ErrBadIP, err)
+}
+
+func TestGet(t *testing.T) {
+	masked, err := Get("f@mask@2")("secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "****et", masked)
+	masked, err = Get("mask")("secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "**cret", masked)
+
+	for _, name := range []string{"sha1", "f@mask@", "f@mask@-1", "f@mask@x"} {
+		assert.Nil(t, Get(name), name)
+	}
+
+	_, err = GetAll(map[string]string{"ip": "ipAnonymize", "login": "rot13"})
+	assert.Error(t, err)
+	transforms, err := GetAll(map[string]string{"ip": "ipAnonymize"})
+	require.NoError(t, err)
+	assert.Len(t, transforms, 1)
+
+	SetHashSalt("")
+	_, err = GetAll(map[string]string{"login": "hmac"})
+	assert.Error(t, err, "hashing without a salt should fail")
+	SetHashSalt("key")
+	defer SetHashSalt("")
+	_, err = GetAll(map[string]string{"login": "hmac"})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,78 @@
+package pii
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/twitchscience/aws_utils/logger"
+)
+
+// SaltLoader sets the salt used by Hash from a Config, reloading it from the Config's
+// SaltPath.
+type SaltLoader struct {
+	config Config
+	closer chan bool
+}
+
+// NewSaltLoader returns a SaltLoader which has set the config's salt.
+func NewSaltLoader(config Config) (*SaltLoader, error) {
+	l := &SaltLoader{config: config, closer: make(chan bool)}
+	if err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload sets the salt from SaltPath, or from the config if there isn't one. A salt which
+// has changed without its version changing is rejected, keeping the current salt, since
+// hashes made with either couldn't be told apart.
+func (l *SaltLoader) Reload() error {
+	next := salt{l.config.HashSalt, l.config.HashSaltVersion}
+	if l.config.SaltPath != "" {
+		data, err := ioutil.ReadFile(l.config.SaltPath)
+		if err != nil {
+			return fmt.Errorf("reading hash salt: %v", err)
+		}
+		var file Config
+		if err = json.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("parsing hash salt: %v", err)
+		}
+		next = salt{file.HashSalt, file.HashSaltVersion}
+	}
+	if err := validateSalt(next); err != nil {
+		return err
+	}
+	current := currentSalt()
+	if current.key != "" && current.key != next.key && current.version == next.version {
+		return fmt.Errorf("hash salt changed without its version %q changing", current.version)
+	}
+	SetVersionedHashSalt(next.key, next.version)
+	return nil
+}
+
+// Crank reloads the salt every ReloadFrequency until the SaltLoader is closed. It returns
+// straight away if there's no SaltPath or ReloadFrequency.
+func (l *SaltLoader) Crank() {
+	if l.config.SaltPath == "" || l.config.ReloadFrequency.Duration <= 0 {
+		return
+	}
+	tick := time.NewTicker(l.config.ReloadFrequency.Duration)
+	for {
+		select {
+		case <-tick.C:
+			if err := l.Reload(); err != nil {
+				logger.WithError(err).Error("Failed to reload hash salt")
+			}
+		case <-l.closer:
+			tick.Stop()
+			return
+		}
+	}
+}
+
+// Close stops Crank.
+func (l *SaltLoader) Close() {
+	close(l.closer)
+}
@@ -0,0 +1,63 @@
+package pii
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSalt(t *testing.T, path, contents string) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+}
+
+func hashPrefix(t *testing.T) string {
+	hashed, err := Hash("value")
+	require.NoError(t, err)
+	return hashed[:2]
+}
+
+func TestSaltLoaderRotation(t *testing.T) {
+	SetHashSalt("")
+	defer SetHashSalt("")
+	dir, err := ioutil.TempDir("", "salt")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "salt.json")
+
+	writeSalt(t, path, `{"HashSalt": "first", "HashSaltVersion": "1"}`)
+	l, err := NewSaltLoader(Config{SaltPath: path})
+	require.NoError(t, err)
+	assert.Equal(t, "1:", hashPrefix(t))
+	first, _ := Hash("value")
+
+	writeSalt(t, path, `{"HashSalt": "second", "HashSaltVersion": "2"}`)
+	require.NoError(t, l.Reload())
+	assert.Equal(t, "2:", hashPrefix(t))
+	second, _ := Hash("value")
+	assert.NotEqual(t, first[2:], second[2:])
+
+	// A new salt under the same version, or a broken file, keeps the current salt.
+	writeSalt(t, path, `{"HashSalt": "third", "HashSaltVersion": "2"}`)
+	assert.Error(t, l.Reload())
+	writeSalt(t, path, `{"HashSalt": ""}`)
+	assert.Error(t, l.Reload())
+	writeSalt(t, path, `{`)
+	assert.Error(t, l.Reload())
+	unchanged, _ := Hash("value")
+	assert.Equal(t, second, unchanged)
+}
+
+func TestSaltLoaderConfig(t *testing.T) {
+	SetHashSalt("")
+	defer SetHashSalt("")
+	_, err := NewSaltLoader(Config{HashSalt: "key", HashSaltVersion: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "1:", hashPrefix(t))
+
+	_, err = NewSaltLoader(Config{SaltPath: "/does/not/exist.json"})
+	assert.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package processor
 
 import (
+	"fmt"
+
 	"github.com/twitchscience/spade/parser"
 	"github.com/twitchscience/spade/reporter"
 )
@@ -18,9 +20,9 @@ type RequestConverter struct {
 func (p *RequestConverter) Process(r parser.Parseable) (events []parser.MixpanelEvent, err error) {
 	defer func() {
 		if recovered := recover(); recovered != nil {
-			events = []parser.MixpanelEvent{
-				*parser.MakePanickedEvent(r),
-			}
+			panicked := parser.MakePanickedEvent(r)
+			panicked.Error = fmt.Sprint(recovered)
+			events = []parser.MixpanelEvent{*panicked}
 		}
 	}()
 	return p.parser.Parse(r)
@@ -36,9 +38,13 @@ func (p *RequestConverter) Listen() {
 	for request := range p.in {
 		/* #nosec */ // any errors will be contained in the relevant events
 		events, _ := p.Process(request)
+		token := parser.TokenOf(request)
 		for _, event := range events {
+			token.Hold()
+			event.Token = token
 			p.out <- event
 		}
+		token.Release()
 	}
 	p.done <- true
 }
@@ -3,7 +3,6 @@ package processor
 import (
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/spade/parser"
-	"github.com/twitchscience/spade/parser/json"
 	"github.com/twitchscience/spade/reporter"
 	"github.com/twitchscience/spade/transformer"
 	"github.com/twitchscience/spade/writer"
@@ -33,9 +32,10 @@ type SpadeProcessorPool struct {
 	writer       writer.SpadeWriter
 }
 
-// BuildProcessorPool builds a new SpadeProcessorPool.
-func BuildProcessorPool(schemaConfigs transformer.SchemaConfigLoader, eventMetadataConfigs transformer.EventMetadataConfigLoader,
-	rep reporter.Reporter, writer writer.SpadeWriter, stats reporter.StatsLogger) *SpadeProcessorPool {
+// BuildProcessorPool builds a new SpadeProcessorPool, parsing requests with the given parser and
+// transforming them with the given transformer config.
+func BuildProcessorPool(logParser parser.Parser, schemaConfigs transformer.SchemaConfigLoader, eventMetadataConfigs transformer.EventMetadataConfigLoader,
+	transformerConfig transformer.Config, rep reporter.Reporter, writer writer.SpadeWriter, stats reporter.StatsLogger) *SpadeProcessorPool {
 
 	transformers := make([]*RequestTransformer, nTransformers)
 	converters := make([]*RequestConverter, nConverters)
@@ -46,7 +46,7 @@ func BuildProcessorPool(schemaConfigs transformer.SchemaConfigLoader, eventMetad
 	for i := 0; i < nConverters; i++ {
 		converters[i] = &RequestConverter{
 			r:      rep,
-			parser: &json.LogParser{},
+			parser: logParser,
 			in:     requestChannel,
 			out:    transport,
 			done:   make(chan bool),
@@ -55,7 +55,7 @@ func BuildProcessorPool(schemaConfigs transformer.SchemaConfigLoader, eventMetad
 
 	for i := 0; i < nTransformers; i++ {
 		transformers[i] = &RequestTransformer{
-			t:    transformer.NewRedshiftTransformer(schemaConfigs, eventMetadataConfigs, stats),
+			t:    transformer.NewRedshiftTransformer(schemaConfigs, eventMetadataConfigs, transformerConfig, stats),
 			in:   transport,
 			done: make(chan bool),
 		}
@@ -70,7 +70,7 @@ var (
 			},
 		},
 	)
-	_transformer = transformer.NewRedshiftTransformer(_config, _eventMetadataconfig, _cactus)
+	_transformer = transformer.NewRedshiftTransformer(_config, _eventMetadataconfig, transformer.Config{}, _cactus)
 	_parser      = &json.LogParser{}
 )
 
@@ -102,7 +102,7 @@ func (p *_panicParser) Parse(parser.Parseable) ([]parser.MixpanelEvent, error) {
 
 type _panicTransformer struct{}
 
-func (p *_panicTransformer) Consume(*parser.MixpanelEvent) *writer.WriteRequest {
+func (p *_panicTransformer) Consume(*parser.MixpanelEvent) []*writer.WriteRequest {
 	panic("panicked!")
 }
 
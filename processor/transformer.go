@@ -18,21 +18,27 @@ func (p *RequestTransformer) Close() {
 	<-p.done
 }
 
-// Process transforms the given event into a WriteRequest.
-func (p *RequestTransformer) Process(e *parser.MixpanelEvent) (request *writer.WriteRequest) {
+// Process transforms the given event into WriteRequests.
+func (p *RequestTransformer) Process(e *parser.MixpanelEvent) (requests []*writer.WriteRequest) {
 	defer func() {
 		if recovered := recover(); recovered != nil {
-			request = writer.MakeErrorRequest(e, recovered)
+			requests = []*writer.WriteRequest{writer.MakeErrorRequest(e, recovered)}
 		}
 	}()
 
 	return p.t.Consume(e)
 }
 
-// Listen listens for incoming events, transforms them, and writes them to the SpadeWriter.
+// Listen listens for incoming events, transforms them, and writes them to the SpadeWriter,
+// giving each WriteRequest a hold on the event's Token.
 func (p *RequestTransformer) Listen(w writer.SpadeWriter) {
 	for event := range p.in {
-		w.Write(p.Process(&event))
+		for _, request := range p.Process(&event) {
+			event.Token.Hold()
+			request.Token = event.Token
+			w.Write(request)
+		}
+		event.Token.Release()
 	}
 	p.done <- true
 }
@@ -0,0 +1,66 @@
+package protoevent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/codec"
+)
+
+// ExpandGlob decompresses a glob, compressed with any registered codec, into its events. The
+// events are a JSON array, or a protobuf Batch (see MarshalBatch) preceded by its record
+// version, Version.
+func ExpandGlob(glob []byte) ([]*spade.Event, error) {
+	decompressed, err := codec.Decompress(glob)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing: %v", err)
+	}
+
+	if len(decompressed) > 0 && decompressed[0] == Version {
+		events, err := UnmarshalBatch(decompressed[1:])
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling protobuf: %v", err)
+		}
+		return events, nil
+	}
+	if trimmed := bytes.TrimSpace(decompressed); len(trimmed) == 0 {
+		return nil, fmt.Errorf("empty glob")
+	} else if trimmed[0] != '[' {
+		return nil, fmt.Errorf("unknown glob record version %d, expected a JSON array or %d",
+			decompressed[0], Version)
+	}
+
+	var events []*spade.Event
+	err = json.Unmarshal(decompressed, &events)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling: %v", err)
+	}
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+		if err = DecodePlaintext(e); err != nil {
+			return nil, fmt.Errorf("error unmarshalling %s: %v", e.Uuid, err)
+		}
+	}
+	return events, nil
+}
+
+// Glob compresses events into a glob of the given compression version; it is the inverse
+// of ExpandGlob. The events are globbed as a protobuf Batch if any has protobuf data, and as
+// JSON otherwise.
+func Glob(events []*spade.Event, compressionVersion byte) ([]byte, error) {
+	for _, e := range events {
+		if e.Version == Version {
+			batch := append([]byte{Version}, MarshalBatch(events)...)
+			return codec.Compress(compressionVersion, batch)
+		}
+	}
+	data, err := json.Marshal(events)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling: %v", err)
+	}
+	return codec.Compress(compressionVersion, data)
+}
@@ -0,0 +1,94 @@
+package protoevent
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"github.com/twitchscience/spade/codec"
+)
+
+var testMsg1 = []byte(`[{"uuid": "x", "data": "test"}]`)
+
+func compressBytes(t *testing.T, compressionVersion byte, data []byte) []byte {
+	var scratch bytes.Buffer
+	compressor, err := flate.NewWriter(&scratch, flate.BestSpeed)
+	require.Nil(t, err)
+	_, err = compressor.Write(data)
+	require.Nil(t, err)
+	err = compressor.Close()
+	require.Nil(t, err)
+	return append([]byte{compressionVersion}, scratch.Bytes()...)
+}
+
+// Test that the glob is correctly decompressed.
+func TestExpandGlobNominal(t *testing.T) {
+	events, err := ExpandGlob(compressBytes(t, 1, testMsg1))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, "x", events[0].Uuid)
+	assert.Equal(t, "test", events[0].Data)
+}
+
+// Test that an unknown compression version causes an error.
+func TestExpandGlobBadVersion(t *testing.T) {
+	_, err := ExpandGlob(compressBytes(t, 99, testMsg1))
+	assert.NotNil(t, err)
+}
+
+// Test that globs which are neither JSON nor of a known record version cause an error.
+func TestExpandGlobUnknownRecordVersion(t *testing.T) {
+	for _, data := range [][]byte{[]byte("garbage"), {7, 0x0a, 0x00}, {}, []byte(" ")} {
+		_, err := ExpandGlob(compressBytes(t, 1, data))
+		assert.NotNil(t, err, "%q", data)
+	}
+	_, err := ExpandGlob(compressBytes(t, 1, []byte("garbage")))
+	assert.Contains(t, err.Error(), "unknown glob record version")
+}
+
+// Test that Glob produces globs which ExpandGlob can read.
+func TestGlobRoundTrip(t *testing.T) {
+	glob, err := Glob([]*spade.Event{{Uuid: "x", Data: "test"}, {Uuid: "y"}}, codec.Zstd)
+	require.Nil(t, err)
+	events, err := ExpandGlob(glob)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, "test", events[0].Data)
+	assert.Equal(t, "y", events[1].Uuid)
+
+	_, err = Glob([]*spade.Event{{Uuid: "x"}}, 99)
+	assert.NotNil(t, err)
+}
+
+func batchEvent(t *testing.T, uuid string) *spade.Event {
+	data, err := MarshalBags([]Bag{{Event: "login", Properties: json.RawMessage(`{"a": 1}`)}})
+	require.Nil(t, err)
+	return &spade.Event{Uuid: uuid, Data: string(data), Version: Version}
+}
+
+// Test that events with protobuf data are globbed as a protobuf Batch, which ExpandGlob reads,
+// and that JSON globs may hold them with base64 data.
+func TestGlobProtobuf(t *testing.T) {
+	e := batchEvent(t, "x")
+	glob, err := Glob([]*spade.Event{{Uuid: "json"}, e}, codec.Zstd)
+	require.Nil(t, err)
+	decompressed, err := codec.Decompress(glob)
+	require.Nil(t, err)
+	assert.Equal(t, byte(Version), decompressed[0])
+	events, err := ExpandGlob(glob)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, "json", events[0].Uuid)
+	assert.Equal(t, e.Data, events[1].Data)
+
+	plaintext, err := json.Marshal([]*spade.Event{EncodePlaintext(e)})
+	require.Nil(t, err)
+	events, err = ExpandGlob(compressBytes(t, 1, plaintext))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, e.Data, events[0].Data)
+}
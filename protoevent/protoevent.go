@@ -0,0 +1,400 @@
+// Package protoevent encodes edge events in the protobuf envelope described by
+// spade_event.proto. Events of record Version carry their properties as protobuf
+// PropertyBags rather than base64 encoded JSON, so they can be decoded without base64 or URL
+// unescaping. In memory they are spade.Events whose Data holds the encoded PropertyBags;
+// wherever events are written as JSON lines (edge logs, replays), Data is base64 encoded.
+// Glob and ExpandGlob compress lists of events into the globs read from Kinesis and back.
+package protoevent
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/twitchscience/scoop_protocol/spade"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Version is the record version of events with protobuf PropertyBags.
+const Version = 5
+
+// Bag is a decoded event, as carried in a PropertyBag.
+type Bag struct {
+	Event      string
+	Properties json.RawMessage
+}
+
+// Marshal encodes an event as a protobuf Event.
+func Marshal(e *spade.Event) []byte {
+	var b []byte
+	if !e.ReceivedAt.IsZero() {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(e.ReceivedAt.UnixNano()))
+	}
+	if e.ClientIp != nil {
+		ip := e.ClientIp.To4()
+		if ip == nil {
+			ip = e.ClientIp.To16()
+		}
+		b = appendBytes(b, 2, ip)
+	}
+	b = appendString(b, 3, e.XForwardedFor)
+	b = appendString(b, 4, e.Uuid)
+	b = appendString(b, 5, e.Data)
+	b = appendString(b, 6, e.UserAgent)
+	if e.Version != 0 {
+		b = protowire.AppendTag(b, 7, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(int64(e.Version)))
+	}
+	b = appendString(b, 8, e.EdgeType)
+	return b
+}
+
+// Unmarshal decodes a protobuf Event.
+func Unmarshal(b []byte, e *spade.Event) error {
+	*e = spade.Event{}
+	return forEachField(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			e.ReceivedAt = time.Unix(0, int64(v)).UTC()
+			return n
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n >= 0 && len(v) > 0 {
+				e.ClientIp = append(net.IP(nil), v...)
+			}
+			return n
+		case num == 3 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			e.XForwardedFor = v
+			return n
+		case num == 4 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			e.Uuid = v
+			return n
+		case num == 5 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			e.Data = v
+			return n
+		case num == 6 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			e.UserAgent = v
+			return n
+		case num == 7 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			e.Version = int(int32(v))
+			return n
+		case num == 8 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			e.EdgeType = v
+			return n
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+}
+
+// MarshalBatch encodes events as a protobuf Batch.
+func MarshalBatch(events []*spade.Event) []byte {
+	var b []byte
+	for _, e := range events {
+		b = appendBytes(b, 1, Marshal(e))
+	}
+	return b
+}
+
+// UnmarshalBatch decodes a protobuf Batch.
+func UnmarshalBatch(b []byte) ([]*spade.Event, error) {
+	var events []*spade.Event
+	var err error
+	parseErr := forEachField(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		if num != 1 || typ != protowire.BytesType {
+			return protowire.ConsumeFieldValue(num, typ, b)
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return n
+		}
+		var e spade.Event
+		if uerr := Unmarshal(v, &e); uerr != nil && err == nil {
+			err = uerr
+		}
+		events = append(events, &e)
+		return n
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decoding event: %v", err)
+	}
+	return events, nil
+}
+
+// MarshalBags encodes decoded events as PropertyBags, the Data of a Version event. Each
+// bag's properties must be a JSON object; its strings, numbers and bools are encoded as such
+// and any other value as JSON.
+func MarshalBags(bags []Bag) ([]byte, error) {
+	var b []byte
+	for _, bag := range bags {
+		d := json.NewDecoder(bytes.NewReader(bag.Properties))
+		d.UseNumber()
+		var properties map[string]interface{}
+		if err := d.Decode(&properties); err != nil {
+			return nil, fmt.Errorf("decoding properties of %s: %v", bag.Event, err)
+		}
+		encoded, err := marshalBag(bag.Event, properties)
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s: %v", bag.Event, err)
+		}
+		b = appendBytes(b, 1, encoded)
+	}
+	return b, nil
+}
+
+func marshalBag(event string, properties map[string]interface{}) ([]byte, error) {
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	b := appendString(nil, 1, event)
+	for _, k := range keys {
+		property, err := marshalProperty(k, properties[k])
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %v", k, err)
+		}
+		b = appendBytes(b, 2, property)
+	}
+	return b, nil
+}
+
+func marshalProperty(name string, value interface{}) ([]byte, error) {
+	b := appendString(nil, 1, name)
+	switch v := value.(type) {
+	case string:
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		return protowire.AppendString(b, v), nil
+	case int:
+		return appendInt(b, int64(v)), nil
+	case int32:
+		return appendInt(b, int64(v)), nil
+	case int64:
+		return appendInt(b, v), nil
+	case float32:
+		return appendDouble(b, float64(v)), nil
+	case float64:
+		return appendDouble(b, v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return appendInt(b, i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return appendDouble(b, f), nil
+	case bool:
+		b = protowire.AppendTag(b, 5, protowire.VarintType)
+		return protowire.AppendVarint(b, protowire.EncodeBool(v)), nil
+	}
+	j, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	b = protowire.AppendTag(b, 6, protowire.BytesType)
+	return protowire.AppendBytes(b, j), nil
+}
+
+// UnmarshalBags decodes PropertyBags, rendering each bag's properties as a JSON object.
+func UnmarshalBags(data []byte) ([]Bag, error) {
+	var bags []Bag
+	var err error
+	parseErr := forEachField(data, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		if num != 1 || typ != protowire.BytesType {
+			return protowire.ConsumeFieldValue(num, typ, b)
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return n
+		}
+		bag, berr := unmarshalBag(v)
+		if berr != nil && err == nil {
+			err = berr
+		}
+		bags = append(bags, bag)
+		return n
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(bags) == 0 {
+		return nil, fmt.Errorf("no events in property bags")
+	}
+	return bags, nil
+}
+
+func unmarshalBag(b []byte) (Bag, error) {
+	var bag Bag
+	properties := bytes.NewBufferString("{")
+	var err error
+	parseErr := forEachField(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			bag.Event = v
+			return n
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n
+			}
+			if properties.Len() > 1 {
+				properties.WriteByte(',')
+			}
+			if perr := writeProperty(properties, v); perr != nil && err == nil {
+				err = perr
+			}
+			return n
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+	if parseErr != nil {
+		return bag, parseErr
+	}
+	if err != nil {
+		return bag, fmt.Errorf("property of %s: %v", bag.Event, err)
+	}
+	properties.WriteByte('}')
+	bag.Properties = properties.Bytes()
+	return bag, nil
+}
+
+// writeProperty writes a Property to buf as a JSON object member.
+func writeProperty(buf *bytes.Buffer, b []byte) error {
+	var name string
+	var value []byte
+	parseErr := forEachField(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			name = v
+			return n
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			value, _ = json.Marshal(v)
+			return n
+		case num == 3 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			value = strconv.AppendInt(nil, protowire.DecodeZigZag(v), 10)
+			return n
+		case num == 4 && typ == protowire.Fixed64Type:
+			v, n := protowire.ConsumeFixed64(b)
+			f := math.Float64frombits(v)
+			if math.IsInf(f, 0) || math.IsNaN(f) {
+				value = []byte("null")
+			} else {
+				value = strconv.AppendFloat(nil, f, 'g', -1, 64)
+			}
+			return n
+		case num == 5 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			value = strconv.AppendBool(nil, protowire.DecodeBool(v))
+			return n
+		case num == 6 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			value = v
+			return n
+		}
+		return protowire.ConsumeFieldValue(num, typ, b)
+	})
+	if parseErr != nil {
+		return parseErr
+	}
+	if value == nil {
+		value = []byte("null")
+	} else if !json.Valid(value) {
+		return fmt.Errorf("%s is not valid JSON", name)
+	}
+	key, _ := json.Marshal(name)
+	buf.Write(key)
+	buf.WriteByte(':')
+	buf.Write(value)
+	return nil
+}
+
+// EncodePlaintext returns the event with its Data base64 encoded if it is a Version event, so
+// it can be written as JSON. The event itself is left alone.
+func EncodePlaintext(e *spade.Event) *spade.Event {
+	if e.Version != Version {
+		return e
+	}
+	plaintext := *e
+	plaintext.Data = base64.StdEncoding.EncodeToString([]byte(e.Data))
+	return &plaintext
+}
+
+// DecodePlaintext decodes the base64 Data of a Version event read from JSON in place. Other
+// events are left alone.
+func DecodePlaintext(e *spade.Event) error {
+	if e.Version != Version {
+		return nil
+	}
+	data, err := base64.StdEncoding.DecodeString(e.Data)
+	if err != nil {
+		return fmt.Errorf("decoding base64 property bags: %v", err)
+	}
+	e.Data = string(data)
+	return nil
+}
+
+// forEachField calls fn with the number, type and encoded value of each field in b. fn
+// returns the length of the value, or a negative protowire error code.
+func forEachField(b []byte, fn func(protowire.Number, protowire.Type, []byte) int) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		n = fn(num, typ, b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+func appendString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendInt(b []byte, v int64) []byte {
+	b = protowire.AppendTag(b, 3, protowire.VarintType)
+	return protowire.AppendVarint(b, protowire.EncodeZigZag(v))
+}
+
+func appendDouble(b []byte, v float64) []byte {
+	b = protowire.AppendTag(b, 4, protowire.Fixed64Type)
+	return protowire.AppendFixed64(b, math.Float64bits(v))
+}
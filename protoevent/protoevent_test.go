@@ -0,0 +1,102 @@
+package protoevent
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/scoop_protocol/spade"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	for _, e := range []*spade.Event{
+		{
+			ReceivedAt:    time.Unix(1424730600, 123456789).UTC(),
+			ClientIp:      net.IPv4(10, 0, 0, 1),
+			XForwardedFor: "1.2.3.4",
+			Uuid:          "uuid",
+			Data:          "\x0a\x00",
+			UserAgent:     "agent",
+			Version:       Version,
+			EdgeType:      spade.EXTERNAL_EDGE,
+		},
+		{ClientIp: net.ParseIP("2001:db8::1"), Uuid: "v6"},
+		{},
+	} {
+		var decoded spade.Event
+		require.Nil(t, Unmarshal(Marshal(e), &decoded))
+		assert.Equal(t, e.ReceivedAt, decoded.ReceivedAt)
+		assert.True(t, e.ClientIp.Equal(decoded.ClientIp))
+		assert.Equal(t, e.XForwardedFor, decoded.XForwardedFor)
+		assert.Equal(t, e.Uuid, decoded.Uuid)
+		assert.Equal(t, e.Data, decoded.Data)
+		assert.Equal(t, e.UserAgent, decoded.UserAgent)
+		assert.Equal(t, e.Version, decoded.Version)
+		assert.Equal(t, e.EdgeType, decoded.EdgeType)
+	}
+}
+
+func TestBatchRoundTrip(t *testing.T) {
+	events, err := UnmarshalBatch(MarshalBatch([]*spade.Event{{Uuid: "a"}, {Uuid: "b", Version: Version}}))
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "a", events[0].Uuid)
+	assert.Equal(t, Version, events[1].Version)
+
+	_, err = UnmarshalBatch([]byte{0x0a, 0x05, 0x01})
+	assert.NotNil(t, err)
+}
+
+func TestBagsRoundTrip(t *testing.T) {
+	properties := `{"channel": "lirik", "count": 3, "big": 12345678901234, "ratio": 0.5,
+		"live": true, "tags": ["a", "b"], "meta": {"x": null}, "nothing": null}`
+	data, err := MarshalBags([]Bag{
+		{Event: "video-play", Properties: json.RawMessage(properties)},
+		{Event: "empty", Properties: json.RawMessage(`{}`)},
+	})
+	require.Nil(t, err)
+
+	bags, err := UnmarshalBags(data)
+	require.Nil(t, err)
+	require.Len(t, bags, 2)
+	assert.Equal(t, "video-play", bags[0].Event)
+	assert.JSONEq(t, properties, string(bags[0].Properties))
+	assert.Equal(t, "empty", bags[1].Event)
+	assert.JSONEq(t, `{}`, string(bags[1].Properties))
+
+	_, err = MarshalBags([]Bag{{Event: "bad", Properties: json.RawMessage(`[1]`)}})
+	assert.NotNil(t, err)
+}
+
+func TestUnmarshalBagsErrors(t *testing.T) {
+	_, err := UnmarshalBags(nil)
+	assert.NotNil(t, err, "expected an error for no events")
+
+	// A property whose json_value isn't JSON.
+	property := protowire.AppendTag(nil, 1, protowire.BytesType)
+	property = protowire.AppendString(property, "p")
+	property = protowire.AppendTag(property, 6, protowire.BytesType)
+	property = protowire.AppendString(property, "{nope")
+	bag := appendString(nil, 1, "event")
+	bag = appendBytes(bag, 2, property)
+	_, err = UnmarshalBags(appendBytes(nil, 1, bag))
+	assert.NotNil(t, err, "expected an error for invalid JSON")
+}
+
+func TestPlaintextRoundTrip(t *testing.T) {
+	e := &spade.Event{Uuid: "a", Data: "\x0a\x01\xff", Version: Version}
+	plaintext := EncodePlaintext(e)
+	assert.Equal(t, "CgH/", plaintext.Data)
+	assert.Equal(t, "\x0a\x01\xff", e.Data, "expected the event to be left alone")
+	require.Nil(t, DecodePlaintext(plaintext))
+	assert.Equal(t, e.Data, plaintext.Data)
+
+	legacy := &spade.Event{Data: "e30=", Version: spade.PROTOCOL_VERSION}
+	assert.Equal(t, legacy, EncodePlaintext(legacy))
+	require.Nil(t, DecodePlaintext(legacy))
+	assert.Equal(t, "e30=", legacy.Data)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/twitchscience/spade/consumer"
+	"github.com/twitchscience/spade/transformer"
+)
+
+// redriveOptions configures the redrive subcommand, which processes stored dead letters or
+// nontracked events again with the current schemas instead of consuming from Kinesis.
+type redriveOptions struct {
+	redrive consumer.RedriveConfig
+	// localDir, if set, serves files from <localDir>/<bucket>/ instead of S3.
+	localDir string
+}
+
+// parseRedriveArgs parses the arguments after the redrive subcommand, which are
+// -bucket BUCKET [-kind KIND] [-local_dir DIR] [-workers N] START END [EVENT ...].
+func parseRedriveArgs(args []string) (*redriveOptions, error) {
+	fs := flag.NewFlagSet("redrive", flag.ContinueOnError)
+	bucket := fs.String("bucket", "", "bucket holding the dead letters or nontracked events to redrive")
+	kind := fs.String("kind", consumer.RedriveDeadLetters, fmt.Sprintf(
+		"what the bucket holds, %s or %s", consumer.RedriveDeadLetters, consumer.RedriveNonTracked))
+	localDir := fs.String("local_dir", "", "read files from <local_dir>/<bucket>/ instead of S3")
+	workers := fs.Int("workers", runtime.NumCPU(), "number of files to stream concurrently")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *bucket == "" {
+		return nil, errors.New("-bucket is required")
+	}
+	if *kind != consumer.RedriveDeadLetters && *kind != consumer.RedriveNonTracked {
+		return nil, fmt.Errorf("-kind must be %s or %s, got %q",
+			consumer.RedriveDeadLetters, consumer.RedriveNonTracked, *kind)
+	}
+	if fs.NArg() < 2 {
+		return nil, errors.New("usage: redrive [flags] START END [EVENT ...]")
+	}
+
+	start, err := time.ParseInLocation(replayTimeFormat, fs.Arg(0), transformer.PST)
+	if err != nil {
+		return nil, fmt.Errorf("parsing START: %v", err)
+	}
+	end, err := time.ParseInLocation(replayTimeFormat, fs.Arg(1), transformer.PST)
+	if err != nil {
+		return nil, fmt.Errorf("parsing END: %v", err)
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("need a valid time range, got %v to %v", start, end)
+	}
+
+	return &redriveOptions{
+		redrive: consumer.RedriveConfig{
+			Bucket:  *bucket,
+			Kind:    *kind,
+			Start:   start,
+			End:     end,
+			Events:  fs.Args()[2:],
+			Workers: *workers,
+		},
+		localDir: *localDir,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/twitchscience/spade/consumer"
+	"github.com/twitchscience/spade/transformer"
+)
+
+// replayTimeFormat is the format of the START and END arguments, in Pacific time.
+const replayTimeFormat = "2006-01-02 15:04:05"
+
+// replayOptions configures the replay subcommand, which reprocesses edge logs for a time
+// window instead of consuming from Kinesis.
+type replayOptions struct {
+	edgeLogs consumer.EdgeLogConfig
+	// localDir, if set, serves edge logs from <localDir>/<bucket>/ instead of S3.
+	localDir string
+}
+
+// parseReplayArgs parses the arguments after the replay subcommand, which are
+// [-edge_bucket BUCKET] [-local_dir DIR] [-workers N] START END [TABLE ...].
+func parseReplayArgs(args []string) (*replayOptions, error) {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	bucket := fs.String("edge_bucket", "", "bucket holding the edge logs to replay")
+	localDir := fs.String("local_dir", "", "read edge logs from <local_dir>/<edge_bucket>/ instead of S3")
+	workers := fs.Int("workers", runtime.NumCPU(), "number of edge logs to stream concurrently")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *bucket == "" {
+		return nil, errors.New("-edge_bucket is required")
+	}
+	if fs.NArg() < 2 {
+		return nil, errors.New("usage: replay [flags] START END [TABLE ...]")
+	}
+
+	start, err := time.ParseInLocation(replayTimeFormat, fs.Arg(0), transformer.PST)
+	if err != nil {
+		return nil, fmt.Errorf("parsing START: %v", err)
+	}
+	end, err := time.ParseInLocation(replayTimeFormat, fs.Arg(1), transformer.PST)
+	if err != nil {
+		return nil, fmt.Errorf("parsing END: %v", err)
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("need a valid time range, got %v to %v", start, end)
+	}
+
+	return &replayOptions{
+		edgeLogs: consumer.EdgeLogConfig{
+			Bucket:  *bucket,
+			Start:   start,
+			End:     end,
+			Tables:  fs.Args()[2:],
+			Workers: *workers,
+		},
+		localDir: *localDir,
+	}, nil
+}
+
+// defaultRunTag returns the run tag used when none is given on the command line.
+func defaultRunTag(now time.Time) string {
+	return now.Format("20060102T150405")
+}
@@ -15,19 +15,23 @@ const (
 	UnknownError
 	PanickedInProcessing
 	FailedTransport
+	UnmappedProperties
+	UnmappedPropertiesSample
 )
 
 var failMessages = map[FailMode]string{
-	None:                 "Success",
-	SkippedColumn:        "Missing One or More Columns",
-	UnableToParseData:    "Malformed Data",
-	NonTrackingEvent:     "Untracked Event",
-	BadColumnConversion:  "Badly Typed Columns",
-	FailedWrite:          "Failed To Write",
-	EmptyRequest:         "Empty Request",
-	UnknownError:         "Unknown Failure",
-	PanickedInProcessing: "Panicked in Processing",
-	FailedTransport:      "Failed in Transport",
+	None:                     "Success",
+	SkippedColumn:            "Missing One or More Columns",
+	UnableToParseData:        "Malformed Data",
+	NonTrackingEvent:         "Untracked Event",
+	BadColumnConversion:      "Badly Typed Columns",
+	FailedWrite:              "Failed To Write",
+	EmptyRequest:             "Empty Request",
+	UnknownError:             "Unknown Failure",
+	PanickedInProcessing:     "Panicked in Processing",
+	FailedTransport:          "Failed in Transport",
+	UnmappedProperties:       "Unmapped Properties",
+	UnmappedPropertiesSample: "Sampled Unmapped Properties",
 }
 
 // Return a human-readable string describing the given FailMode.
@@ -2,6 +2,7 @@ package tables
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"strings"
@@ -9,6 +10,8 @@ import (
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
 	"github.com/twitchscience/spade/geoip"
+	"github.com/twitchscience/spade/pii"
+	"github.com/twitchscience/spade/reporter"
 	"github.com/twitchscience/spade/transformer"
 )
 
@@ -17,6 +20,17 @@ type Tables struct {
 	Configs []scoop_protocol.Config
 }
 
+// columnError is why a column can't be processed safely, such as hashing without a salt. Its
+// table is dropped from the config, like a table with an unknown transform.
+type columnError struct {
+	column string
+	err    error
+}
+
+func (e columnError) Error() string {
+	return fmt.Sprintf("column %s: %v", e.column, e.err)
+}
+
 func getTypes(
 	definitions []scoop_protocol.ColumnDefinition,
 	tConfigs map[string]transformer.MappingTransformerConfig,
@@ -26,12 +40,32 @@ func getTypes(
 	for i, definition := range definitions {
 		var t transformer.ColumnTransformer
 		var supportingColumns []string
-		tConfig, ok := tConfigs[definition.Transformer]
+		tConfig, ok := tConfigs[transformer.MappingTransformerID(definition.Transformer)]
 		if ok {
-			t = transformer.GetMappingTransform(definition.Transformer, tConfig)
 			supportingColumns = strings.Split(definition.SupportingColumns, ",")
+			if transformer.IsLookupMapping(definition.Transformer) {
+				for _, name := range supportingColumns {
+					if strings.TrimSpace(name) == "" {
+						return nil, columnError{definition.OutboundName, fmt.Errorf(
+							"lookup %s needs supporting columns naming its arguments, got %q",
+							definition.Transformer, definition.SupportingColumns)}
+					}
+				}
+			}
+			t = transformer.GetMappingTransform(definition.Transformer, tConfig, supportingColumns)
+		} else if strings.HasPrefix(definition.Transformer, transformer.DerivedPrefix) {
+			// Derived columns always get their supporting columns, even if there are none,
+			// so that they can handle missing properties.
+			supportingColumns = []string{}
+			if definition.SupportingColumns != "" {
+				supportingColumns = strings.Split(definition.SupportingColumns, ",")
+			}
+			t = transformer.GetDerivedTransform(definition.Transformer, len(supportingColumns)+1)
 		} else {
-			t = transformer.GetSingleValueTransform(definition.Transformer, geoip)
+			if definition.Transformer == "hmac" && !pii.HasHashSalt() {
+				return nil, columnError{definition.OutboundName, pii.ErrNoSalt}
+			}
+			t = transformer.GetColumnTransform(definition.Transformer, definition.ColumnCreationOptions, geoip)
 		}
 		if t == nil {
 			logger.WithError(transformer.ErrUnknownTransform).WithField(
@@ -63,21 +97,28 @@ func LoadConfig(file io.Reader) (Tables, error) {
 	return Tables{cfgs}, nil
 }
 
-// CompileForParsing returns a map of transformers and versions for our table configs.
+// CompileForParsing returns a map of transformers and versions for our table configs. Tables
+// with a transform this processor doesn't know, or a column it can't process safely, such as
+// one hashed without a salt or a lookup without supporting columns, are left out and counted
+// in the config.table.dropped stat.
 func (c *Tables) CompileForParsing(
 	tConfigs map[string]transformer.MappingTransformerConfig,
 	geoip geoip.GeoLookup,
-) (map[string][]transformer.RedshiftType, map[string]int, error) {
+	stats reporter.StatsLogger,
+) (map[string][]transformer.RedshiftType, map[string]int) {
 	configs := make(map[string][]transformer.RedshiftType)
 	versions := make(map[string]int)
 	for _, config := range c.Configs {
 		typedConfig, typeErr := getTypes(config.Columns, tConfigs, geoip)
-		if typeErr == nil {
-			configs[config.EventName] = typedConfig
-			versions[config.EventName] = config.Version
+		if typeErr != nil {
+			logger.WithError(typeErr).WithField("event", config.EventName).Error("Dropping table from config")
+			stats.IncrBy("config.table.dropped", 1)
+			continue
 		}
+		configs[config.EventName] = typedConfig
+		versions[config.EventName] = config.Version
 	}
-	return configs, versions, nil
+	return configs, versions
 }
 
 // CompileForMaintenance turns our list of Configs into a map.
@@ -114,10 +114,7 @@ func (d *DynamicLoader) pullConfigIn() (map[string][]transformer.RedshiftType, m
 		return nil, nil, err
 	}
 
-	newConfigs, newVersions, err := tables.CompileForParsing(d.tConfigs, d.geoip)
-	if err != nil {
-		return nil, nil, err
-	}
+	newConfigs, newVersions := tables.CompileForParsing(d.tConfigs, d.geoip, d.stats)
 	return newConfigs, newVersions, nil
 }
 
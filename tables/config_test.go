@@ -4,13 +4,34 @@ import (
 	"bytes"
 	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/cactus/go-statsd-client/statsd"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
 
 	"github.com/twitchscience/spade/geoip"
+	"github.com/twitchscience/spade/pii"
 	"github.com/twitchscience/spade/transformer"
 )
 
+// countingStats counts the tables dropped from configs.
+type countingStats struct {
+	dropped int
+}
+
+func (s *countingStats) Timing(stat string, t time.Duration) {
+}
+
+func (s *countingStats) IncrBy(stat string, value int) {
+	if stat == "config.table.dropped" {
+		s.dropped += value
+	}
+}
+
+func (s *countingStats) GetStatter() statsd.Statter {
+	return nil
+}
+
 func newColumnDefs(in, out, transformer, opts string) scoop_protocol.ColumnDefinition {
 	return scoop_protocol.ColumnDefinition{
 		InboundName:           in,
@@ -29,6 +50,7 @@ func buildConfig() []byte {
 				newColumnDefs("testCharIn", "testChar", "varchar", "(32)"),
 				newColumnDefs("testIn", "test", "f@timestamp@2006-01-02 15:04:05", ""),
 				newColumnDefs("testMappingIn", "testMapping", "userIDWithMapping", ""),
+				newColumnDefs("testPriceIn", "testPrice", "decimal", "(12,2)"),
 			},
 			Version: 22,
 		},
@@ -57,7 +79,7 @@ func TestConfigLoading(t *testing.T) {
 		t.Error(err)
 		t.Fail()
 	}
-	maintenanceStrings, maintananceVersions, _ := tables.CompileForParsing(buildMappingConfig(), geoip.Noop())
+	maintenanceStrings, maintananceVersions := tables.CompileForParsing(buildMappingConfig(), geoip.Noop(), &countingStats{})
 	loader := NewStaticLoader(maintenanceStrings, maintananceVersions)
 	_, err = loader.GetColumnsForEvent("test1")
 	if err != nil {
@@ -82,7 +104,7 @@ func TestVersionLoading(t *testing.T) {
 		t.Error(err)
 		t.Fail()
 	}
-	maintenanceStrings, maintananceVersions, _ := tables.CompileForParsing(buildMappingConfig(), geoip.Noop())
+	maintenanceStrings, maintananceVersions := tables.CompileForParsing(buildMappingConfig(), geoip.Noop(), &countingStats{})
 	loader := NewStaticLoader(maintenanceStrings, maintananceVersions)
 	version := loader.GetVersionForEvent("test1")
 	if version != 22 {
@@ -101,3 +123,46 @@ func TestVersionLoading(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestHashWithoutSalt(t *testing.T) {
+	tables := Tables{[]scoop_protocol.Config{
+		{EventName: "login", Columns: []scoop_protocol.ColumnDefinition{newColumnDefs("login", "login", "hmac", "")}},
+		{EventName: "unknown", Columns: []scoop_protocol.ColumnDefinition{newColumnDefs("x", "x", "rot13", "")}},
+		{EventName: "plain", Columns: []scoop_protocol.ColumnDefinition{newColumnDefs("x", "x", "int", "")}},
+	}}
+	stats := &countingStats{}
+	configs, _ := tables.CompileForParsing(nil, geoip.Noop(), stats)
+	if _, ok := configs["plain"]; !ok || len(configs) != 1 {
+		t.Errorf("expected only the plain table, got %v", configs)
+	}
+	if stats.dropped != 2 {
+		t.Errorf("expected 2 tables to be counted as dropped, got %d", stats.dropped)
+	}
+
+	pii.SetHashSalt("salt")
+	defer pii.SetHashSalt("")
+	configs, _ = tables.CompileForParsing(nil, geoip.Noop(), &countingStats{})
+	if _, ok := configs["login"]; !ok || len(configs) != 2 {
+		t.Errorf("expected the hashing and plain tables, got %v", configs)
+	}
+}
+
+func TestLookupWithoutSupportingColumns(t *testing.T) {
+	tConfigs := map[string]transformer.MappingTransformerConfig{"gameWithMapping": {}}
+	for _, supporting := range []string{"", "game,", " "} {
+		column := newColumnDefs("game_id", "game_id", "gameWithMapping@id", "")
+		column.SupportingColumns = supporting
+		tables := Tables{[]scoop_protocol.Config{
+			{EventName: "play", Columns: []scoop_protocol.ColumnDefinition{column}},
+			{EventName: "plain", Columns: []scoop_protocol.ColumnDefinition{newColumnDefs("x", "x", "int", "")}},
+		}}
+		stats := &countingStats{}
+		configs, _ := tables.CompileForParsing(tConfigs, geoip.Noop(), stats)
+		if _, ok := configs["plain"]; !ok || len(configs) != 1 {
+			t.Errorf("expected a lookup with supporting columns %q to only drop its table, got %v", supporting, configs)
+		}
+		if stats.dropped != 1 {
+			t.Errorf("expected 1 table to be counted as dropped, got %d", stats.dropped)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package transformer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/twitchscience/spade/lookup"
+)
+
+// Classes of column failure.
+const (
+	// FailureNotFound is when the column's property is missing or null.
+	FailureNotFound = "not_found"
+	// FailureTypeMismatch is when the property can't be converted to the column's type.
+	FailureTypeMismatch = "type_mismatch"
+	// FailureOutOfRange is when the property converts but doesn't fit the column.
+	FailureOutOfRange = "out_of_range"
+	// FailureLookup is when a mapping column's lookup of the property failed.
+	FailureLookup = "lookup_failure"
+)
+
+const (
+	// maxFailureSamples is how many of a column's most recent offending values are kept.
+	maxFailureSamples = 5
+	// maxFailureSampleLength bounds the length of a sampled value.
+	maxFailureSampleLength = 256
+	// failureSampleInterval is the least time between samples of one column's failures.
+	failureSampleInterval = time.Minute
+)
+
+// columnFailureClass returns the class of a column's failure, given the property the column
+// was formatted from.
+func columnFailureClass(err error, value interface{}) string {
+	switch err {
+	case ErrColumnNotFound:
+		return FailureNotFound
+	case lookup.ErrTooManyRequests, lookup.ErrExtractingValue, ErrBadLookupValue,
+		ErrEmptyLookupValue, ErrFetchFailure:
+		return FailureLookup
+	case ErrVarcharTooLong, ErrDivideByZero:
+		return FailureOutOfRange
+	}
+	switch e := err.(type) {
+	case ErrOutOfRange:
+		return FailureOutOfRange
+	case *strconv.NumError:
+		if e.Err == strconv.ErrRange {
+			return FailureOutOfRange
+		}
+	}
+	if value == nil {
+		return FailureNotFound
+	}
+	return FailureTypeMismatch
+}
+
+// redactError returns a column's error without the value it failed on, which many errors
+// quote.
+func redactError(err error) string {
+	switch e := err.(type) {
+	case ErrParse:
+		return e.Redacted()
+	case ErrOutOfRange:
+		return "value out of range"
+	case *strconv.NumError:
+		return fmt.Sprintf("strconv.%s: %v", e.Func, e.Err)
+	case *time.ParseError:
+		return fmt.Sprintf("parsing time as %q", e.Layout)
+	}
+	switch err {
+	// These errors' messages never include the value.
+	case ErrColumnNotFound, ErrVarcharTooLong, ErrDivideByZero, ErrBadURL, ErrNoDomain,
+		ErrBadLookupValue, ErrEmptyLookupValue, ErrFetchFailure, lookup.ErrTooManyRequests,
+		lookup.ErrExtractingValue:
+		return err.Error()
+	}
+	return "error redacted"
+}
+
+// describeValue returns the JSON type and size of a value, without the value.
+func describeValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("string of %d characters", utf8.RuneCountInString(v))
+	case json.Number:
+		return fmt.Sprintf("number of %d characters", len(v))
+	case bool:
+		return "bool"
+	case map[string]interface{}:
+		return fmt.Sprintf("object of %d properties", len(v))
+	case []interface{}:
+		return fmt.Sprintf("array of %d items", len(v))
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// FailureReportConfig configures what the report of column failures keeps.
+type FailureReportConfig struct {
+	// RawValues, if set, samples the values columns failed on, and their errors, as they are,
+	// rather than describing the values and redacting the errors. The values may identify
+	// users.
+	RawValues bool
+}
+
+// ColumnFailures are the failures of one class of one event's column.
+type ColumnFailures struct {
+	Event      string
+	Column     string
+	Class      string
+	Count      int64
+	LastFailed time.Time
+	// LastError is the error of the last failure, redacted unless the report keeps raw
+	// values, in which case it's the error of the last sampled failure
+	LastError string
+	// Samples are the most recent sampled values which the column failed on, described by
+	// their type and size unless the report keeps raw values, which are sampled as JSON
+	Samples []string
+
+	lastSampled time.Time
+}
+
+type columnFailureKey struct {
+	event, column, class string
+}
+
+// FailureReport collects the failures of each column, with a rate-limited sample of the
+// values they failed on, so that the clients sending bad values can be found.
+type FailureReport struct {
+	lock      sync.Mutex
+	failures  map[columnFailureKey]*ColumnFailures
+	interval  time.Duration
+	rawValues bool
+}
+
+// Failures is the report of the failures of every RedshiftTransformer's columns.
+var Failures = NewFailureReport(failureSampleInterval)
+
+// NewFailureReport returns an empty FailureReport which samples the failures of each column
+// at most once per interval.
+func NewFailureReport(interval time.Duration) *FailureReport {
+	return &FailureReport{
+		failures: make(map[columnFailureKey]*ColumnFailures),
+		interval: interval,
+	}
+}
+
+// Configure sets what the report keeps of the failures recorded from now on.
+func (r *FailureReport) Configure(config FailureReportConfig) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.rawValues = config.RawValues
+}
+
+// Record records a column's failure on a value. Values which failed with an ErrSensitiveValue
+// aren't sampled.
+func (r *FailureReport) Record(event, column, class string, err error, value interface{}) {
+	now := time.Now()
+	key := columnFailureKey{event, column, class}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	f, ok := r.failures[key]
+	if !ok {
+		f = &ColumnFailures{Event: event, Column: column, Class: class}
+		r.failures[key] = f
+	}
+	f.Count++
+	f.LastFailed = now
+	// Sensitive values' errors don't include them, and the values aren't sampled.
+	if _, ok := err.(ErrSensitiveValue); ok {
+		f.LastError = err.Error()
+		return
+	}
+	if err != nil && !r.rawValues {
+		f.LastError = redactError(err)
+	}
+	if !f.lastSampled.IsZero() && now.Sub(f.lastSampled) < r.interval {
+		return
+	}
+	f.lastSampled = now
+	sample := describeValue(value)
+	if r.rawValues {
+		if err != nil {
+			f.LastError = err.Error()
+		}
+		raw, jsonErr := json.Marshal(value)
+		if jsonErr != nil {
+			return
+		}
+		if len(raw) > maxFailureSampleLength {
+			raw = raw[:maxFailureSampleLength]
+		}
+		sample = string(raw)
+	}
+	f.Samples = append(f.Samples, sample)
+	if len(f.Samples) > maxFailureSamples {
+		f.Samples = f.Samples[len(f.Samples)-maxFailureSamples:]
+	}
+}
+
+// Query returns copies of the failures of the event's column, sorted by event, column and
+// class. An empty event or column matches all of them.
+func (r *FailureReport) Query(event, column string) []ColumnFailures {
+	r.lock.Lock()
+	var failures []ColumnFailures
+	for key, f := range r.failures {
+		if (event == "" || key.event == event) && (column == "" || key.column == column) {
+			c := *f
+			c.Samples = append([]string(nil), f.Samples...)
+			failures = append(failures, c)
+		}
+	}
+	r.lock.Unlock()
+
+	sort.Slice(failures, func(i, j int) bool {
+		a, b := failures[i], failures[j]
+		if a.Event != b.Event {
+			return a.Event < b.Event
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Class < b.Class
+	})
+	return failures
+}
+
+// ServeHTTP serves the failures matching the request's event and column parameters as JSON.
+func (r *FailureReport) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	failures := r.Query(req.FormValue("event"), req.FormValue("column"))
+	if failures == nil {
+		failures = []ColumnFailures{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(failures)
+}
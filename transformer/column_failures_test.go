@@ -0,0 +1,116 @@
+package transformer
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/spade/lookup"
+)
+
+func TestColumnFailureClass(t *testing.T) {
+	_, rangeErr := strconv.ParseInt("99999999999999999999", 10, 64)
+	for _, c := range []struct {
+		err      error
+		value    interface{}
+		expected string
+	}{
+		{ErrColumnNotFound, nil, FailureNotFound},
+		{errors.New("nil target"), nil, FailureNotFound},
+		{errors.New("Failed to parse sda as a Int"), "sda", FailureTypeMismatch},
+		{rangeErr, json.Number("99999999999999999999"), FailureOutOfRange},
+		{ErrOutOfRange{"too big"}, json.Number("70000"), FailureOutOfRange},
+		{ErrVarcharTooLong, "hello world", FailureOutOfRange},
+		{lookup.ErrExtractingValue, "kai", FailureLookup},
+		{ErrFetchFailure, "kai", FailureLookup},
+	} {
+		assert.Equal(t, c.expected, columnFailureClass(c.err, c.value), c.err.Error())
+	}
+}
+
+func TestFailureReport(t *testing.T) {
+	r := NewFailureReport(time.Hour)
+	r.Record("play", "quality", FailureTypeMismatch, genError("720p", "Int"), "720p")
+	r.Record("play", "quality", FailureTypeMismatch, genError("1080p", "Int"), "1080p")
+	r.Record("play", "channel", FailureNotFound, ErrColumnNotFound, nil)
+	r.Record("chat", "quality", FailureOutOfRange, ErrVarcharTooLong, "hello")
+	r.Record("login", "email", FailureTypeMismatch, ErrSensitiveValue{"Failed to parse a bool as a HMAC"}, true)
+
+	failures := r.Query("play", "")
+	require.Len(t, failures, 2)
+	assert.Equal(t, "channel", failures[0].Column)
+	assert.Equal(t, []string{"null"}, failures[0].Samples)
+	assert.Equal(t, "quality", failures[1].Column)
+	assert.EqualValues(t, 2, failures[1].Count)
+	assert.Equal(t, "Failed to parse a string as a Int", failures[1].LastError, "errors should be redacted")
+	assert.Equal(t, []string{"string of 4 characters"}, failures[1].Samples, "samples should be rate limited and redacted")
+
+	assert.Len(t, r.Query("", "quality"), 2)
+	assert.Len(t, r.Query("", ""), 4)
+	assert.Empty(t, r.Query("login", "")[0].Samples, "sensitive values should not be sampled")
+	assert.Equal(t, "Failed to parse a bool as a HMAC", r.Query("login", "")[0].LastError)
+	assert.Empty(t, r.Query("signup", ""))
+
+	r = NewFailureReport(0)
+	for i := 0; i < 2*maxFailureSamples; i++ {
+		r.Record("play", "quality", FailureTypeMismatch, nil, strconv.Itoa(i))
+	}
+	assert.Len(t, r.Query("play", "quality")[0].Samples, maxFailureSamples)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/column_failures?event=play", nil))
+	var served []ColumnFailures
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &served))
+	require.Len(t, served, 1)
+	assert.EqualValues(t, 2*maxFailureSamples, served[0].Count)
+}
+
+func TestFailureReportRawValues(t *testing.T) {
+	r := NewFailureReport(time.Hour)
+	r.Configure(FailureReportConfig{RawValues: true})
+	r.Record("play", "quality", FailureTypeMismatch, genError("720p", "Int"), "720p")
+	r.Record("play", "quality", FailureTypeMismatch, genError("1080p", "Int"), "1080p")
+	failures := r.Query("play", "quality")
+	require.Len(t, failures, 1)
+	assert.Equal(t, "Failed to parse 720p as a Int", failures[0].LastError, "raw errors should be rate limited")
+	assert.Equal(t, []string{`"720p"`}, failures[0].Samples)
+
+	r = NewFailureReport(0)
+	r.Configure(FailureReportConfig{RawValues: true})
+	for i := 0; i < 2*maxFailureSamples; i++ {
+		r.Record("play", "quality", FailureTypeMismatch, nil, strconv.Itoa(i))
+	}
+	assert.Equal(t, []string{`"5"`, `"6"`, `"7"`, `"8"`, `"9"`}, r.Query("play", "quality")[0].Samples)
+}
+
+func TestRedaction(t *testing.T) {
+	_, numErr := strconv.ParseInt("10.0.0.1", 10, 64)
+	_, timeErr := time.Parse("2006-01-02", "kai.hayashi")
+	for _, c := range []struct {
+		err      error
+		expected string
+	}{
+		{genError("10.0.0.1", "Time: unix"), "Failed to parse a string as a Time: unix"},
+		{numErr, "strconv.ParseInt: invalid syntax"},
+		{timeErr, `parsing time as "2006-01-02"`},
+		{ErrOutOfRange{`parsing "70000": value out of range (bits: 16)`}, "value out of range"},
+		{ErrBadURL, ErrBadURL.Error()},
+		{errors.New("exploding items: expected an array, got kai.hayashi"), "error redacted"},
+	} {
+		assert.Equal(t, c.expected, redactError(c.err))
+	}
+	for value, expected := range map[interface{}]string{
+		"日本":                "string of 2 characters",
+		json.Number("-1.5"): "number of 4 characters",
+		false:               "bool",
+	} {
+		assert.Equal(t, expected, describeValue(value))
+	}
+	assert.Equal(t, "object of 1 properties", describeValue(map[string]interface{}{"a": 1}))
+	assert.Equal(t, "array of 2 items", describeValue([]interface{}{1, 2}))
+}
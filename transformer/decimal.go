@@ -0,0 +1,167 @@
+package transformer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Redshift's defaults and limits for decimal columns.
+	defaultDecimalPrecision = 18
+	maxDecimalPrecision     = 38
+	// maxDecimalExponent bounds the exponents accepted, so values can't make huge strings.
+	maxDecimalExponent = 100
+)
+
+// genDecimalFormat returns the transformer for a decimal column with the given creation
+// options, such as "(12,2)", or nil if the options don't describe a valid precision and scale.
+// Values are parsed and rounded (half away from zero) as strings, so they're exact.
+func genDecimalFormat(options string) ColumnTransformer {
+	precision, scale, err := parseDecimalOptions(options)
+	if err != nil {
+		return nil
+	}
+	name := fmt.Sprintf("Decimal(%d,%d)", precision, scale)
+	return func(args []interface{}) (string, error) {
+		var str string
+		switch v := args[0].(type) {
+		case json.Number:
+			str = string(v)
+		case string:
+			str = strings.TrimSpace(v)
+		default:
+			return "", genError(args[0], name)
+		}
+		d, ok := formatDecimal(str, precision, scale)
+		if !ok {
+			return "", genError(args[0], name)
+		}
+		return d, nil
+	}
+}
+
+// parseDecimalOptions returns the precision and scale in column creation options like
+// "(12,2)" or "(12)", or Redshift's defaults if there are none.
+func parseDecimalOptions(options string) (int, int, error) {
+	options = strings.TrimSpace(options)
+	if !strings.HasPrefix(options, "(") {
+		return defaultDecimalPrecision, 0, nil
+	}
+	end := strings.IndexByte(options, ')')
+	if end < 0 {
+		return 0, 0, fmt.Errorf("unterminated decimal options %q", options)
+	}
+	parts := strings.Split(options[1:end], ",")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("bad decimal options %q", options)
+	}
+	precision, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("parsing decimal precision: %v", err)
+	}
+	scale := 0
+	if len(parts) == 2 {
+		if scale, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil {
+			return 0, 0, fmt.Errorf("parsing decimal scale: %v", err)
+		}
+	}
+	if precision < 1 || precision > maxDecimalPrecision || scale < 0 || scale > precision {
+		return 0, 0, fmt.Errorf("bad decimal precision and scale %q", options)
+	}
+	return precision, scale, nil
+}
+
+// formatDecimal rounds a decimal number, optionally with an exponent, to the scale. It returns
+// false if it isn't a number or has more integer digits than the precision allows.
+func formatDecimal(str string, precision, scale int) (string, bool) {
+	negative := false
+	if str != "" && (str[0] == '-' || str[0] == '+') {
+		negative = str[0] == '-'
+		str = str[1:]
+	}
+	exponent := 0
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		if exponent, err = strconv.Atoi(str[i+1:]); err != nil ||
+			exponent > maxDecimalExponent || exponent < -maxDecimalExponent {
+			return "", false
+		}
+		str = str[:i]
+	}
+	intPart, fracPart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+	}
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return "", false
+	}
+
+	// Move the decimal point by the exponent.
+	digits := intPart + fracPart
+	point := len(intPart) + exponent
+	if point < 0 {
+		digits = strings.Repeat("0", -point) + digits
+		point = 0
+	}
+	if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	intPart, fracPart = digits[:point], digits[point:]
+
+	roundUp := false
+	if len(fracPart) > scale {
+		roundUp = fracPart[scale] >= '5'
+		fracPart = fracPart[:scale]
+	} else {
+		fracPart += strings.Repeat("0", scale-len(fracPart))
+	}
+	digits = intPart + fracPart
+	if roundUp {
+		digits = incrementDigits(digits)
+	}
+	intPart, fracPart = digits[:len(digits)-scale], digits[len(digits)-scale:]
+
+	intPart = strings.TrimLeft(intPart, "0")
+	if len(intPart) > precision-scale {
+		return "", false
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	if strings.Trim(intPart+fracPart, "0") == "" {
+		negative = false
+	}
+
+	formatted := intPart
+	if scale > 0 {
+		formatted += "." + fracPart
+	}
+	if negative {
+		formatted = "-" + formatted
+	}
+	return formatted, true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// incrementDigits adds one to a string of decimal digits.
+func incrementDigits(digits string) string {
+	b := []byte(digits)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
@@ -0,0 +1,329 @@
+package transformer
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Derived columns compute their value from one or more properties: the InboundName followed
+// by the SupportingColumns. Their transformers are named d@<function>, followed by the
+// function's parameters separated by @, e.g. d@concat@- or d@date_trunc@hour@UTC. Unlike
+// other columns, a derived column's missing properties are passed to it as nil.
+
+// DerivedPrefix starts the name of every derived transformer.
+const DerivedPrefix = "d@"
+
+// derivedTransformGeneratorMap holds generators which take the function's parameters and
+// number of arguments, returning nil if either doesn't suit the function.
+var derivedTransformGeneratorMap = map[string]func(params []string, nargs int) ColumnTransformer{
+	"coalesce":   genCoalesce,
+	"default":    genDefault,
+	"concat":     genConcat,
+	"lower":      genCaseFormat(strings.ToLower),
+	"upper":      genCaseFormat(strings.ToUpper),
+	"substr":     genSubstr,
+	"date_trunc": genDateTrunc,
+	"add":        genArithmetic(func(a, b int64) int64 { return a + b }, func(a, b float64) float64 { return a + b }),
+	"sub":        genArithmetic(func(a, b int64) int64 { return a - b }, func(a, b float64) float64 { return a - b }),
+	"mul":        genArithmetic(func(a, b int64) int64 { return a * b }, func(a, b float64) float64 { return a * b }),
+	"div":        genDivide,
+}
+
+// ErrDivideByZero is when a derived column divides by zero.
+var ErrDivideByZero = errors.New("divide by zero")
+
+// GetDerivedTransform returns the derived transformer for a given identifier string, taking
+// nargs properties, or nil if there's no such function or it can't take that many.
+func GetDerivedTransform(tType string, nargs int) ColumnTransformer {
+	if !strings.HasPrefix(tType, DerivedPrefix) {
+		return nil
+	}
+	params := strings.Split(strings.TrimPrefix(tType, DerivedPrefix), "@")
+	generator, ok := derivedTransformGeneratorMap[params[0]]
+	if !ok {
+		return nil
+	}
+	t := generator(params[1:], nargs)
+	if t == nil {
+		return nil
+	}
+	return safeColumnTransformer(t, nargs)
+}
+
+// derivedString returns a property as a string, and false if it's missing or empty. Nested
+// objects and arrays are returned as JSON.
+func derivedString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, v != ""
+	case json.Number:
+		return string(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		str, err := jsonFormat([]interface{}{v})
+		return str, err == nil
+	}
+}
+
+// genCoalesce returns the first of its properties which is present and not empty.
+func genCoalesce(params []string, nargs int) ColumnTransformer {
+	if len(params) != 0 {
+		return nil
+	}
+	return func(args []interface{}) (string, error) {
+		for _, arg := range args {
+			if str, ok := derivedString(arg); ok {
+				return str, nil
+			}
+		}
+		return "", ErrColumnNotFound
+	}
+}
+
+// genDefault is genCoalesce with a literal value, its parameter, if every property is missing.
+func genDefault(params []string, nargs int) ColumnTransformer {
+	if len(params) != 1 {
+		return nil
+	}
+	coalesce := genCoalesce(nil, nargs)
+	return func(args []interface{}) (string, error) {
+		if str, err := coalesce(args); err == nil {
+			return str, nil
+		}
+		return params[0], nil
+	}
+}
+
+// genConcat joins the properties which are present, with an optional separator parameter.
+func genConcat(params []string, nargs int) ColumnTransformer {
+	if len(params) > 1 {
+		return nil
+	}
+	separator := ""
+	if len(params) == 1 {
+		separator = params[0]
+	}
+	return func(args []interface{}) (string, error) {
+		parts := make([]string, 0, len(args))
+		for _, arg := range args {
+			if str, ok := derivedString(arg); ok {
+				parts = append(parts, str)
+			}
+		}
+		if len(parts) == 0 {
+			return "", ErrColumnNotFound
+		}
+		return strings.Join(parts, separator), nil
+	}
+}
+
+func genCaseFormat(convert func(string) string) func([]string, int) ColumnTransformer {
+	return func(params []string, nargs int) ColumnTransformer {
+		if len(params) != 0 || nargs != 1 {
+			return nil
+		}
+		return func(args []interface{}) (string, error) {
+			str, ok := derivedString(args[0])
+			if !ok {
+				return "", ErrColumnNotFound
+			}
+			return convert(str), nil
+		}
+	}
+}
+
+// genSubstr takes the characters from a start position, counting from 1 as SQL does, with an
+// optional length: d@substr@1@10 is the first ten characters.
+func genSubstr(params []string, nargs int) ColumnTransformer {
+	if len(params) < 1 || len(params) > 2 || nargs != 1 {
+		return nil
+	}
+	start, err := strconv.Atoi(params[0])
+	if err != nil || start < 1 {
+		return nil
+	}
+	length := -1
+	if len(params) == 2 {
+		if length, err = strconv.Atoi(params[1]); err != nil || length < 0 {
+			return nil
+		}
+	}
+	return func(args []interface{}) (string, error) {
+		str, ok := derivedString(args[0])
+		if !ok {
+			return "", ErrColumnNotFound
+		}
+		// Skip to the start and take the length in characters, not bytes.
+		i := 0
+		for n := 1; n < start && i < len(str); n++ {
+			_, size := utf8.DecodeRuneInString(str[i:])
+			i += size
+		}
+		str = str[i:]
+		if length >= 0 {
+			end := 0
+			for n := 0; n < length && end < len(str); n++ {
+				_, size := utf8.DecodeRuneInString(str[end:])
+				end += size
+			}
+			str = str[:end]
+		}
+		return str, nil
+	}
+}
+
+// genDateTrunc truncates a timestamp, in unix seconds or ISO 8601, to the start of its minute,
+// hour, day, week (from Monday), month or year in the timezone, PST unless one is given as
+// the second parameter.
+func genDateTrunc(params []string, nargs int) ColumnTransformer {
+	if len(params) < 1 || len(params) > 2 || nargs != 1 {
+		return nil
+	}
+	timezone := PST
+	if len(params) == 2 {
+		loc, err := time.LoadLocation(params[1])
+		if err != nil || params[1] == "" || params[1] == "Local" {
+			return nil
+		}
+		timezone = loc
+	}
+	unit := params[0]
+	switch unit {
+	case "minute", "hour", "day", "week", "month", "year":
+	default:
+		return nil
+	}
+	unix := genUnixTimeFormat(timezone)
+	iso8601 := genISO8601TimeFormat(timezone)
+	return func(args []interface{}) (string, error) {
+		var str string
+		var err error
+		switch args[0].(type) {
+		case nil:
+			return "", ErrColumnNotFound
+		case json.Number:
+			str, err = unix(args)
+		default:
+			str, err = iso8601(args)
+		}
+		if err != nil {
+			return "", err
+		}
+		t, err := time.ParseInLocation(RedshiftDatetimeIngestString, str, timezone)
+		if err != nil {
+			return "", err
+		}
+		year, month, day := t.Date()
+		switch unit {
+		case "minute":
+			t = time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, timezone)
+		case "hour":
+			t = time.Date(year, month, day, t.Hour(), 0, 0, 0, timezone)
+		case "day":
+			t = time.Date(year, month, day, 0, 0, 0, 0, timezone)
+		case "week":
+			t = time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, timezone)
+		case "month":
+			t = time.Date(year, month, 1, 0, 0, 0, 0, timezone)
+		case "year":
+			t = time.Date(year, time.January, 1, 0, 0, 0, 0, timezone)
+		}
+		return t.Format(RedshiftDatetimeIngestString), nil
+	}
+}
+
+// derivedNumbers returns the properties as numbers, as integers if they all are. Numeric
+// strings are accepted, as they are by int and float columns.
+func derivedNumbers(args []interface{}) ([]int64, []float64, error) {
+	ints := make([]int64, 0, len(args))
+	floats := make([]float64, len(args))
+	for i, arg := range args {
+		var str string
+		switch v := arg.(type) {
+		case nil:
+			return nil, nil, ErrColumnNotFound
+		case json.Number:
+			str = string(v)
+		case string:
+			str = strings.TrimSpace(v)
+		default:
+			return nil, nil, genError(arg, "Number")
+		}
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, nil, genError(arg, "Number")
+		}
+		floats[i] = f
+		if n, err := strconv.ParseInt(str, 10, 64); err == nil && ints != nil {
+			ints = append(ints, n)
+		} else {
+			ints = nil
+		}
+	}
+	return ints, floats, nil
+}
+
+func formatDerivedFloat(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", genError(f, "Number")
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64), nil
+}
+
+// genArithmetic folds its two or more properties with the operation, as integers if they all
+// are and as floats otherwise.
+func genArithmetic(intOp func(a, b int64) int64, floatOp func(a, b float64) float64) func([]string, int) ColumnTransformer {
+	return func(params []string, nargs int) ColumnTransformer {
+		if len(params) != 0 || nargs < 2 {
+			return nil
+		}
+		return func(args []interface{}) (string, error) {
+			ints, floats, err := derivedNumbers(args)
+			if err != nil {
+				return "", err
+			}
+			result := floats[0]
+			for _, f := range floats[1:] {
+				result = floatOp(result, f)
+			}
+			// The float result tells us whether the integer one overflowed.
+			if ints != nil && math.Abs(result) < math.MaxInt64 {
+				intResult := ints[0]
+				for _, n := range ints[1:] {
+					intResult = intOp(intResult, n)
+				}
+				return strconv.FormatInt(intResult, 10), nil
+			}
+			return formatDerivedFloat(result)
+		}
+	}
+}
+
+// genDivide divides its first property by the others, always as floats.
+func genDivide(params []string, nargs int) ColumnTransformer {
+	if len(params) != 0 || nargs < 2 {
+		return nil
+	}
+	return func(args []interface{}) (string, error) {
+		_, floats, err := derivedNumbers(args)
+		if err != nil {
+			return "", err
+		}
+		result := floats[0]
+		for _, f := range floats[1:] {
+			if f == 0 {
+				return "", ErrDivideByZero
+			}
+			result /= f
+		}
+		return formatDerivedFloat(result)
+	}
+}
@@ -0,0 +1,109 @@
+package transformer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func derivedRunner(t *testing.T, tType string, args []interface{}, expected string, shouldFail bool) {
+	transform := GetDerivedTransform(tType, len(args))
+	require.NotNil(t, transform, tType)
+	actual, err := transform(args)
+	if shouldFail {
+		assert.Error(t, err, tType)
+	} else {
+		assert.NoError(t, err, tType)
+		assert.Equal(t, expected, actual, tType)
+	}
+}
+
+func TestDerivedStrings(t *testing.T) {
+	derivedRunner(t, "d@coalesce", []interface{}{nil, "", "b", "c"}, "b", false)
+	derivedRunner(t, "d@coalesce", []interface{}{json.Number("4"), "b"}, "4", false)
+	derivedRunner(t, "d@coalesce", []interface{}{nil, ""}, "", true)
+
+	derivedRunner(t, "d@default@unknown", []interface{}{nil}, "unknown", false)
+	derivedRunner(t, "d@default@unknown", []interface{}{""}, "unknown", false)
+	derivedRunner(t, "d@default@unknown", []interface{}{nil, "b"}, "b", false)
+	derivedRunner(t, "d@default@unknown", []interface{}{true}, "true", false)
+
+	derivedRunner(t, "d@concat", []interface{}{"a", json.Number("1")}, "a1", false)
+	derivedRunner(t, "d@concat@-", []interface{}{"a", nil, "c"}, "a-c", false)
+	derivedRunner(t, "d@concat@:", []interface{}{nil, nil}, "", true)
+
+	derivedRunner(t, "d@lower", []interface{}{"MiXeD"}, "mixed", false)
+	derivedRunner(t, "d@upper", []interface{}{"MiXeD"}, "MIXED", false)
+	derivedRunner(t, "d@upper", []interface{}{nil}, "", true)
+
+	derivedRunner(t, "d@substr@1@3", []interface{}{"abcdef"}, "abc", false)
+	derivedRunner(t, "d@substr@3", []interface{}{"abcdef"}, "cdef", false)
+	derivedRunner(t, "d@substr@2@2", []interface{}{"日本語です"}, "本語", false)
+	derivedRunner(t, "d@substr@10@2", []interface{}{"abc"}, "", false)
+}
+
+func TestDerivedDateTrunc(t *testing.T) {
+	// 2013-10-17 11:05:55.045 PST, a Thursday.
+	when := json.Number("1382033155.045")
+	derivedRunner(t, "d@date_trunc@minute", []interface{}{when}, "2013-10-17 11:05:00", false)
+	derivedRunner(t, "d@date_trunc@hour", []interface{}{when}, "2013-10-17 11:00:00", false)
+	derivedRunner(t, "d@date_trunc@day", []interface{}{when}, "2013-10-17 00:00:00", false)
+	derivedRunner(t, "d@date_trunc@week", []interface{}{when}, "2013-10-14 00:00:00", false)
+	derivedRunner(t, "d@date_trunc@month", []interface{}{when}, "2013-10-01 00:00:00", false)
+	derivedRunner(t, "d@date_trunc@year", []interface{}{when}, "2013-01-01 00:00:00", false)
+	derivedRunner(t, "d@date_trunc@day@UTC", []interface{}{when}, "2013-10-17 00:00:00", false)
+	derivedRunner(t, "d@date_trunc@hour@UTC", []interface{}{"2013-10-17T11:05:55-07:00"}, "2013-10-17 18:00:00", false)
+	derivedRunner(t, "d@date_trunc@week", []interface{}{"2013-10-14"}, "2013-10-14 00:00:00", false)
+
+	derivedRunner(t, "d@date_trunc@hour", []interface{}{nil}, "", true)
+	derivedRunner(t, "d@date_trunc@hour", []interface{}{"yesterday"}, "", true)
+}
+
+func TestDerivedArithmetic(t *testing.T) {
+	derivedRunner(t, "d@add", []interface{}{json.Number("2"), json.Number("3"), "4"}, "9", false)
+	derivedRunner(t, "d@add", []interface{}{json.Number("2"), json.Number("0.5")}, "2.5", false)
+	derivedRunner(t, "d@sub", []interface{}{json.Number("2"), json.Number("3")}, "-1", false)
+	derivedRunner(t, "d@mul", []interface{}{json.Number("4"), json.Number("2.5")}, "10", false)
+	derivedRunner(t, "d@mul", []interface{}{json.Number("9223372036854775807"), json.Number("2")}, "18446744073709552000", false)
+	derivedRunner(t, "d@div", []interface{}{json.Number("7"), json.Number("2")}, "3.5", false)
+
+	derivedRunner(t, "d@div", []interface{}{json.Number("7"), json.Number("0")}, "", true)
+	derivedRunner(t, "d@add", []interface{}{json.Number("2"), nil}, "", true)
+	derivedRunner(t, "d@add", []interface{}{json.Number("2"), "two"}, "", true)
+	derivedRunner(t, "d@add", []interface{}{json.Number("2"), true}, "", true)
+}
+
+func TestBadDerivedTransforms(t *testing.T) {
+	for tType, nargs := range map[string]int{
+		"d@nope":                           1,
+		"coalesce":                         1,
+		"d@coalesce@x":                     2,
+		"d@default":                        1,
+		"d@concat@-@-":                     2,
+		"d@lower":                          2,
+		"d@substr":                         1,
+		"d@substr@0":                       1,
+		"d@substr@1@x":                     1,
+		"d@date_trunc@decade":              1,
+		"d@date_trunc@day@Nowhere/Special": 1,
+		"d@add":                            1,
+		"d@div":                            1,
+	} {
+		assert.Nil(t, GetDerivedTransform(tType, nargs), tType)
+	}
+}
+
+func TestDerivedColumnMissingProperties(t *testing.T) {
+	properties := map[string]interface{}{"name": "Kappa"}
+	withDefault := RedshiftType{GetDerivedTransform("d@default@none", 1), "missing", "out", []string{}}
+	_, value, err := withDefault.Format(properties)
+	assert.NoError(t, err)
+	assert.Equal(t, "none", value)
+
+	// Columns without supporting columns still need their property.
+	varchar := RedshiftType{GetSingleValueTransform("varchar", nil), "missing", "out", nil}
+	_, _, err = varchar.Format(properties)
+	assert.Equal(t, ErrColumnNotFound, err)
+}
@@ -0,0 +1,100 @@
+package transformer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/twitchscience/scoop_protocol/scoop_protocol"
+	"github.com/twitchscience/spade/parser"
+)
+
+// ExplodeIndexProperty is the property holding the index of a row's element in the exploded
+// array, for a column to map.
+const ExplodeIndexProperty = "explode_index"
+
+// row is the properties of one row of an event, with the row's UUID.
+type row struct {
+	uuid       string
+	properties map[string]interface{}
+}
+
+// explode returns the rows of an event. The explode metadata of an event names an array
+// property (or a path to one) to explode: the event becomes one row per element of the array,
+// in which the property is the element rather than the array. Columns can then take
+// per-element values with paths such as items.id, while the other columns are repeated in
+// every row. The rows of exploded events have the event's UUID suffixed with their index, as
+// the events of multi-event edge requests do.
+//
+// Events without an array to explode, including those whose array is missing, null or empty,
+// have just the one row, so they aren't lost.
+func (t *RedshiftTransformer) explode(event *parser.MixpanelEvent, properties map[string]interface{}) ([]row, error) {
+	single := []row{{uuid: event.UUID, properties: properties}}
+	path := t.EventMetadataConfigs.GetMetadataValueByType(event.Event, string(scoop_protocol.EXPLODE))
+	if path == "" {
+		return single, nil
+	}
+
+	value, found := lookupProperty(properties, path)
+	if !found || value == nil {
+		t.stats.IncrBy(fmt.Sprintf("transformer.%s.explode.empty", event.Event), 1)
+		return single, nil
+	}
+	elements, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("exploding %s: expected an array, got %v", path, value)
+	}
+	if len(elements) == 0 {
+		t.stats.IncrBy(fmt.Sprintf("transformer.%s.explode.empty", event.Event), 1)
+		return single, nil
+	}
+
+	rows := make([]row, len(elements))
+	for i, element := range elements {
+		p, err := withProperty(properties, path, element)
+		if err != nil {
+			return nil, fmt.Errorf("exploding %s: %v", path, err)
+		}
+		p[ExplodeIndexProperty] = json.Number(strconv.Itoa(i))
+		rows[i] = row{uuid: fmt.Sprintf("%s-%d", event.UUID, i), properties: p}
+	}
+	t.stats.IncrBy(fmt.Sprintf("transformer.%s.explode.rows", event.Event), len(rows))
+	return rows, nil
+}
+
+// withProperty returns a copy of the properties with the named property replaced by value,
+// copying the objects along its path rather than changing them.
+func withProperty(properties map[string]interface{}, path string, value interface{}) (map[string]interface{}, error) {
+	copied := copyObject(properties)
+	if _, ok := properties[path]; ok {
+		copied[path] = value
+		return copied, nil
+	}
+	steps := propertyPath(path)
+	object := copied
+	for i, step := range steps {
+		if step.index >= 0 {
+			return nil, fmt.Errorf("array indexes aren't supported in exploded paths")
+		}
+		if i == len(steps)-1 {
+			object[step.key] = value
+			break
+		}
+		next, ok := object[step.key].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s isn't an object", step.key)
+		}
+		next = copyObject(next)
+		object[step.key] = next
+		object = next
+	}
+	return copied, nil
+}
+
+func copyObject(object map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(object)+1)
+	for k, v := range object {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,153 @@
+package transformer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/twitchscience/spade/lookup"
+	"github.com/twitchscience/spade/reporter"
+)
+
+// MappingSeparator separates a mapping transformer's ID from the field of its lookup which a
+// column takes, as in gameWithMapping@id.
+const MappingSeparator = "@"
+
+// MappingTransformerID returns the ID of a mapping column's transformer, which is what's
+// configured in TransformerFetchers.
+func MappingTransformerID(tType string) string {
+	return strings.SplitN(tType, MappingSeparator, 2)[0]
+}
+
+// genLookupTransformer returns the transformer of a mapping column without a transformer of
+// its own in mappingTransformMap. The fetch's arguments are named after the supporting
+// columns and take their values. If field is set, the column takes that field of the fetched
+// fields, otherwise the fetched string. Lookups are cached under keys namespaced by the
+// transformer's ID, so a lookup's fields are fetched once for all the columns taking them;
+// the fields are cached apart from the string, under the ID followed by MappingSeparator.
+func genLookupTransformer(id, field string, argNames []string, config MappingTransformerConfig) ColumnTransformer {
+	return safeColumnTransformer(func(args []interface{}) (string, error) {
+		// As with userIDWithMapping, a value sent by the client is kept.
+		if value, ok := lookupArg(args[0]); ok && value != "" {
+			return value, ErrIDSet
+		}
+
+		fetchArgs := make(map[string]string, len(argNames))
+		key := make(url.Values, len(argNames))
+		for i, name := range argNames {
+			value, ok := lookupArg(args[i+1])
+			if !ok {
+				return "", ErrBadLookupValue
+			}
+			if value = strings.TrimSpace(value); value == "" {
+				return "", ErrEmptyLookupValue
+			}
+			fetchArgs[name] = value
+			key.Set(name, value)
+		}
+
+		cacheKey := id + ":" + key.Encode()
+		fetch := func() (string, error) {
+			return config.Fetcher.FetchString(fetchArgs)
+		}
+		if field != "" {
+			cacheKey = id + MappingSeparator + ":" + key.Encode()
+			fetch = func() (string, error) {
+				fields, err := config.Fetcher.FetchFields(fetchArgs)
+				if err != nil {
+					return "", err
+				}
+				b, err := json.Marshal(fields)
+				if err != nil {
+					return "", err
+				}
+				return string(b), nil
+			}
+		}
+		value, status := cachedLookup(config, id, cacheKey, fetch)
+		if field == "" || value == "" {
+			return value, status
+		}
+		var fields map[string]string
+		if err := json.Unmarshal([]byte(value), &fields); err != nil {
+			return "", ErrBadLookupValue
+		}
+		if fieldValue, ok := fields[field]; ok {
+			return fieldValue, status
+		}
+		return "", lookup.ErrExtractingValue
+	}, len(argNames)+1)
+}
+
+// lookupArg returns a property as a lookup argument, if it's a string or number.
+func lookupArg(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return string(v), true
+	}
+	return "", false
+}
+
+// cachedLookup returns the value cached under the key, or fetches and caches it. The error
+// says where the value came from or why there isn't one; an empty value is cached for
+// arguments the fetcher can't find a value for, so they aren't fetched again.
+func cachedLookup(config MappingTransformerConfig, statName, key string, fetch func() (string, error)) (string, error) {
+	// Check the local cache.
+	cached, err := config.LocalCache.Get(key)
+	if err == nil {
+		recordCacheError(config.Stats, statName, nil, "local_get")
+		return cached, ErrLocalCacheHit
+	}
+
+	// Failed the local cache. Try the remote cache.
+	cached, err = config.RemoteCache.Get(key)
+	if err == nil {
+		recordCacheError(config.Stats, statName, nil, "remote_get")
+		_ = config.LocalCache.Set(key, cached)
+		return cached, ErrRemoteCacheHit
+	}
+
+	// We'll fetch at this point, remembering to save to cache before returning. One thing
+	// to notice is that we'll always return failures from setting the cache in conjunction
+	// with the fetched value, this way the client can identify failure to save to cache but
+	// still use the value and move forward.
+	fetched, err := fetch()
+	if err != nil {
+		if err == lookup.ErrExtractingValue {
+			// This kind of error is most likely caused by invalid arguments provided for
+			// fetching, so let's cache an empty value so we don't keep fetching in the future
+			_ = config.LocalCache.Set(key, "")
+			err = config.RemoteCache.Set(key, "")
+			recordCacheError(config.Stats, statName, err, "remote_set")
+		}
+		return "", ErrFetchFailure
+	}
+	_ = config.LocalCache.Set(key, fetched)
+	err = config.RemoteCache.Set(key, fetched)
+	recordCacheError(config.Stats, statName, err, "remote_set")
+	if err != nil {
+		return fetched, ErrCacheSetFailure
+	}
+	return fetched, ErrFetchSuccess
+}
+
+func recordCacheError(stats reporter.StatsLogger, statName string, err error, operation string) {
+	switch err {
+	case nil:
+		stats.IncrBy(fmt.Sprintf("transformer.%s.cache_error.%s.success", statName, operation), 1)
+	case memcache.ErrCacheMiss:
+		stats.IncrBy(fmt.Sprintf("transformer.%s.cache_error.%s.cache_miss", statName, operation), 1)
+	case memcache.ErrMalformedKey:
+		stats.IncrBy(fmt.Sprintf("transformer.%s.cache_error.%s.malformed_key", statName, operation), 1)
+	default:
+		if _, ok := err.(*memcache.ConnectTimeoutError); ok {
+			stats.IncrBy(fmt.Sprintf("transformer.%s.cache_error.%s.connect_timeout", statName, operation), 1)
+		} else {
+			stats.IncrBy(fmt.Sprintf("transformer.%s.cache_error.%s.other", statName, operation), 1)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package transformer
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/spade/lookup"
+)
+
+// gameFetcher fetches games by name, counting its fetches.
+type gameFetcher struct {
+	fetches int
+}
+
+var games = map[string]map[string]string{
+	"overwatch": {"id": "488552", "slug": "overwatch"},
+}
+
+func (f *gameFetcher) FetchInt64(args map[string]string) (int64, error) {
+	return 0, errors.New("Not expected to fetch an int")
+}
+
+func (f *gameFetcher) FetchString(args map[string]string) (string, error) {
+	fields, err := f.FetchFields(args)
+	if err != nil {
+		return "", err
+	}
+	return fields["id"], nil
+}
+
+func (f *gameFetcher) FetchFields(args map[string]string) (map[string]string, error) {
+	f.fetches++
+	if fields, ok := games[args["game"]]; ok {
+		return fields, nil
+	}
+	return nil, lookup.ErrExtractingValue
+}
+
+// mapCache is a StringCache in a map.
+type mapCache map[string]string
+
+func (c mapCache) Get(key string) (string, error) {
+	if value, ok := c[key]; ok {
+		return value, nil
+	}
+	return "", errors.New("cache miss")
+}
+
+func (c mapCache) Set(key string, value string) error {
+	c[key] = value
+	return nil
+}
+
+func TestGenericMappingTransform(t *testing.T) {
+	fetcher := &gameFetcher{}
+	local, remote := mapCache{}, mapCache{}
+	config := MappingTransformerConfig{fetcher, local, remote, &statsMock{}}
+
+	id := GetMappingTransform("gameIDWithMapping", config, []string{"game"})
+	require.NotNil(t, id)
+	for _, c := range []struct {
+		args     []interface{}
+		expected string
+		err      error
+	}{
+		{[]interface{}{json.Number("123"), "overwatch"}, "123", ErrIDSet},
+		{[]interface{}{nil, " overwatch "}, "488552", ErrFetchSuccess},
+		{[]interface{}{nil, "overwatch"}, "488552", ErrLocalCacheHit},
+		{[]interface{}{nil, "unknown"}, "", ErrFetchFailure},
+		{[]interface{}{nil, "unknown"}, "", ErrLocalCacheHit},
+		{[]interface{}{nil, json.Number("1")}, "", ErrFetchFailure},
+		{[]interface{}{nil, ""}, "", ErrEmptyLookupValue},
+		{[]interface{}{nil, nil}, "", ErrBadLookupValue},
+	} {
+		value, err := id(c.args)
+		assert.Equal(t, c.err, err, "%v", c.args)
+		assert.Equal(t, c.expected, value, "%v", c.args)
+	}
+	assert.Equal(t, "488552", remote["gameIDWithMapping:game=overwatch"])
+	assert.Equal(t, "", remote["gameIDWithMapping:game=unknown"])
+
+	fetcher.fetches = 0
+	slug := GetMappingTransform("gameWithMapping@slug", config, []string{"game"})
+	gameID := GetMappingTransform("gameWithMapping@id", config, []string{"game"})
+	missing := GetMappingTransform("gameWithMapping@box_art", config, []string{"game"})
+	value, err := slug([]interface{}{nil, "overwatch"})
+	assert.Equal(t, ErrFetchSuccess, err)
+	assert.Equal(t, "overwatch", value)
+	value, err = gameID([]interface{}{nil, "overwatch"})
+	assert.Equal(t, ErrLocalCacheHit, err)
+	assert.Equal(t, "488552", value)
+	_, err = missing([]interface{}{nil, "overwatch"})
+	assert.Equal(t, lookup.ErrExtractingValue, err)
+	assert.Equal(t, 1, fetcher.fetches, "fields should be fetched once for all columns")
+	assert.Contains(t, remote["gameWithMapping@:game=overwatch"], `"slug":"overwatch"`)
+	assert.NotContains(t, remote, "gameWithMapping:game=overwatch")
+
+	// Both forms of one transformer don't share cached values.
+	fetcher.fetches = 0
+	plain := GetMappingTransform("gameIDWithMapping", config, []string{"game"})
+	gameSlug := GetMappingTransform("gameIDWithMapping@slug", config, []string{"game"})
+	value, err = plain([]interface{}{nil, "overwatch"})
+	assert.Equal(t, ErrLocalCacheHit, err)
+	assert.Equal(t, "488552", value)
+	value, err = gameSlug([]interface{}{nil, "overwatch"})
+	assert.Equal(t, ErrFetchSuccess, err)
+	assert.Equal(t, "overwatch", value)
+	value, err = plain([]interface{}{nil, "overwatch"})
+	assert.Equal(t, ErrLocalCacheHit, err)
+	assert.Equal(t, "488552", value)
+	assert.Equal(t, 1, fetcher.fetches)
+
+	assert.Nil(t, GetMappingTransform("gameWithMapping@", config, []string{"game"}))
+	assert.Nil(t, GetMappingTransform("userIDWithMapping@id", config, []string{"login"}))
+}
@@ -0,0 +1,96 @@
+package transformer
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// An InboundName or supporting column may be a path into the event's properties, such as
+// player.quality or items[0].id, so that nested objects and arrays can be mapped to columns
+// without flattening them in every client. A property whose name is exactly the path still
+// takes precedence, so existing schemas with dots in their property names keep working.
+
+// pathStep is one step of a property path: an object key, or an array index if index >= 0.
+type pathStep struct {
+	key   string
+	index int
+}
+
+// parsedPaths caches the steps of each name looked up, or nil if the name isn't a path.
+var parsedPaths sync.Map
+
+// lookupProperty returns the property with the given name or path.
+func lookupProperty(properties map[string]interface{}, name string) (interface{}, bool) {
+	if value, ok := properties[name]; ok {
+		return value, true
+	}
+	steps := propertyPath(name)
+	if steps == nil {
+		return nil, false
+	}
+	var value interface{} = properties
+	for _, step := range steps {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if step.index >= 0 {
+				return nil, false
+			}
+			next, found := v[step.key]
+			if !found {
+				return nil, false
+			}
+			value = next
+		case []interface{}:
+			if step.index < 0 || step.index >= len(v) {
+				return nil, false
+			}
+			value = v[step.index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// propertyPath returns the steps of the path, parsing it the first time it's seen.
+func propertyPath(name string) []pathStep {
+	if steps, ok := parsedPaths.Load(name); ok {
+		return steps.([]pathStep)
+	}
+	steps := parsePath(name)
+	parsedPaths.Store(name, steps)
+	return steps
+}
+
+// parsePath splits a path into its steps. It returns nil if the name has no nesting or isn't
+// a well formed path.
+func parsePath(name string) []pathStep {
+	if !strings.ContainsAny(name, ".[") {
+		return nil
+	}
+	var steps []pathStep
+	for _, part := range strings.Split(name, ".") {
+		bracket := strings.IndexByte(part, '[')
+		if bracket < 0 {
+			bracket = len(part)
+		}
+		if bracket == 0 {
+			return nil
+		}
+		steps = append(steps, pathStep{key: part[:bracket], index: -1})
+		for rest := part[bracket:]; rest != ""; {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 0 {
+				return nil
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil
+			}
+			steps = append(steps, pathStep{index: index})
+			rest = rest[end+1:]
+		}
+	}
+	return steps
+}
@@ -0,0 +1,76 @@
+package transformer
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeProperties(t *testing.T, properties string) map[string]interface{} {
+	var decoded map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(properties)))
+	d.UseNumber()
+	require.Nil(t, d.Decode(&decoded))
+	return decoded
+}
+
+func TestLookupProperty(t *testing.T) {
+	properties := decodeProperties(t, `{
+		"channel": "lirik",
+		"player": {"quality": "720p", "size": {"width": 1280}},
+		"items": [{"id": 7}, {"id": 8}],
+		"matrix": [[1, 2], [3, 4]],
+		"flat.name": "flat"
+	}`)
+	for _, tt := range []struct {
+		name     string
+		expected interface{}
+		found    bool
+	}{
+		{"channel", "lirik", true},
+		{"player.quality", "720p", true},
+		{"player.size.width", json.Number("1280"), true},
+		{"items[1].id", json.Number("8"), true},
+		{"matrix[1][0]", json.Number("3"), true},
+		{"flat.name", "flat", true},
+		{"player.missing", nil, false},
+		{"items[2].id", nil, false},
+		{"items.id", nil, false},
+		{"player[0]", nil, false},
+		{"channel.length", nil, false},
+		{"items[x]", nil, false},
+		{"items[0", nil, false},
+		{"player..quality", nil, false},
+	} {
+		value, found := lookupProperty(properties, tt.name)
+		assert.Equal(t, tt.found, found, tt.name)
+		assert.Equal(t, tt.expected, value, tt.name)
+	}
+}
+
+func TestFormatNestedColumns(t *testing.T) {
+	properties := decodeProperties(t, `{"player": {"quality": "720p"}, "ids": ["a", "b"]}`)
+
+	name, value, err := (&RedshiftType{varcharFormat, "player.quality", "quality", nil}).Format(properties)
+	require.Nil(t, err)
+	assert.Equal(t, "quality", name)
+	assert.Equal(t, "720p", value)
+
+	_, _, err = (&RedshiftType{varcharFormat, "player.codec", "codec", nil}).Format(properties)
+	assert.Equal(t, ErrColumnNotFound, err)
+
+	supported := &RedshiftType{
+		Transformer: func(args []interface{}) (string, error) {
+			return args[0].(string) + args[1].(string), nil
+		},
+		InboundName:       "ids[0]",
+		OutboundName:      "both",
+		SupportingColumns: []string{"ids[1]"},
+	}
+	_, value, err = supported.Format(properties)
+	require.Nil(t, err)
+	assert.Equal(t, "ab", value)
+}
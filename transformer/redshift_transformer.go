@@ -16,33 +16,74 @@ import (
 	"github.com/twitchscience/spade/lookup"
 )
 
+// Policies for strings longer than their varchar column.
+const (
+	// VarcharTruncate truncates the string to the column's length, on a UTF-8 boundary.
+	VarcharTruncate = "truncate"
+	// VarcharSkip leaves the column empty, as for any other value which doesn't fit.
+	VarcharSkip = "skip"
+)
+
+// Config configures a RedshiftTransformer.
+type Config struct {
+	// VarcharOverflow is VarcharTruncate (the default) or VarcharSkip
+	VarcharOverflow string
+	// UnmappedSampleRate is the fraction, 0.01 if unset, of events with unmapped properties
+	// whose property names are counted and which are written to the nontracked output
+	UnmappedSampleRate float64
+}
+
+// Validate checks the config's policies are known.
+func (c *Config) Validate() error {
+	if c.UnmappedSampleRate < 0 || c.UnmappedSampleRate > 1 {
+		return fmt.Errorf("unmapped sample rate %v is not between 0 and 1", c.UnmappedSampleRate)
+	}
+	switch c.VarcharOverflow {
+	case "", VarcharTruncate, VarcharSkip:
+		return nil
+	}
+	return fmt.Errorf("unknown varchar overflow policy %q, expected %s or %s",
+		c.VarcharOverflow, VarcharTruncate, VarcharSkip)
+}
+
 // RedshiftTransformer turns MixpanelEvents into WriteRequests using the given SchemaConfigLoader.
 type RedshiftTransformer struct {
 	Configs              SchemaConfigLoader
 	EventMetadataConfigs EventMetadataConfigLoader
+	config               Config
 	stats                reporter.StatsLogger
+	failures             *FailureReport
 }
 
+// nontrackedEvent is a line of a nontracked file. The UUID and the time the edge received the
+// event let it be re-driven as the same event.
 type nontrackedEvent struct {
 	Event      string          `json:"event"`
 	Properties json.RawMessage `json:"properties"`
+	UUID       string          `json:"uuid,omitempty"`
+	Time       json.Number     `json:"time,omitempty"`
 }
 
 // NewRedshiftTransformer creates a new RedshiftTransformer using the given SchemaConfigLoader and EventMetadataConfigLoader
-func NewRedshiftTransformer(configs SchemaConfigLoader, eventMetadataConfigs EventMetadataConfigLoader, stats reporter.StatsLogger) Transformer {
+func NewRedshiftTransformer(configs SchemaConfigLoader, eventMetadataConfigs EventMetadataConfigLoader, config Config, stats reporter.StatsLogger) Transformer {
 	return &RedshiftTransformer{
 		Configs:              configs,
 		EventMetadataConfigs: eventMetadataConfigs,
+		config:               config,
 		stats:                stats,
+		failures:             Failures,
 	}
 }
 
-// Consume transforms a MixpanelEvent into a WriteRequest.
-func (t *RedshiftTransformer) Consume(event *parser.MixpanelEvent) *writer.WriteRequest {
+// Consume transforms a MixpanelEvent into WriteRequests: usually one, but one per element of
+// the event's array property if its metadata says to explode it. A sample of events with
+// properties no column maps also get a request for the nontracked output, and if the event's
+// table is strict they fail instead of being written.
+func (t *RedshiftTransformer) Consume(event *parser.MixpanelEvent) []*writer.WriteRequest {
 	version := t.Configs.GetVersionForEvent(event.Event)
 
 	if event.Failure != reporter.None {
-		return &writer.WriteRequest{
+		return []*writer.WriteRequest{{
 			Category: event.Event,
 			Version:  version,
 			Line:     "",
@@ -50,30 +91,67 @@ func (t *RedshiftTransformer) Consume(event *parser.MixpanelEvent) *writer.Write
 			Source:   event.Properties,
 			Failure:  event.Failure,
 			Pstart:   event.Pstart,
-		}
+			Error:    event.Error,
+			Raw:      event.Original(),
+		}}
 	}
 
 	t1 := time.Now()
-	line, kv, err := t.transform(event)
-	t.stats.Timing(fmt.Sprintf("transformer.%s", event.Event), time.Since(t1)/time.Millisecond)
+	defer func() {
+		t.stats.Timing(fmt.Sprintf("transformer.%s", event.Event), time.Since(t1)/time.Millisecond)
+	}()
 
-	if err == nil {
-		return &writer.WriteRequest{
+	columns, properties, err := t.prepare(event)
+	if err != nil {
+		return []*writer.WriteRequest{t.errorRequest(event, version, err)}
+	}
+
+	var requests []*writer.WriteRequest
+	if unmapped := unmappedProperties(columns, properties); len(unmapped) > 0 {
+		if sample := t.reportUnmapped(event, version, unmapped); sample != nil {
+			requests = append(requests, sample)
+		}
+		// Strict tables fail events with properties that no column maps, rather than writing
+		// the columns which are mapped.
+		if t.EventMetadataConfigs.GetMetadataValueByType(event.Event, string(scoop_protocol.STRICT)) == "true" {
+			return append(requests, t.strictRequest(event, version, unmapped))
+		}
+	}
+
+	rows, err := t.explode(event, properties)
+	if err != nil {
+		return append(requests, t.errorRequest(event, version, err))
+	}
+
+	for _, r := range rows {
+		line, kv, skipped := t.transform(event.Event, columns, r.properties)
+		failure := reporter.None
+		if skipped { // Non critical error: a column was skipped
+			failure = reporter.SkippedColumn
+		}
+		requests = append(requests, &writer.WriteRequest{
 			Category: event.Event,
 			Version:  version,
 			Line:     line,
 			Record:   kv,
-			UUID:     event.UUID,
+			UUID:     r.uuid,
 			Source:   event.Properties,
-			Failure:  reporter.None,
+			Failure:  failure,
 			Pstart:   event.Pstart,
-		}
+		})
 	}
+	return requests
+}
+
+// errorRequest returns the WriteRequest for an event which couldn't be transformed.
+func (t *RedshiftTransformer) errorRequest(event *parser.MixpanelEvent, version int, err error) *writer.WriteRequest {
 	switch err.(type) {
 	case ErrNotTracked:
 		dump, err := json.Marshal(&nontrackedEvent{
 			Event:      event.Event,
 			Properties: event.Properties,
+			UUID:       event.UUID,
+			Time:       event.EventTime,
 		})
 		if err != nil {
 			dump = []byte("")
@@ -87,17 +165,6 @@ func (t *RedshiftTransformer) Consume(event *parser.MixpanelEvent) *writer.Write
 			Failure:  reporter.NonTrackingEvent,
 			Pstart:   event.Pstart,
 		}
-	case ErrSkippedColumn: // Non critical error
-		return &writer.WriteRequest{
-			Category: event.Event,
-			Version:  version,
-			Line:     line,
-			Record:   kv,
-			UUID:     event.UUID,
-			Source:   event.Properties,
-			Failure:  reporter.SkippedColumn,
-			Pstart:   event.Pstart,
-		}
 	default:
 		return &writer.WriteRequest{
 			Category: "Unknown",
@@ -107,24 +174,24 @@ func (t *RedshiftTransformer) Consume(event *parser.MixpanelEvent) *writer.Write
 			Source:   event.Properties,
 			Failure:  reporter.EmptyRequest,
 			Pstart:   event.Pstart,
+			Error:    err.Error(),
+			Raw:      event.Original(),
 		}
 	}
 }
 
-func (t *RedshiftTransformer) transform(event *parser.MixpanelEvent) (string, map[string]string, error) {
+// prepare returns the columns of the event's table and its decoded properties, with the
+// properties set by the edge added.
+func (t *RedshiftTransformer) prepare(event *parser.MixpanelEvent) ([]RedshiftType, map[string]interface{}, error) {
 	if event.Event == "" {
-		return "", nil, ErrEmptyRequest
+		return nil, nil, ErrEmptyRequest
 	}
 
-	var possibleError error
 	columns, err := t.Configs.GetColumnsForEvent(event.Event)
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
-	var tsvOutput bytes.Buffer
-	kvOutput := make(map[string]string)
-
 	// We can probably make this so that it never actually needs to decode the json
 	// If each table knew which byte sequences a column corresponds to we can
 	// dynamically build a state machine to scrape each column from the raw byte array
@@ -132,7 +199,7 @@ func (t *RedshiftTransformer) transform(event *parser.MixpanelEvent) (string, ma
 	decoder := json.NewDecoder(bytes.NewReader(event.Properties))
 	decoder.UseNumber()
 	if err = decoder.Decode(&temp); err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 
 	if event.EdgeType == spade.INTERNAL_EDGE || event.EdgeType == spade.EXTERNAL_EDGE {
@@ -156,10 +223,23 @@ func (t *RedshiftTransformer) transform(event *parser.MixpanelEvent) (string, ma
 		temp["ip"] = event.ClientIP
 	}
 
+	if _, ok := temp["socket_ip"]; !ok && event.SocketIP != "" {
+		temp["socket_ip"] = event.SocketIP
+	}
+
 	// Still allow clients to override the user agent.
 	if _, ok := temp["user_agent"]; !ok && event.UserAgent != "" {
 		temp["user_agent"] = event.UserAgent
 	}
+	return columns, temp, nil
+}
+
+// transform formats the columns of one row, returning whether any column was skipped. The
+// failures of skipped columns are counted by class and recorded in the FailureReport.
+func (t *RedshiftTransformer) transform(eventName string, columns []RedshiftType, temp map[string]interface{}) (string, map[string]string, bool) {
+	anySkipped := false
+	var tsvOutput bytes.Buffer
+	kvOutput := make(map[string]string)
 
 	results := make(map[string]int)
 	for n, column := range columns {
@@ -198,14 +278,25 @@ func (t *RedshiftTransformer) transform(event *parser.MixpanelEvent) (string, ma
 		case ErrCacheSetFailure:
 			results["success"]++
 			results["cache.set_failure"]++
+		case ErrVarcharTooLong:
+			t.stats.IncrBy(fmt.Sprintf("transformer.%s.varchar_overflow.%s", eventName, k), 1)
+			if t.config.VarcharOverflow == VarcharSkip {
+				skipped = true
+				v = ""
+			} else {
+				results["success"]++
+				results["varchar_truncated"]++
+			}
 		default:
 			skipped = true
 		}
 		if skipped {
 			results["skippedColumn"]++
-			possibleError = ErrSkippedColumn{
-				fmt.Sprintf("Problem parsing into %v: %v\n", column, err),
-			}
+			value, _ := lookupProperty(temp, column.InboundName)
+			class := columnFailureClass(err, value)
+			anySkipped = true
+			t.stats.IncrBy(fmt.Sprintf("transformer.%s.failed.%s.%s", eventName, column.OutboundName, class), 1)
+			t.failures.Record(eventName, column.OutboundName, class, err, value)
 		}
 		if n != 0 {
 			_, _ = tsvOutput.WriteRune('\t')
@@ -216,8 +307,8 @@ func (t *RedshiftTransformer) transform(event *parser.MixpanelEvent) (string, ma
 		}
 	}
 	for stat, count := range results {
-		t.stats.IncrBy(fmt.Sprintf("transformer.%s.%s", event.Event, stat), count)
+		t.stats.IncrBy(fmt.Sprintf("transformer.%s.%s", eventName, stat), count)
 	}
 
-	return tsvOutput.String(), kvOutput, possibleError
+	return tsvOutput.String(), kvOutput, anySkipped
 }
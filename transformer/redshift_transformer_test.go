@@ -2,6 +2,7 @@ package transformer
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cactus/go-statsd-client/statsd"
 
+	"github.com/twitchscience/scoop_protocol/scoop_protocol"
 	"github.com/twitchscience/scoop_protocol/spade"
 	"github.com/twitchscience/spade/lookup"
 	"github.com/twitchscience/spade/parser"
@@ -43,6 +45,14 @@ func (f *idFetcherMock) FetchInt64(args map[string]string) (int64, error) {
 	return 42, nil
 }
 
+func (f *idFetcherMock) FetchString(args map[string]string) (string, error) {
+	return "", errors.New("Not expected to fetch a string")
+}
+
+func (f *idFetcherMock) FetchFields(args map[string]string) (map[string]string, error) {
+	return nil, errors.New("Not expected to fetch fields")
+}
+
 // cacheMock implements a TransformerCache that always fails in Get and does nothing in Set.
 type cacheMock struct{}
 
@@ -54,6 +64,15 @@ func (c *cacheMock) Set(key string, value string) error {
 	return nil
 }
 
+type countingStats struct {
+	statsMock
+	counts map[string]int
+}
+
+func (s *countingStats) IncrBy(stat string, value int) {
+	s.counts[stat] += value
+}
+
 type statsMock struct{}
 
 func (s *statsMock) Timing(stat string, t time.Duration) {
@@ -141,10 +160,13 @@ func transformerRunner(t *testing.T, input *parser.MixpanelEvent, expected *writ
 		},
 	}
 	_stats, _ := statsd.NewNoop()
-	_transformer := NewRedshiftTransformer(config, eventMetadataConfig, reporter.WrapCactusStatter(_stats, 0.1))
-	if !reflect.DeepEqual(_transformer.Consume(input), expected) {
-		t.Logf("Got \n%v \nexpected \n%v\n", *_transformer.Consume(input), expected)
-		t.Logf("Transformer output: %#v", _transformer.Consume(input).Record)
+	_transformer := NewRedshiftTransformer(config, eventMetadataConfig, Config{}, reporter.WrapCactusStatter(_stats, 0.1))
+	requests := _transformer.Consume(input)
+	if len(requests) != 1 || !reflect.DeepEqual(requests[0], expected) {
+		t.Logf("Got \n%v \nexpected \n%v\n", requests, expected)
+		if len(requests) > 0 {
+			t.Logf("Transformer output: %#v", requests[0].Record)
+		}
 		t.Fail()
 	}
 }
@@ -172,6 +194,7 @@ func TestBadParseEventConsume(t *testing.T) {
 		Source:   badParseEvent.Properties,
 		Failure:  reporter.UnableToParseData,
 		Pstart:   badParseEvent.Pstart,
+		Raw:      badParseEvent.Original(),
 	})
 }
 
@@ -192,14 +215,14 @@ func TestTransformBadColumnEventConsume(t *testing.T) {
 		UUID:      "uuid1",
 	}
 	expected := writer.WriteRequest{
-		Category: "login",
-		Version:  42,
-		Line:     "\"\"\t\"0.1234\"\t\"kai.hayashi\"\t\"Test Browser\"\t\"2013-10-17 11:05:55\"\t\"42\"",
-		Record:   map[string]string{"fraction": "0.1234", "name": "kai.hayashi", "now": "2013-10-17 11:05:55", "id": "42", "user_agent": "Test Browser"},
-		Source:   transformErrorEvent.Properties,
-		Failure:  reporter.SkippedColumn,
-		Pstart:   now,
-		UUID:     "uuid1",
+		Category:      "login",
+		Version:       42,
+		Line:          "\"\"\t\"0.1234\"\t\"kai.hayashi\"\t\"Test Browser\"\t\"2013-10-17 11:05:55\"\t\"42\"",
+		Record:        map[string]string{"fraction": "0.1234", "name": "kai.hayashi", "now": "2013-10-17 11:05:55", "id": "42", "user_agent": "Test Browser"},
+		Source:        transformErrorEvent.Properties,
+		Failure:       reporter.SkippedColumn,
+		Pstart:        now,
+		UUID:          "uuid1",
 	}
 	transformerRunner(t, transformErrorEvent, &expected)
 }
@@ -226,6 +249,8 @@ func TestEmptyEventConsume(t *testing.T) {
 		Failure:  reporter.EmptyRequest,
 		Pstart:   emptyEvent.Pstart,
 		UUID:     "uuid1",
+		Error:    ErrEmptyRequest.Error(),
+		Raw:      emptyEvent.Original(),
 	})
 }
 
@@ -243,14 +268,14 @@ func TestMissingPropertyEventConsume(t *testing.T) {
 		UUID:    "uuid1",
 	}
 	expected := writer.WriteRequest{
-		Category: "login",
-		Version:  42,
-		Line:     "\"42\"\t\"0.1234\"\t\"kai.hayashi\"\t\"\"\t\"\"\t\"42\"",
-		Record:   map[string]string{"times": "42", "fraction": "0.1234", "name": "kai.hayashi", "id": "42"},
-		Source:   emptyEvent.Properties,
-		Failure:  reporter.SkippedColumn,
-		Pstart:   now,
-		UUID:     "uuid1",
+		Category:      "login",
+		Version:       42,
+		Line:          "\"42\"\t\"0.1234\"\t\"kai.hayashi\"\t\"\"\t\"\"\t\"42\"",
+		Record:        map[string]string{"times": "42", "fraction": "0.1234", "name": "kai.hayashi", "id": "42"},
+		Source:        emptyEvent.Properties,
+		Failure:       reporter.SkippedColumn,
+		Pstart:        now,
+		UUID:          "uuid1",
 	}
 	transformerRunner(t, emptyEvent, &expected)
 }
@@ -267,13 +292,14 @@ func TestNotTrackedEventConsume(t *testing.T) {
 			"now":      1382033155,
 			"id":       42
 		}`),
-		Failure: reporter.None,
-		Pstart:  now,
-		UUID:    "uuid1",
+		Failure:   reporter.None,
+		Pstart:    now,
+		UUID:      "uuid1",
+		EventTime: json.Number("1382033155"),
 	}
 	transformerRunner(t, notTrackedEvent, &writer.WriteRequest{
 		Category: notTrackedEvent.Event,
-		Line:     `{"event":"NotTracked","properties":{"times":42,"fraction":0.1234,"name":"kai.hayashi","now":1382033155,"id":42}}`,
+		Line:     `{"event":"NotTracked","properties":{"times":42,"fraction":0.1234,"name":"kai.hayashi","now":1382033155,"id":42},"uuid":"uuid1","time":1382033155}`,
 		Source:   notTrackedEvent.Properties,
 		Failure:  reporter.NonTrackingEvent,
 		Pstart:   notTrackedEvent.Pstart,
@@ -297,14 +323,14 @@ func TestEventWithNoMappingConsume(t *testing.T) {
 		UUID:    "uuid1",
 	}
 	expected := writer.WriteRequest{
-		Category: "login",
-		Version:  42,
-		Line:     "\"42\"\t\"0.1234\"\t\"\"\t\"\"\t\"2013-10-17 11:05:55\"\t\"\"",
-		Record:   map[string]string{"times": "42", "fraction": "0.1234", "now": "2013-10-17 11:05:55"},
-		Source:   noMappingEvent.Properties,
-		UUID:     "uuid1",
-		Failure:  reporter.SkippedColumn,
-		Pstart:   now,
+		Category:      "login",
+		Version:       42,
+		Line:          "\"42\"\t\"0.1234\"\t\"\"\t\"\"\t\"2013-10-17 11:05:55\"\t\"\"",
+		Record:        map[string]string{"times": "42", "fraction": "0.1234", "now": "2013-10-17 11:05:55"},
+		Source:        noMappingEvent.Properties,
+		UUID:          "uuid1",
+		Failure:       reporter.SkippedColumn,
+		Pstart:        now,
 	}
 	transformerRunner(t, noMappingEvent, &expected)
 }
@@ -368,3 +394,228 @@ func TestNormalEventWithMappingConsume(t *testing.T) {
 	}
 	transformerRunner(t, normalEvent, &expected)
 }
+
+func explodeTransformer() Transformer {
+	config := &testLoader{
+		Configs: map[string][]RedshiftType{
+			"purchase": {
+				{varcharFormat, "channel", "channel", nil},
+				{intFormat(32), ExplodeIndexProperty, "item_index", nil},
+				{varcharFormat, "cart.items.sku", "sku", nil},
+				{intFormat(32), "cart.items.quantity", "quantity", nil},
+			},
+		},
+		Versions: map[string]int{"purchase": 3},
+	}
+	eventMetadataConfig := &testEventMetadataLoader{
+		configs: map[string](map[string]string){
+			"purchase": {string(scoop_protocol.EXPLODE): "cart.items"},
+		},
+	}
+	return NewRedshiftTransformer(config, eventMetadataConfig, Config{}, &statsMock{})
+}
+
+func TestExplodedEventConsume(t *testing.T) {
+	event := &parser.MixpanelEvent{
+		Event:    "purchase",
+		EdgeType: spade.INTERNAL_EDGE,
+		Properties: []byte(`{
+			"channel": "lirik",
+			"cart": {"items": [{"sku": "a", "quantity": 1}, {"sku": "b", "quantity": "x"}]}}`),
+		Failure: reporter.None,
+		UUID:    "uuid1",
+	}
+	requests := explodeTransformer().Consume(event)
+	if len(requests) != 2 {
+		t.Fatalf("expected a request per item, got %v", requests)
+	}
+	expected := []*writer.WriteRequest{
+		{
+			Category: "purchase",
+			Version:  3,
+			Line:     `"lirik"` + "\t" + `"0"` + "\t" + `"a"` + "\t" + `"1"`,
+			Record:   map[string]string{"channel": "lirik", "item_index": "0", "sku": "a", "quantity": "1"},
+			UUID:     "uuid1-0",
+			Source:   event.Properties,
+			Failure:  reporter.None,
+		},
+		{
+			Category:      "purchase",
+			Version:       3,
+			Line:          `"lirik"` + "\t" + `"1"` + "\t" + `"b"` + "\t" + `""`,
+			Record:        map[string]string{"channel": "lirik", "item_index": "1", "sku": "b"},
+			UUID:          "uuid1-1",
+			Source:        event.Properties,
+			Failure:       reporter.SkippedColumn,
+		},
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(requests[i], expected[i]) {
+			t.Errorf("row %d: got \n%v \nexpected \n%v", i, requests[i], expected[i])
+		}
+	}
+}
+
+func TestExplodedEventWithoutItemsConsume(t *testing.T) {
+	for _, properties := range []string{
+		`{"channel": "lirik", "cart": {"items": []}}`,
+		`{"channel": "lirik", "cart": {"items": null}}`,
+		`{"channel": "lirik"}`,
+	} {
+		requests := explodeTransformer().Consume(&parser.MixpanelEvent{
+			Event:      "purchase",
+			EdgeType:   spade.INTERNAL_EDGE,
+			Properties: []byte(properties),
+			UUID:       "uuid1",
+		})
+		if len(requests) != 1 {
+			t.Errorf("expected one row for %s, got %v", properties, requests)
+			continue
+		}
+		if requests[0].UUID != "uuid1" || requests[0].Record["channel"] != "lirik" || requests[0].Record["sku"] != "" {
+			t.Errorf("expected a row without an item for %s, got %v", properties, requests[0])
+		}
+	}
+
+	requests := explodeTransformer().Consume(&parser.MixpanelEvent{
+		Event:      "purchase",
+		EdgeType:   spade.INTERNAL_EDGE,
+		Properties: []byte(`{"cart": {"items": "a"}}`),
+	})
+	if len(requests) != 1 || requests[0].Failure != reporter.EmptyRequest {
+		t.Errorf("expected an error for an array which isn't, got %v", requests)
+	}
+}
+
+func TestVarcharOverflowConsume(t *testing.T) {
+	config := &testLoader{
+		Configs: map[string][]RedshiftType{
+			"chat": {{GetColumnTransform("varchar", "(5)", nil), "message", "message", nil}},
+		},
+	}
+	event := &parser.MixpanelEvent{
+		Event:      "chat",
+		EdgeType:   spade.INTERNAL_EDGE,
+		Properties: []byte(`{"message": "hello world"}`),
+	}
+	for policy, expected := range map[string]*writer.WriteRequest{
+		VarcharTruncate: {
+			Category: "chat",
+			Line:     `"hello"`,
+			Record:   map[string]string{"message": "hello"},
+			Source:   event.Properties,
+			Failure:  reporter.None,
+		},
+		VarcharSkip: {
+			Category:      "chat",
+			Line:          `""`,
+			Record:        map[string]string{},
+			Source:        event.Properties,
+			Failure:       reporter.SkippedColumn,
+		},
+	} {
+		stats := &countingStats{counts: map[string]int{}}
+		requests := NewRedshiftTransformer(config, &testEventMetadataLoader{}, Config{VarcharOverflow: policy}, stats).Consume(event)
+		if len(requests) != 1 || !reflect.DeepEqual(requests[0], expected) {
+			t.Errorf("%s: got \n%v \nexpected \n%v", policy, requests, expected)
+		}
+		if stats.counts["transformer.chat.varchar_overflow.message"] != 1 {
+			t.Errorf("%s: expected the overflow to be counted, got %v", policy, stats.counts)
+		}
+		if policy == VarcharSkip && stats.counts["transformer.chat.failed.message.out_of_range"] != 1 {
+			t.Errorf("%s: expected the skipped column to be counted, got %v", policy, stats.counts)
+		}
+	}
+
+	if err := (&Config{VarcharOverflow: "explode"}).Validate(); err == nil {
+		t.Error("expected an error for an unknown policy")
+	}
+}
+
+func unmappedTransformer(strict string, stats reporter.StatsLogger) Transformer {
+	config := &testLoader{
+		Configs: map[string][]RedshiftType{
+			"play": {
+				{varcharFormat, "channel", "channel", nil},
+				{varcharFormat, "player.quality", "quality", nil},
+			},
+		},
+		Versions: map[string]int{"play": 7},
+	}
+	eventMetadataConfig := &testEventMetadataLoader{
+		configs: map[string](map[string]string){
+			"play": {string(scoop_protocol.STRICT): strict},
+		},
+	}
+	return NewRedshiftTransformer(config, eventMetadataConfig, Config{UnmappedSampleRate: 1}, stats)
+}
+
+func TestUnmappedPropertiesConsume(t *testing.T) {
+	event := &parser.MixpanelEvent{
+		Event:      "play",
+		EdgeType:   spade.INTERNAL_EDGE,
+		Properties: []byte(`{"channel":"lirik","player":{"quality":"720p","volume":1},"muted":true,"codec.name":"h264"}`),
+		UUID:       "uuid1",
+		UserAgent:  "Test Browser",
+	}
+	stats := &countingStats{counts: map[string]int{}}
+	requests := unmappedTransformer("", stats).Consume(event)
+	if len(requests) != 2 {
+		t.Fatalf("expected a sample and a row, got %v", requests)
+	}
+	sample := &writer.WriteRequest{
+		Category: "play",
+		Version:  7,
+		Line:     `{"event":"play","properties":` + string(event.Properties) + `,"unmapped":["codec.name","muted"]}`,
+		UUID:     "uuid1",
+		Source:   event.Properties,
+		Failure:  reporter.UnmappedPropertiesSample,
+	}
+	if !reflect.DeepEqual(requests[0], sample) {
+		t.Errorf("got \n%v \nexpected \n%v", requests[0], sample)
+	}
+	if requests[1].Failure != reporter.None || requests[1].Line != `"lirik"`+"\t"+`"720p"` {
+		t.Errorf("expected the mapped columns to be written, got %v", requests[1])
+	}
+	for stat, expected := range map[string]int{
+		"transformer.play.unmapped":            1,
+		"transformer.play.unmapped_properties": 2,
+		"transformer.play.unmapped.muted":      1,
+		"transformer.play.unmapped.codec_name": 1,
+		"transformer.play.strict_rejected":     0,
+	} {
+		if stats.counts[stat] != expected {
+			t.Errorf("expected %s to be %d, got %v", stat, expected, stats.counts)
+		}
+	}
+
+	if err := (&Config{UnmappedSampleRate: 2}).Validate(); err == nil {
+		t.Error("expected an error for a sample rate over 1")
+	}
+}
+
+func TestStrictEventConsume(t *testing.T) {
+	event := &parser.MixpanelEvent{
+		Event:      "play",
+		EdgeType:   spade.INTERNAL_EDGE,
+		Properties: []byte(`{"channel": "lirik", "muted": true}`),
+		UUID:       "uuid1",
+	}
+	stats := &countingStats{counts: map[string]int{}}
+	requests := unmappedTransformer("true", stats).Consume(event)
+	if len(requests) != 2 || requests[0].Failure != reporter.UnmappedPropertiesSample {
+		t.Fatalf("expected a sample and a failure, got %v", requests)
+	}
+	if requests[1].Failure != reporter.UnmappedProperties || requests[1].Error != "unmapped properties: muted" {
+		t.Errorf("expected the event to fail, got %v", requests[1])
+	}
+	if stats.counts["transformer.play.strict_rejected"] != 1 {
+		t.Errorf("expected the rejection to be counted, got %v", stats.counts)
+	}
+
+	event.Properties = []byte(`{"channel": "lirik", "player": {"quality": "720p"}}`)
+	requests = unmappedTransformer("true", stats).Consume(event)
+	if len(requests) != 1 || requests[0].Failure != reporter.None {
+		t.Errorf("expected a mapped event to be written, got %v", requests)
+	}
+}
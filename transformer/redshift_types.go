@@ -1,6 +1,7 @@
 package transformer
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,10 +9,12 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
-	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/twitchscience/spade/geoip"
+	"github.com/twitchscience/spade/pii"
 	"github.com/twitchscience/spade/reporter"
+	"github.com/twitchscience/spade/useragent"
 
 	"github.com/twitchscience/spade/cache"
 	"github.com/twitchscience/spade/lookup"
@@ -26,7 +29,7 @@ import (
 // for time transformers. Transform generators allow the user to define how
 // the transformer should parse a inbound property.
 
-// PST is the timezone used for everything.
+// PST is the timezone of timestamps whose column doesn't give one.
 var PST = getPST()
 
 func getPST() *time.Location {
@@ -47,7 +50,8 @@ type MappingTransformerConfig struct {
 
 // RedshiftType combines a way to get the input to the ColumnTransformer.
 // Basically it performs Transformer(Event[EventProperty]) -> Column with the help of the values
-// of the SupportingColumns provided.
+// of the SupportingColumns provided. Either may be a path like player.quality or items[0].id.
+// Unless SupportingColumns is nil, missing properties are passed to the Transformer as nil.
 type RedshiftType struct {
 	Transformer       ColumnTransformer
 	InboundName       string
@@ -56,13 +60,14 @@ type RedshiftType struct {
 }
 
 // Format finds the column to transform and returns the outbound column name and transformed value.
+// The inbound and supporting columns may be paths into nested properties.
 func (r *RedshiftType) Format(eventProperties map[string]interface{}) (string, string, error) {
 	args := make([]interface{}, 0, len(r.SupportingColumns)+1)
 	columns := []string{r.InboundName}
 	columns = append(columns, r.SupportingColumns...)
 	for _, col := range columns {
-		p, ok := eventProperties[col]
-		if !ok && len(r.SupportingColumns) == 0 {
+		p, ok := lookupProperty(eventProperties, col)
+		if !ok && r.SupportingColumns == nil {
 			return "", "", ErrColumnNotFound
 		}
 		args = append(args, p)
@@ -73,6 +78,19 @@ func (r *RedshiftType) Format(eventProperties map[string]interface{}) (string, s
 
 // GetSingleValueTransform returns us a single value Transformer for a given identifier string.
 func GetSingleValueTransform(tType string, geoip geoip.GeoLookup) ColumnTransformer {
+	return GetColumnTransform(tType, "", geoip)
+}
+
+// GetColumnTransform returns a single value Transformer for a given identifier string and the
+// column's creation options, which size types like decimal(12,2).
+func GetColumnTransform(tType string, options string, geoip geoip.GeoLookup) ColumnTransformer {
+	if transformGenerator, ok := optionsTransformGeneratorMap[tType]; ok {
+		t := transformGenerator(options)
+		if t == nil {
+			return nil
+		}
+		return safeColumnTransformer(t, 1)
+	}
 	if t, ok := singleValueTransformMap[tType]; ok {
 		return safeColumnTransformer(t, 1)
 	}
@@ -80,34 +98,76 @@ func GetSingleValueTransform(tType string, geoip geoip.GeoLookup) ColumnTransfor
 		return safeColumnTransformer(t(geoip), 1)
 	}
 	if tType[0] == 'f' { // were building a transform function
-		transformParams := strings.Split(tType, "@")
+		transformParams := strings.SplitN(tType, "@", 3)
 		if len(transformParams) < 3 {
 			return nil
 		}
 		if transformGenerator, ok := singleValueTransformGeneratorMap[transformParams[1]]; ok {
-			return safeColumnTransformer(transformGenerator(transformParams[2]), 1)
+			t := transformGenerator(transformParams[2])
+			if t == nil {
+				return nil
+			}
+			return safeColumnTransformer(t, 1)
 		}
 		return nil
 	}
 	return nil
 }
 
-// GetMappingTransform returns a mapping transformer for a given identifier string.
-func GetMappingTransform(tType string, config MappingTransformerConfig) ColumnTransformer {
-	if transformGenerator, ok := mappingTransformMap[tType]; ok {
+// GetMappingTransform returns a mapping transformer for a given identifier string, which is
+// the ID of a transformer configured in TransformerFetchers, optionally followed by
+// MappingSeparator and the field of the lookup to take. Transformers without a generator in
+// mappingTransformMap look up their fetcher's value with arguments named after the
+// supporting columns.
+func GetMappingTransform(tType string, config MappingTransformerConfig, supportingColumns []string) ColumnTransformer {
+	id := MappingTransformerID(tType)
+	field := strings.TrimPrefix(tType[len(id):], MappingSeparator)
+	if transformGenerator, ok := mappingTransformMap[id]; ok {
+		if field != "" {
+			return nil
+		}
 		return transformGenerator(config)
 	}
-	return nil
+	if config.Fetcher == nil || (tType != id && field == "") {
+		return nil
+	}
+	return genLookupTransformer(id, field, supportingColumns, config)
+}
+
+// IsLookupMapping returns whether a mapping transformer looks up its fetcher's value with
+// arguments named after the supporting columns, rather than having a generator in
+// mappingTransformMap.
+func IsLookupMapping(tType string) bool {
+	_, ok := mappingTransformMap[MappingTransformerID(tType)]
+	return !ok
 }
 
 // New types should register here
 var (
 	singleValueTransformMap = map[string]ColumnTransformer{
-		"int":     intFormat(32),
-		"bigint":  intFormat(64),
-		"float":   floatFormat,
-		"varchar": varcharFormat,
-		"bool":    boolFormat,
+		"smallint": intFormat(16),
+		"int":      intFormat(32),
+		"bigint":   intFormat(64),
+		"float":    floatFormat,
+		"bool":     boolFormat,
+		"date":     dateFormat,
+		"uuid":     uuidFormat,
+		"json":     jsonFormat,
+		"super":    jsonFormat,
+
+		"hmac":        piiFormat("HMAC", pii.Hash),
+		"mask":        piiFormat("Mask", pii.Get("mask")),
+		"ipAnonymize": piiFormat("Ip Anonymize", pii.AnonymizeIP),
+
+		"uaBrowser":        uaFormat(func(a useragent.Agent) string { return a.Browser }),
+		"uaBrowserVersion": uaFormat(func(a useragent.Agent) string { return a.BrowserVersion }),
+		"uaOS":             uaFormat(func(a useragent.Agent) string { return a.OS }),
+		"uaDeviceType":     uaFormat(func(a useragent.Agent) string { return a.DeviceType }),
+		"uaIsBot":          uaFormat(func(a useragent.Agent) string { return strconv.FormatBool(a.Bot) }),
+	}
+	optionsTransformGeneratorMap = map[string]func(string) ColumnTransformer{
+		"decimal": genDecimalFormat,
+		"varchar": genVarcharFormat,
 	}
 	geoipTransformGeneratorMap = map[string]func(geoip.GeoLookup) ColumnTransformer{
 		"ipCity":       ipCityFormat,
@@ -118,15 +178,17 @@ var (
 	}
 	singleValueTransformGeneratorMap = map[string]func(string) ColumnTransformer{
 		"timestamp": genTimeFormat,
+		"mask":      genMaskFormat,
+		"url":       genURLFormat,
+		"urlParam":  genURLParamFormat,
 	}
 	mappingTransformMap = map[string]func(MappingTransformerConfig) ColumnTransformer{
 		"userIDWithMapping": genLoginToIDTransformer,
 	}
 )
 
-// Probably want to change this to be a static type of error
 func genError(offender interface{}, t string) error {
-	return fmt.Errorf("Failed to parse %v as a %s", offender, t)
+	return ErrParse{Value: fmt.Sprint(offender), ValueType: fmt.Sprintf("%T", offender), Type: t}
 }
 
 var (
@@ -134,6 +196,9 @@ var (
 	ErrUnknownTransform = errors.New("Unrecognized transform")
 	// ErrColumnNotFound is when a property from blueprint is not on an event.
 	ErrColumnNotFound = errors.New("Property Not Found")
+	// ErrVarcharTooLong is when a string is longer than its varchar column; the transformer
+	// also returns the string truncated to fit.
+	ErrVarcharTooLong = errors.New("Varchar too long")
 )
 
 // ColumnTransformer takes an event property and transforms it to a string.
@@ -183,7 +248,7 @@ func intFormat(bitsAllowed uint) func([]interface{}) (string, error) {
 			return "", err
 		}
 		if i > maxIntAllowed || i < minIntAllowed {
-			return "", fmt.Errorf("parsing \"%v\": value out of range (bits: %v)", i, bitsAllowed)
+			return "", ErrOutOfRange{fmt.Sprintf("parsing \"%v\": value out of range (bits: %v)", i, bitsAllowed)}
 		}
 		return strconv.FormatInt(i, 10), nil
 	}
@@ -230,31 +295,116 @@ func genUnixTimeFormat(timezone *time.Location) ColumnTransformer {
 		nanos := (i - seconds) * float64(time.Second)
 		// we also error if the year will be converted into a > 4 digit number
 		if seconds < timeLowerBound || seconds > fiveDigitYearCutoff {
-			return "", genError(args[0], "Time: unix")
+			return "", ErrOutOfRange{genError(args[0], "Time: unix").Error()}
 		}
 		return time.Unix(int64(seconds), int64(nanos)).In(timezone).Format(RedshiftDatetimeIngestString), nil
 	}
 }
 
-func genTimeFormat(format string) ColumnTransformer {
-	if format == "unix" {
-		return genUnixTimeFormat(PST)
-	} else if format == "unix-utc" {
+// genUnixMillisTimeFormat is genUnixTimeFormat for milliseconds since the epoch.
+func genUnixMillisTimeFormat(timezone *time.Location) ColumnTransformer {
+	return func(args []interface{}) (string, error) {
+		t, ok := args[0].(json.Number)
+		if !ok {
+			return "", genError(args[0], "Time: unix-ms")
+		}
+		i, err := t.Float64()
+		if err != nil {
+			return "", err
+		}
+
+		seconds := math.Trunc(i / 1000)
+		millis := math.Trunc(i - seconds*1000)
+		if seconds < timeLowerBound || seconds > fiveDigitYearCutoff {
+			return "", ErrOutOfRange{genError(args[0], "Time: unix-ms").Error()}
+		}
+		return time.Unix(int64(seconds), int64(millis)*int64(time.Millisecond)).In(timezone).Format(RedshiftDatetimeIngestString), nil
+	}
+}
+
+// iso8601Layouts are the ISO 8601 forms detected by the iso8601 format, most common first.
+// Fractional seconds are optional in all of them. Times without an offset are in the column's
+// timezone.
+var iso8601Layouts = []string{
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// genISO8601TimeFormat parses any of the iso8601Layouts and converts the time to the timezone.
+func genISO8601TimeFormat(timezone *time.Location) ColumnTransformer {
+	return func(args []interface{}) (string, error) {
+		str, ok := args[0].(string)
+		if !ok {
+			return "", genError(args[0], "Time: iso8601")
+		}
+		for _, layout := range iso8601Layouts {
+			if t, err := time.ParseInLocation(layout, str, timezone); err == nil {
+				return t.In(timezone).Format(RedshiftDatetimeIngestString), nil
+			}
+		}
+		return "", genError(args[0], "Time: iso8601")
+	}
+}
+
+// genTimeFormat returns the transformer for a timestamp column given its format, which may be
+// followed by @ and an IANA timezone, as in f@timestamp@unix@UTC. Timestamps are written in
+// that timezone, or PST if there isn't one, so older tables keep their output; unix-utc predates
+// timezones and can't be given one. It returns nil for an unknown timezone.
+func genTimeFormat(spec string) ColumnTransformer {
+	format, timezone, zoned := spec, PST, false
+	if i := strings.LastIndexByte(spec, '@'); i >= 0 {
+		name := spec[i+1:]
+		if name == "" || name == "Local" {
+			return nil
+		}
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil
+		}
+		format, timezone, zoned = spec[:i], loc, true
+	}
+
+	switch format {
+	case "unix":
+		return genUnixTimeFormat(timezone)
+	case "unix-utc":
+		if zoned {
+			return nil
+		}
 		return genUnixTimeFormat(time.UTC)
+	case "unix-ms":
+		return genUnixMillisTimeFormat(timezone)
+	case "iso8601":
+		return genISO8601TimeFormat(timezone)
 	}
 	return func(args []interface{}) (string, error) {
 		str, ok := args[0].(string)
 		if !ok {
 			return "", genError(args[0], "Time: "+format)
 		}
-		t, err := time.ParseInLocation(format, str, PST)
+		t, err := time.ParseInLocation(format, str, timezone)
 		if err != nil {
 			return "", err
 		}
+		if zoned {
+			t = t.In(timezone)
+		}
 		return t.Format(RedshiftDatetimeIngestString), nil
 	}
 }
 
+const (
+	// Redshift's default and maximum varchar lengths, in bytes.
+	defaultVarcharLength = 256
+	maxVarcharLength     = 65535
+)
+
 func varcharFormat(args []interface{}) (string, error) {
 	str, ok := args[0].(string)
 	if !ok {
@@ -263,6 +413,156 @@ func varcharFormat(args []interface{}) (string, error) {
 	return str, nil
 }
 
+// genVarcharFormat returns the transformer for a varchar column with the given creation
+// options, such as "(255)" or "(max)", or nil if the options don't describe a valid length.
+// Longer strings are truncated on a UTF-8 boundary, and returned with ErrVarcharTooLong.
+func genVarcharFormat(options string) ColumnTransformer {
+	length, err := parseVarcharLength(options)
+	if err != nil {
+		return nil
+	}
+	return func(args []interface{}) (string, error) {
+		str, err := varcharFormat(args)
+		if err != nil || len(str) <= length {
+			return str, err
+		}
+		return truncateUTF8(str, length), ErrVarcharTooLong
+	}
+}
+
+// parseVarcharLength returns the length in column creation options like "(255)", or
+// Redshift's default if there is none.
+func parseVarcharLength(options string) (int, error) {
+	options = strings.TrimSpace(options)
+	if !strings.HasPrefix(options, "(") {
+		return defaultVarcharLength, nil
+	}
+	end := strings.IndexByte(options, ')')
+	if end < 0 {
+		return 0, fmt.Errorf("unterminated varchar options %q", options)
+	}
+	size := strings.TrimSpace(options[1:end])
+	if strings.EqualFold(size, "max") {
+		return maxVarcharLength, nil
+	}
+	length, err := strconv.Atoi(size)
+	if err != nil {
+		return 0, fmt.Errorf("parsing varchar length: %v", err)
+	}
+	if length < 1 || length > maxVarcharLength {
+		return 0, fmt.Errorf("bad varchar length %d", length)
+	}
+	return length, nil
+}
+
+// truncateUTF8 truncates a string to at most n bytes without splitting a character.
+func truncateUTF8(str string, n int) string {
+	for n > 0 && !utf8.RuneStart(str[n]) {
+		n--
+	}
+	return str[:n]
+}
+
+// RedshiftDateIngestString is the format of dates that Redshift understands.
+const RedshiftDateIngestString = "2006-01-02"
+
+// dateFormat takes a date, an RFC 3339 timestamp (keeping its own date), or unix seconds (as
+// a date in PST, like unix timestamps).
+func dateFormat(args []interface{}) (string, error) {
+	switch v := args[0].(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil || f < timeLowerBound || f > fiveDigitYearCutoff {
+			return "", genError(args[0], "Date")
+		}
+		return time.Unix(int64(f), 0).In(PST).Format(RedshiftDateIngestString), nil
+	case string:
+		if t, err := time.Parse(RedshiftDateIngestString, v); err == nil {
+			return t.Format(RedshiftDateIngestString), nil
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t.Format(RedshiftDateIngestString), nil
+		}
+	}
+	return "", genError(args[0], "Date")
+}
+
+// uuidFormat takes a UUID with or without hyphens and returns it in its canonical form.
+func uuidFormat(args []interface{}) (string, error) {
+	str, ok := args[0].(string)
+	if !ok {
+		return "", genError(args[0], "UUID")
+	}
+	hex := strings.Replace(str, "-", "", -1)
+	if len(hex) != 32 || (len(str) != 32 && (len(str) != 36 ||
+		str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-')) {
+		return "", genError(args[0], "UUID")
+	}
+	for _, c := range hex {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return "", genError(args[0], "UUID")
+		}
+	}
+	hex = strings.ToLower(hex)
+	return hex[:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:], nil
+}
+
+// jsonFormat re-serializes a property, usually a nested object or array, as compact JSON.
+func jsonFormat(args []interface{}) (string, error) {
+	if args[0] == nil {
+		return "", genError(args[0], "JSON")
+	}
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(args[0]); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// piiFormat applies a pii transform to a string or number, so that identifying values
+// (including numeric user IDs) never reach the column. Its errors are ErrSensitiveValues, so
+// the values it fails on aren't sampled either.
+func piiFormat(name string, transform pii.Func) ColumnTransformer {
+	return func(args []interface{}) (string, error) {
+		var str string
+		switch v := args[0].(type) {
+		case string:
+			str = v
+		case json.Number:
+			str = string(v)
+		default:
+			return "", ErrSensitiveValue{fmt.Sprintf("Failed to parse a %T as a %s", args[0], name)}
+		}
+		value, err := transform(str)
+		if err != nil {
+			return "", ErrSensitiveValue{fmt.Sprintf("Failed to parse a value as a %s: %v", name, err)}
+		}
+		return value, nil
+	}
+}
+
+// genMaskFormat masks all but the given number of characters, as in f@mask@2.
+func genMaskFormat(keep string) ColumnTransformer {
+	transform := pii.Get("f@mask@" + keep)
+	if transform == nil {
+		return nil
+	}
+	return piiFormat("Mask", transform)
+}
+
+// uaFormat parses a user agent string and returns one part of it.
+func uaFormat(part func(useragent.Agent) string) ColumnTransformer {
+	return func(args []interface{}) (string, error) {
+		str, ok := args[0].(string)
+		if !ok {
+			return "", genError(args[0], "User Agent")
+		}
+		return part(useragent.Parse(str)), nil
+	}
+}
+
 func boolFormat(args []interface{}) (string, error) {
 	b, ok := args[0].(bool)
 	if ok {
@@ -327,23 +627,6 @@ func ipAsnIntFormat(geoip geoip.GeoLookup) ColumnTransformer {
 	}
 }
 
-func recordCacheError(stats reporter.StatsLogger, err error, operation string) {
-	switch err {
-	case nil:
-		stats.IncrBy(fmt.Sprintf("transformer.login_to_id.cache_error.%s.success", operation), 1)
-	case memcache.ErrCacheMiss:
-		stats.IncrBy(fmt.Sprintf("transformer.login_to_id.cache_error.%s.cache_miss", operation), 1)
-	case memcache.ErrMalformedKey:
-		stats.IncrBy(fmt.Sprintf("transformer.login_to_id.cache_error.%s.malformed_key", operation), 1)
-	default:
-		if _, ok := err.(*memcache.ConnectTimeoutError); ok {
-			stats.IncrBy(fmt.Sprintf("transformer.login_to_id.cache_error.%s.connect_timeout", operation), 1)
-		} else {
-			stats.IncrBy(fmt.Sprintf("transformer.login_to_id.cache_error.%s.other", operation), 1)
-		}
-	}
-}
-
 var (
 	// ErrIDSet means we didn't have to do a lookup.
 	ErrIDSet = errors.New("id was set")
@@ -397,46 +680,14 @@ func genLoginToIDTransformer(config MappingTransformerConfig) ColumnTransformer
 			return "", ErrEmptyLookupValue
 		}
 
-		// Chceck the local cache.
-		cachedID, err := config.LocalCache.Get(login)
-		if err == nil {
-			recordCacheError(config.Stats, nil, "local_get")
-			return cachedID, ErrLocalCacheHit
-		}
-
-		// Failed the local cache. Try the remote cache.
-		cachedID, err = config.RemoteCache.Get(login)
-		if err == nil {
-			recordCacheError(config.Stats, nil, "remote_get")
-			_ = config.LocalCache.Set(login, cachedID)
-			return cachedID, ErrRemoteCacheHit
-		}
-
-		// We'll fetch at this point, remembering to save to cache before returning. One thing
-		// to notice is that we'll always return failures from setting the cache in conjunction
-		// with the fetched value, this way the client can identify failure to save to cache but
-		// still use the value and move forward.
-		fetchArgs := map[string]string{
-			"login": login,
-		}
-		fetchedValue, err := config.Fetcher.FetchInt64(fetchArgs)
-		if err != nil {
-			if err == lookup.ErrExtractingValue {
-				// This kind of error is most likely caused by an invalid login provided for
-				// fetching, so let's cache an empty value so we don't keep fetching in the future
-				_ = config.LocalCache.Set(login, "")
-				err = config.RemoteCache.Set(login, "")
-				recordCacheError(config.Stats, err, "remote_set")
+		// The login argument and cache keys predate generic lookups, and are kept so that
+		// the IDs already cached are still used.
+		return cachedLookup(config, "login_to_id", login, func() (string, error) {
+			fetchedValue, err := config.Fetcher.FetchInt64(map[string]string{"login": login})
+			if err != nil {
+				return "", err
 			}
-			return "", ErrFetchFailure
-		}
-		fetchedID := strconv.FormatInt(fetchedValue, 10)
-		_ = config.LocalCache.Set(login, fetchedID)
-		err = config.RemoteCache.Set(login, fetchedID)
-		recordCacheError(config.Stats, err, "remote_set")
-		if err != nil {
-			return fetchedID, ErrCacheSetFailure
-		}
-		return fetchedID, ErrFetchSuccess
+			return strconv.FormatInt(fetchedValue, 10), nil
+		})
 	}, 2)
 }
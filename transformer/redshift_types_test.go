@@ -2,8 +2,7 @@ package transformer
 
 import (
 	"encoding/json"
-	"reflect"
-	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,10 +11,24 @@ import (
 	"github.com/twitchscience/scoop_protocol/transformer"
 	"github.com/twitchscience/spade/cache/lru"
 	"github.com/twitchscience/spade/geoip"
+	"github.com/twitchscience/spade/pii"
 )
 
 var (
-	exportedTransformGenerators = []string{"f@timestamp@unix", "f@timestamp@unix-utc"}
+	exportedTransformGenerators = []string{"f@timestamp@unix", "f@timestamp@unix-utc", "f@timestamp@unix-ms", "f@timestamp@iso8601",
+		"f@url@host", "f@url@domain", "f@url@path", "f@url@fragment"}
+	// exampleTransformParams are parameters which compile for each of scoop_protocol's
+	// ValidTransformPrefixes.
+	exampleTransformParams = map[string]string{
+		"f@timestamp@":  "unix@America/New_York",
+		"f@mask@":       "2",
+		"f@urlParam@":   "utm_source",
+		"d@concat@":     "-",
+		"d@date_trunc@": "hour@UTC",
+		"d@default@":    "none",
+		"d@substr@":     "1@3",
+	}
+	columnCreationOptions = map[string]string{"decimal": "(12,2)"}
 )
 
 func _typeRunner(t *testing.T, input interface{}, _type RedshiftType,
@@ -68,6 +81,77 @@ func TestSmallIntConversion(t *testing.T) {
 	_typeRunner(t, "127", smallInteger, "127", false)
 }
 
+func TestSmallIntTypeConversion(t *testing.T) {
+	smallInteger := RedshiftType{GetSingleValueTransform("smallint", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, json.Number("32767"), smallInteger, "32767", false)
+	_typeRunner(t, "-32768", smallInteger, "-32768", false)
+
+	_typeRunner(t, json.Number("32768"), smallInteger, "", true)
+	_typeRunner(t, "-32769", smallInteger, "", true)
+}
+
+func TestDecimalConversion(t *testing.T) {
+	money := RedshiftType{GetColumnTransform("decimal", "(6,2)", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, json.Number("12.5"), money, "12.50", false)
+	_typeRunner(t, json.Number("0.1"), money, "0.10", false)
+	_typeRunner(t, "9999.994", money, "9999.99", false)
+	_typeRunner(t, "-1.005", money, "-1.01", false)
+	_typeRunner(t, "-0.001", money, "0.00", false)
+	_typeRunner(t, json.Number("1.2345e2"), money, "123.45", false)
+	_typeRunner(t, json.Number("5e-3"), money, "0.01", false)
+	_typeRunner(t, ".5", money, "0.50", false)
+	_typeRunner(t, "0042", money, "42.00", false)
+
+	_typeRunner(t, "9999.995", money, "", true)
+	_typeRunner(t, json.Number("10000"), money, "", true)
+	_typeRunner(t, "1.2.3", money, "", true)
+	_typeRunner(t, "12abc", money, "", true)
+	_typeRunner(t, "1e1000", money, "", true)
+	_typeRunner(t, ".", money, "", true)
+	_typeRunner(t, nil, money, "", true)
+
+	integer := RedshiftType{GetSingleValueTransform("decimal", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "123456789012345678.4", integer, "123456789012345678", false)
+	_typeRunner(t, "1234567890123456789", integer, "", true)
+
+	for _, options := range []string{"(0)", "(39,2)", "(4,5)", "(4,x)", "(4"} {
+		assert.Nil(t, GetColumnTransform("decimal", options, geoip.Noop()), options)
+	}
+}
+
+func TestDateConversion(t *testing.T) {
+	date := RedshiftType{dateFormat, "_", "_", nil}
+	_typeRunner(t, "2017-03-01", date, "2017-03-01", false)
+	_typeRunner(t, "2017-03-01T23:30:00-08:00", date, "2017-03-01", false)
+	_typeRunner(t, json.Number("1382033155"), date, "2013-10-17", false)
+
+	_typeRunner(t, "2017-02-30", date, "", true)
+	_typeRunner(t, "03/01/2017", date, "", true)
+	_typeRunner(t, json.Number("42"), date, "", true)
+	_typeRunner(t, true, date, "", true)
+}
+
+func TestUUIDConversion(t *testing.T) {
+	uuid := RedshiftType{uuidFormat, "_", "_", nil}
+	_typeRunner(t, "2B4A3C1E-49EF-F880-5357-07F320F9E114", uuid, "2b4a3c1e-49ef-f880-5357-07f320f9e114", false)
+	_typeRunner(t, "2B4A3C1E49EFF880535707F320F9E114", uuid, "2b4a3c1e-49ef-f880-5357-07f320f9e114", false)
+
+	_typeRunner(t, "2b4a3c1e-49ef-f880-5357-07f320f9e11", uuid, "", true)
+	_typeRunner(t, "2b4a3c1e49ef-f880-5357-07f320f9e1145", uuid, "", true)
+	_typeRunner(t, "zb4a3c1e-49ef-f880-5357-07f320f9e114", uuid, "", true)
+	_typeRunner(t, json.Number("42"), uuid, "", true)
+}
+
+func TestJSONConversion(t *testing.T) {
+	super := RedshiftType{jsonFormat, "_", "_", nil}
+	_typeRunner(t, map[string]interface{}{"b": []interface{}{json.Number("1.50"), "<x>"}, "a": nil},
+		super, `{"a":null,"b":[1.50,"<x>"]}`, false)
+	_typeRunner(t, []interface{}{}, super, `[]`, false)
+	_typeRunner(t, "text", super, `"text"`, false)
+
+	_typeRunner(t, nil, super, "", true)
+}
+
 func TestFloatConversion(t *testing.T) {
 	normalFloat := RedshiftType{floatFormat, "_", "_", nil}
 	_typeRunner(t, json.Number("1.234"), normalFloat, "1.234", false)
@@ -100,6 +184,40 @@ func TestVarCharConversion(t *testing.T) {
 	_typeRunner(t, json.Number("1234.0"), normalVarChar, "", true)
 }
 
+func TestSizedVarCharConversion(t *testing.T) {
+	short := GetColumnTransform("varchar", "(4)", geoip.Noop())
+	for _, tt := range []struct {
+		input    string
+		expected string
+		tooLong  bool
+	}{
+		{"abcd", "abcd", false},
+		{"abcde", "abcd", true},
+		{"aé", "aé", false},
+		{"abcé", "abc", true}, // é is two bytes, so it doesn't fit
+		{"日本", "日", true},
+	} {
+		actual, err := short([]interface{}{tt.input})
+		assert.Equal(t, tt.expected, actual, tt.input)
+		if tt.tooLong {
+			assert.Equal(t, ErrVarcharTooLong, err, tt.input)
+		} else {
+			assert.NoError(t, err, tt.input)
+		}
+	}
+	_, err := short([]interface{}{json.Number("1")})
+	assert.Error(t, err)
+
+	for options, length := range map[string]int{"": 256, " sortkey": 256, "(MAX)": 65535, "( 32 ) sortkey": 32} {
+		actual, err := parseVarcharLength(options)
+		assert.NoError(t, err, options)
+		assert.Equal(t, length, actual, options)
+	}
+	for _, options := range []string{"(0)", "(65536)", "(x)", "(3"} {
+		assert.Nil(t, GetColumnTransform("varchar", options, geoip.Noop()), options)
+	}
+}
+
 func TestUnixTimestampConversion(t *testing.T) {
 	unixDateTime := RedshiftType{genUnixTimeFormat(PST), "_", "_", nil}
 	_typeRunner(t, json.Number("1382033155.045"), unixDateTime, "2013-10-17 11:05:55.045", false)
@@ -128,6 +246,57 @@ func TestUnixUTCTimestampConversion(t *testing.T) {
 	_typeRunner(t, "2013-10-17 105:55", otherDateTime, "", true)
 }
 
+func TestZonedTimestampConversion(t *testing.T) {
+	unixUTC := RedshiftType{GetSingleValueTransform("f@timestamp@unix@UTC", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, json.Number("1382033155.045"), unixUTC, "2013-10-17 18:05:55.045", false)
+
+	unixTokyo := RedshiftType{GetSingleValueTransform("f@timestamp@unix@Asia/Tokyo", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, json.Number("1382033155"), unixTokyo, "2013-10-18 03:05:55", false)
+
+	// A zoned layout reads times without an offset in the zone, and converts others to it.
+	layoutUTC := RedshiftType{GetSingleValueTransform("f@timestamp@2006-01-02 15:04:05Z07:00@UTC", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "2013-10-17 11:05:55-07:00", layoutUTC, "2013-10-17 18:05:55", false)
+	legacyLayout := RedshiftType{GetSingleValueTransform("f@timestamp@2006-01-02 15:04:05Z07:00", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "2013-10-17 11:05:55-07:00", legacyLayout, "2013-10-17 11:05:55", false)
+
+	for _, tType := range []string{"f@timestamp@unix@Mars/Olympus_Mons", "f@timestamp@unix@", "f@timestamp@unix@Local", "f@timestamp@unix-utc@UTC"} {
+		assert.Nil(t, GetSingleValueTransform(tType, geoip.Noop()), tType)
+		assert.False(t, transformer.IsValidTransform(tType), tType)
+	}
+	for _, tType := range []string{"f@timestamp@unix@UTC", "f@timestamp@unix-ms@America/New_York", "f@timestamp@iso8601@Asia/Tokyo", "f@timestamp@2006-01-02 15:04:05Z07:00@UTC"} {
+		assert.NotNil(t, GetSingleValueTransform(tType, geoip.Noop()), tType)
+		assert.True(t, transformer.IsValidTransform(tType), tType)
+	}
+}
+
+func TestUnixMillisTimestampConversion(t *testing.T) {
+	pst := RedshiftType{GetSingleValueTransform("f@timestamp@unix-ms", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, json.Number("1382033155045"), pst, "2013-10-17 11:05:55.045", false)
+	_typeRunner(t, json.Number("1382033155045.9"), pst, "2013-10-17 11:05:55.045", false)
+	_typeRunner(t, json.Number("1382033155"), pst, "", true)
+	_typeRunner(t, "1382033155045", pst, "", true)
+
+	utc := RedshiftType{GetSingleValueTransform("f@timestamp@unix-ms@UTC", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, json.Number("1382033155000"), utc, "2013-10-17 18:05:55", false)
+}
+
+func TestISO8601TimestampConversion(t *testing.T) {
+	utc := RedshiftType{GetSingleValueTransform("f@timestamp@iso8601@UTC", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "2013-10-17T18:05:55Z", utc, "2013-10-17 18:05:55", false)
+	_typeRunner(t, "2013-10-17T18:05:55.045Z", utc, "2013-10-17 18:05:55.045", false)
+	_typeRunner(t, "2013-10-17T11:05:55-07:00", utc, "2013-10-17 18:05:55", false)
+	_typeRunner(t, "2013-10-17T20:05:55+0200", utc, "2013-10-17 18:05:55", false)
+	_typeRunner(t, "2013-10-17 18:05:55", utc, "2013-10-17 18:05:55", false)
+	_typeRunner(t, "2013-10-17T18:05", utc, "2013-10-17 18:05:00", false)
+	_typeRunner(t, "2013-10-17", utc, "2013-10-17 00:00:00", false)
+	_typeRunner(t, "17/10/2013", utc, "", true)
+	_typeRunner(t, json.Number("1382033155"), utc, "", true)
+
+	pst := RedshiftType{GetSingleValueTransform("f@timestamp@iso8601", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "2013-10-17T18:05:55Z", pst, "2013-10-17 11:05:55", false)
+	_typeRunner(t, "2013-10-17 11:05:55", pst, "2013-10-17 11:05:55", false)
+}
+
 func TestBooleanConversion(t *testing.T) {
 	booleanConverter := RedshiftType{boolFormat, "_", "_", nil}
 	_typeRunner(t, true, booleanConverter, "true", false)
@@ -162,7 +331,77 @@ func TestIpConversion(t *testing.T) {
 	_typeRunner(t, "118.192.154.0", ipAsnIntConverterNoDescription, "59050", false)
 }
 
+func TestPIIConversion(t *testing.T) {
+	pii.SetHashSalt("key")
+	defer pii.SetHashSalt("")
+	hashed := RedshiftType{GetSingleValueTransform("hmac", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "The quick brown fox jumps over the lazy dog", hashed,
+		"f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", false)
+	_typeRunner(t, json.Number("12345"), hashed,
+		"ab99a81f96d56f3b99596e3168b1ade13e02ab0aae08898b8aa4e3377c9e29d1", false)
+	_typeRunner(t, true, hashed, "", true)
+	pii.SetHashSalt("")
+	_typeRunner(t, "12345", hashed, "", true)
+
+	masked := RedshiftType{GetSingleValueTransform("mask", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "4111111111111111", masked, "************1111", false)
+	maskedTwo := RedshiftType{GetSingleValueTransform("f@mask@2", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, json.Number("12345"), maskedTwo, "***45", false)
+	assert.Nil(t, GetSingleValueTransform("f@mask@two", geoip.Noop()))
+
+	anonymized := RedshiftType{GetSingleValueTransform("ipAnonymize", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "222.22.222.222", anonymized, "222.22.222.0", false)
+	_typeRunner(t, "2001:db8:85a3::8a2e:370", anonymized, "2001:db8:85a3::", false)
+	_typeRunner(t, "", anonymized, "", true)
+}
+
+func TestUserAgentConversion(t *testing.T) {
+	ua := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1"
+	for tType, expected := range map[string]string{
+		"uaBrowser":        "Safari",
+		"uaBrowserVersion": "17.2",
+		"uaOS":             "iOS",
+		"uaDeviceType":     "mobile",
+		"uaIsBot":          "false",
+	} {
+		_typeRunner(t, ua, RedshiftType{GetSingleValueTransform(tType, geoip.Noop()), "_", "_", nil}, expected, false)
+		_typeRunner(t, json.Number("1"), RedshiftType{GetSingleValueTransform(tType, geoip.Noop()), "_", "_", nil}, "", true)
+	}
+	isBot := RedshiftType{GetSingleValueTransform("uaIsBot", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)", isBot, "true", false)
+}
+
+// compileTransform returns the transformer of a column type as tables compile it, taking one
+// or two properties if it's derived, or nil if it doesn't compile.
+func compileTransform(tType string) ColumnTransformer {
+	if strings.HasPrefix(tType, DerivedPrefix) {
+		if t := GetDerivedTransform(tType, 1); t != nil {
+			return t
+		}
+		return GetDerivedTransform(tType, 2)
+	}
+	if _, ok := mappingTransformMap[tType]; ok {
+		return GetMappingTransform(tType, MappingTransformerConfig{}, nil)
+	}
+	return GetColumnTransform(tType, columnCreationOptions[tType], geoip.Noop())
+}
+
 func TestInSyncWithScoopProtocol(t *testing.T) {
+	for _, tType := range transformer.ValidTransforms {
+		assert.NotNil(t, compileTransform(tType), "%s is valid but doesn't compile", tType)
+	}
+	for _, prefix := range transformer.ValidTransformPrefixes {
+		params, ok := exampleTransformParams[prefix]
+		if !assert.True(t, ok, "no example parameters for %s", prefix) {
+			continue
+		}
+		assert.True(t, transformer.IsValidTransform(prefix+params), prefix+params)
+		assert.NotNil(t, compileTransform(prefix+params), "%s%s is valid but doesn't compile", prefix, params)
+	}
+	for _, tType := range []string{"d@date_trunc", "d@substr@", "f@mask@", "f@urlParam@", "f@timestamp@"} {
+		assert.False(t, transformer.IsValidTransform(tType), tType)
+	}
+
 	var processorNames []string
 	for k := range singleValueTransformMap {
 		processorNames = append(processorNames, k)
@@ -170,16 +409,37 @@ func TestInSyncWithScoopProtocol(t *testing.T) {
 	for k := range geoipTransformGeneratorMap {
 		processorNames = append(processorNames, k)
 	}
+	for k := range optionsTransformGeneratorMap {
+		processorNames = append(processorNames, k)
+	}
 	for k := range mappingTransformMap {
 		processorNames = append(processorNames, k)
 	}
-	processorNames = append(processorNames, exportedTransformGenerators...)
-	sort.Strings(processorNames)
-	sort.Strings(transformer.ValidTransforms)
+	for k := range derivedTransformGeneratorMap {
+		processorNames = append(processorNames, DerivedPrefix+k)
+	}
+	for k := range singleValueTransformGeneratorMap {
+		if k != "url" {
+			processorNames = append(processorNames, "f@"+k)
+		}
+	}
+	for _, name := range processorNames {
+		assert.True(t, transformer.IsValidTransform(name) || validPrefix(name+"@"),
+			"%s is missing from scoop_protocol's valid transforms", name)
+	}
+	for _, tType := range exportedTransformGenerators {
+		assert.True(t, transformer.IsValidTransform(tType), tType)
+	}
+}
 
-	if !reflect.DeepEqual(processorNames, transformer.ValidTransforms) {
-		t.Errorf("Expected processor valid transform names to be equal to scoop_protocol's list of valid transforms. Respectively found them as %v and %v", processorNames, transformer.ValidTransforms)
+// validPrefix returns whether scoop_protocol lists the prefix as taking parameters.
+func validPrefix(prefix string) bool {
+	for _, p := range transformer.ValidTransformPrefixes {
+		if p == prefix {
+			return true
+		}
 	}
+	return false
 }
 
 func TestLoginToIDTransformer(t *testing.T) {
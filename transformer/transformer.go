@@ -5,9 +5,9 @@ import (
 	"github.com/twitchscience/spade/writer"
 )
 
-// Transformer converts a MixpanelEvent into a WriteRequest.
+// Transformer converts a MixpanelEvent into WriteRequests, one per row.
 type Transformer interface {
-	Consume(*parser.MixpanelEvent) *writer.WriteRequest
+	Consume(*parser.MixpanelEvent) []*writer.WriteRequest
 }
 
 // SchemaConfigLoader returns columns (transformers) or versions for given event types.
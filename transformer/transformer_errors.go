@@ -2,6 +2,7 @@ package transformer
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
@@ -14,17 +15,49 @@ type ErrNotTracked struct {
 	What string
 }
 
-// ErrSkippedColumn indicates an event is missing one or more columns.
-type ErrSkippedColumn struct {
+// ErrSensitiveValue indicates a value which may identify a user couldn't be transformed. Its
+// message doesn't include the value.
+type ErrSensitiveValue struct {
 	What string
 }
 
+// ErrOutOfRange indicates a value is too big or small for its column.
+type ErrOutOfRange struct {
+	What string
+}
+
+// ErrParse indicates a value couldn't be parsed as its column's type.
+type ErrParse struct {
+	// Value is the value, as formatted with %v
+	Value string
+	// ValueType is the Go type of the value
+	ValueType string
+	// Type is what the value was parsed as
+	Type string
+}
+
 // Error returns information on which event type is not being tracked.
 func (t ErrNotTracked) Error() string {
 	return t.What
 }
 
-// Error returns information on one column which is missing.
-func (t ErrSkippedColumn) Error() string {
+// Error returns information on why the value couldn't be transformed.
+func (t ErrSensitiveValue) Error() string {
+	return t.What
+}
+
+// Error returns information on the value which is out of range.
+func (t ErrOutOfRange) Error() string {
 	return t.What
 }
+
+// Error returns information on the value which couldn't be parsed.
+func (t ErrParse) Error() string {
+	return fmt.Sprintf("Failed to parse %s as a %s", t.Value, t.Type)
+}
+
+// Redacted returns information on the type of value which couldn't be parsed, without the
+// value.
+func (t ErrParse) Redacted() string {
+	return fmt.Sprintf("Failed to parse a %s as a %s", t.ValueType, t.Type)
+}
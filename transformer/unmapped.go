@@ -0,0 +1,131 @@
+package transformer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/twitchscience/spade/parser"
+	"github.com/twitchscience/spade/reporter"
+	"github.com/twitchscience/spade/writer"
+)
+
+// defaultUnmappedSampleRate is the fraction of events with unmapped properties which are
+// sampled if the config doesn't say.
+const defaultUnmappedSampleRate = 0.01
+
+// maxUnmappedStatLength bounds the property names put in stat names.
+const maxUnmappedStatLength = 64
+
+// injectedProperties are set on every event by the edge or the transformer, so they aren't
+// unmapped just because a table doesn't store them.
+var injectedProperties = map[string]bool{
+	"time":        true,
+	"client_time": true,
+	"ip":          true,
+	"socket_ip":   true,
+	"user_agent":  true,
+}
+
+// unmappedEvent is what's written to the nontracked output for a sampled event with unmapped
+// properties, so Blueprint can suggest columns for them. The event was also written to its
+// table, so redrives skip lines with Unmapped set.
+type unmappedEvent struct {
+	Event      string          `json:"event"`
+	Properties json.RawMessage `json:"properties"`
+	Unmapped   []string        `json:"unmapped"`
+}
+
+// unmappedProperties returns the sorted names of the properties which no column maps. A
+// column mapping a path such as player.quality maps the whole of the player property.
+func unmappedProperties(columns []RedshiftType, properties map[string]interface{}) []string {
+	mapped := make(map[string]bool, 2*len(columns))
+	mapName := func(name string) {
+		mapped[name] = true
+		if i := strings.IndexAny(name, ".["); i > 0 {
+			mapped[name[:i]] = true
+		}
+	}
+	for _, column := range columns {
+		mapName(column.InboundName)
+		for _, name := range column.SupportingColumns {
+			mapName(name)
+		}
+	}
+
+	var unmapped []string
+	for name := range properties {
+		if !mapped[name] && !injectedProperties[name] {
+			unmapped = append(unmapped, name)
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+// reportUnmapped counts the event's unmapped properties and, if the event is sampled, their
+// names. It returns the sampled event's request for the nontracked output, or nil.
+func (t *RedshiftTransformer) reportUnmapped(event *parser.MixpanelEvent, version int, unmapped []string) *writer.WriteRequest {
+	t.stats.IncrBy(fmt.Sprintf("transformer.%s.unmapped", event.Event), 1)
+	t.stats.IncrBy(fmt.Sprintf("transformer.%s.unmapped_properties", event.Event), len(unmapped))
+
+	rate := t.config.UnmappedSampleRate
+	if rate == 0 {
+		rate = defaultUnmappedSampleRate
+	}
+	if rand.Float64() >= rate {
+		return nil
+	}
+	for _, name := range unmapped {
+		t.stats.IncrBy(fmt.Sprintf("transformer.%s.unmapped.%s", event.Event, statName(name)), 1)
+	}
+	line, err := json.Marshal(&unmappedEvent{
+		Event:      event.Event,
+		Properties: event.Properties,
+		Unmapped:   unmapped,
+	})
+	if err != nil {
+		return nil
+	}
+	return &writer.WriteRequest{
+		Category: event.Event,
+		Version:  version,
+		Line:     string(line),
+		UUID:     event.UUID,
+		Source:   event.Properties,
+		Failure:  reporter.UnmappedPropertiesSample,
+		Pstart:   event.Pstart,
+	}
+}
+
+// strictRequest returns the failed request for an event of a strict table with unmapped
+// properties.
+func (t *RedshiftTransformer) strictRequest(event *parser.MixpanelEvent, version int, unmapped []string) *writer.WriteRequest {
+	t.stats.IncrBy(fmt.Sprintf("transformer.%s.strict_rejected", event.Event), 1)
+	return &writer.WriteRequest{
+		Category: event.Event,
+		Version:  version,
+		Line:     "",
+		UUID:     event.UUID,
+		Source:   event.Properties,
+		Failure:  reporter.UnmappedProperties,
+		Pstart:   event.Pstart,
+		Error:    fmt.Sprintf("unmapped properties: %s", strings.Join(unmapped, ", ")),
+		Raw:      event.Original(),
+	}
+}
+
+// statName makes a property name safe to use as part of a stat name.
+func statName(name string) string {
+	if len(name) > maxUnmappedStatLength {
+		name = name[:maxUnmappedStatLength]
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, name)
+}
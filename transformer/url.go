@@ -0,0 +1,87 @@
+package transformer
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+var (
+	// ErrBadURL is when a property to decompose isn't an absolute URL.
+	ErrBadURL = errors.New("not an absolute URL")
+	// ErrNoDomain is when a URL's host is an IP address, which has no registrable domain.
+	ErrNoDomain = errors.New("host is an IP address")
+)
+
+// urlParts are the parts of a URL which f@url@<part> can extract.
+var urlParts = map[string]func(*url.URL) (string, error){
+	"host": func(u *url.URL) (string, error) {
+		return strings.ToLower(u.Hostname()), nil
+	},
+	// domain is the registrable domain, such as example.co.uk for www.example.co.uk.
+	"domain": func(u *url.URL) (string, error) {
+		if net.ParseIP(u.Hostname()) != nil {
+			return "", ErrNoDomain
+		}
+		return publicsuffix.EffectiveTLDPlusOne(strings.ToLower(u.Hostname()))
+	},
+	"path": func(u *url.URL) (string, error) {
+		if u.Path == "" {
+			return "/", nil
+		}
+		return u.Path, nil
+	},
+	"fragment": func(u *url.URL) (string, error) {
+		return u.Fragment, nil
+	},
+}
+
+// parseURL parses an absolute URL such as a url or referrer property.
+func parseURL(value interface{}, name string) (*url.URL, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, genError(value, name)
+	}
+	u, err := url.Parse(strings.TrimSpace(str))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, ErrBadURL
+	}
+	return u, nil
+}
+
+// genURLFormat returns the transformer for a part of a URL, as in f@url@host, or nil if
+// there's no such part.
+func genURLFormat(part string) ColumnTransformer {
+	extract, ok := urlParts[part]
+	if !ok {
+		return nil
+	}
+	return func(args []interface{}) (string, error) {
+		u, err := parseURL(args[0], "URL "+part)
+		if err != nil {
+			return "", err
+		}
+		return extract(u)
+	}
+}
+
+// genURLParamFormat returns the transformer for a URL's query parameter, as in
+// f@urlParam@utm_source. A URL without the parameter leaves the column empty.
+func genURLParamFormat(param string) ColumnTransformer {
+	if param == "" {
+		return nil
+	}
+	return func(args []interface{}) (string, error) {
+		u, err := parseURL(args[0], "URL param")
+		if err != nil {
+			return "", err
+		}
+		return u.Query().Get(param), nil
+	}
+}
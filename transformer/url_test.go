@@ -0,0 +1,37 @@
+package transformer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twitchscience/spade/geoip"
+)
+
+func TestURLConversion(t *testing.T) {
+	url := "https://WWW.Example.co.uk:8443/a/b%20c?utm_source=newsletter&utm_medium=email&q=1#top"
+	for tType, expected := range map[string]string{
+		"f@url@host":              "www.example.co.uk",
+		"f@url@domain":            "example.co.uk",
+		"f@url@path":              "/a/b c",
+		"f@url@fragment":          "top",
+		"f@urlParam@utm_source":   "newsletter",
+		"f@urlParam@utm_campaign": "",
+	} {
+		column := RedshiftType{GetSingleValueTransform(tType, geoip.Noop()), "_", "_", nil}
+		_typeRunner(t, url, column, expected, false)
+		_typeRunner(t, "www.example.com/no/scheme", column, "", true)
+		_typeRunner(t, "https://bad host/", column, "", true)
+		_typeRunner(t, json.Number("1"), column, "", true)
+	}
+
+	path := RedshiftType{GetSingleValueTransform("f@url@path", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "https://www.twitch.tv", path, "/", false)
+
+	domain := RedshiftType{GetSingleValueTransform("f@url@domain", geoip.Noop()), "_", "_", nil}
+	_typeRunner(t, "http://127.0.0.1/", domain, "", true)
+
+	for _, tType := range []string{"f@url@port", "f@urlParam@"} {
+		assert.Nil(t, GetSingleValueTransform(tType, geoip.Noop()), tType)
+	}
+}
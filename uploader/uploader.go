@@ -402,3 +402,18 @@ func BuildUploaderForBlueprint(numWorkers int, sns snsiface.SNSAPI, s3Uploader s
 		harness:          harness,
 	})
 }
+
+// BuildUploaderForDeadLetters builds an Uploader that uploads dead lettered events to s3. Nobody is notified of
+// the uploads, and errors are only logged.
+func BuildUploaderForDeadLetters(numWorkers int, s3Uploader s3manageriface.UploaderAPI,
+	deadLetterBucketName string, replay bool) *uploader.UploaderPool {
+
+	return buildUploader(&buildUploaderInput{
+		bucketName:       deadLetterBucketName,
+		numWorkers:       numWorkers,
+		s3Uploader:       s3Uploader,
+		keyNameGenerator: &gen.EdgeKeyNameGenerator{Info: buildInstanceInfo(replay)},
+		nullNotifier:     true,
+		harness:          &NullNotifierHarness{},
+	})
+}
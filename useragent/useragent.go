@@ -0,0 +1,257 @@
+// Package useragent parses user agent strings into their browser, operating system and device,
+// using a database of regular expressions which is embedded but can be replaced and reloaded.
+package useragent
+
+import (
+	// Imported for the embedded default database.
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/twitchscience/aws_utils/logger"
+	"github.com/twitchscience/spade/cache/lru"
+	"github.com/vrischmann/jsonutil"
+)
+
+// Names used when nothing in the database matches.
+const (
+	Other         = "Other"
+	DeviceDesktop = "desktop"
+	DeviceBot     = "bot"
+)
+
+// defaultCacheSize is how many user agents are cached if the config doesn't say.
+const defaultCacheSize = 10000
+
+//go:embed regexes.json
+var embeddedDatabase []byte
+
+// Agent is what a user agent string says about the client which sent it.
+type Agent struct {
+	Browser        string
+	BrowserVersion string
+	OS             string
+	DeviceType     string
+	Bot            bool
+}
+
+// Rule names what a user agent is if it matches the regex. For browsers, the regex's first
+// group, if it has one, is the version.
+type Rule struct {
+	Regex string
+	Name  string
+}
+
+// Database holds the rules for each part of an Agent. The first matching rule is used, so
+// more specific rules must come first.
+type Database struct {
+	Bots             []Rule
+	Browsers         []Rule
+	OperatingSystems []Rule
+	Devices          []Rule
+}
+
+type compiledRule struct {
+	regex *regexp.Regexp
+	name  string
+}
+
+type compiledDatabase struct {
+	bots, browsers, operatingSystems, devices []compiledRule
+}
+
+// Config configures a Parser.
+type Config struct {
+	// DatabasePath, if set, is a JSON Database to use instead of the embedded one
+	DatabasePath string
+	// ReloadFrequency, if set, is how often the database is reloaded from DatabasePath
+	ReloadFrequency jsonutil.Duration
+	// CacheSize is how many distinct user agents' Agents are kept; 10000 if unset
+	CacheSize int
+}
+
+// Parser parses user agents, caching the Agent of each distinct string.
+type Parser struct {
+	config Config
+	closer chan bool
+
+	lock       sync.RWMutex
+	generation *generation
+}
+
+// generation is a database with the cache of Agents parsed with it. Reloading replaces both at
+// once, so an Agent parsed with the old database can't be cached for the new one.
+type generation struct {
+	db    *compiledDatabase
+	cache *lru.Cache
+}
+
+// New returns a Parser using the configured database.
+func New(config Config) (*Parser, error) {
+	if config.CacheSize <= 0 {
+		config.CacheSize = defaultCacheSize
+	}
+	p := &Parser{config: config, closer: make(chan bool)}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Reload reloads the database and empties the cache.
+func (p *Parser) Reload() error {
+	data := embeddedDatabase
+	if p.config.DatabasePath != "" {
+		var err error
+		if data, err = ioutil.ReadFile(p.config.DatabasePath); err != nil {
+			return fmt.Errorf("reading user agent database: %v", err)
+		}
+	}
+	db, err := compile(data)
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.generation = &generation{db: db, cache: lru.New(p.config.CacheSize, lru.LongDuration)}
+	return nil
+}
+
+// Crank reloads the database every ReloadFrequency until the Parser is closed. It returns
+// straight away if there's no DatabasePath or ReloadFrequency.
+func (p *Parser) Crank() {
+	if p.config.DatabasePath == "" || p.config.ReloadFrequency.Duration <= 0 {
+		return
+	}
+	tick := time.NewTicker(p.config.ReloadFrequency.Duration)
+	for {
+		select {
+		case <-tick.C:
+			if err := p.Reload(); err != nil {
+				logger.WithError(err).Error("Failed to reload user agent database")
+			}
+		case <-p.closer:
+			tick.Stop()
+			return
+		}
+	}
+}
+
+// Close stops Crank.
+func (p *Parser) Close() {
+	close(p.closer)
+}
+
+// Parse returns the Agent of a user agent string.
+func (p *Parser) Parse(ua string) Agent {
+	p.lock.RLock()
+	g := p.generation
+	p.lock.RUnlock()
+	if cached, err := g.cache.Get(ua); err == nil {
+		return decodeAgent(cached)
+	}
+
+	agent := g.db.parse(ua)
+	_ = g.cache.Set(ua, encodeAgent(agent))
+	return agent
+}
+
+// agentSeparator separates the fields of an Agent in the cache; it can't be in a user agent
+// header, so it can't be in a field.
+const agentSeparator = "\x00"
+
+func encodeAgent(a Agent) string {
+	return strings.Join([]string{a.Browser, a.BrowserVersion, a.OS, a.DeviceType, strconv.FormatBool(a.Bot)}, agentSeparator)
+}
+
+func decodeAgent(s string) Agent {
+	fields := strings.SplitN(s, agentSeparator, 5)
+	bot, _ := strconv.ParseBool(fields[4])
+	return Agent{Browser: fields[0], BrowserVersion: fields[1], OS: fields[2], DeviceType: fields[3], Bot: bot}
+}
+
+func compile(data []byte) (*compiledDatabase, error) {
+	var db Database
+	if err := json.Unmarshal(data, &db); err != nil {
+		return nil, fmt.Errorf("decoding user agent database: %v", err)
+	}
+	var compiled compiledDatabase
+	for _, rules := range []struct {
+		rules []Rule
+		into  *[]compiledRule
+	}{
+		{db.Bots, &compiled.bots},
+		{db.Browsers, &compiled.browsers},
+		{db.OperatingSystems, &compiled.operatingSystems},
+		{db.Devices, &compiled.devices},
+	} {
+		for _, rule := range rules.rules {
+			regex, err := regexp.Compile(rule.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("compiling user agent regex %q: %v", rule.Regex, err)
+			}
+			*rules.into = append(*rules.into, compiledRule{regex, rule.Name})
+		}
+	}
+	return &compiled, nil
+}
+
+func (db *compiledDatabase) parse(ua string) Agent {
+	agent := Agent{Browser: Other}
+	for _, rule := range db.browsers {
+		if match := rule.regex.FindStringSubmatch(ua); match != nil {
+			agent.Browser = rule.name
+			if len(match) > 1 {
+				agent.BrowserVersion = match[1]
+			}
+			break
+		}
+	}
+	agent.OS = firstMatch(db.operatingSystems, ua, Other)
+	agent.DeviceType = firstMatch(db.devices, ua, DeviceDesktop)
+	for _, rule := range db.bots {
+		if rule.regex.MatchString(ua) {
+			agent.Bot = true
+			agent.DeviceType = DeviceBot
+			break
+		}
+	}
+	return agent
+}
+
+func firstMatch(rules []compiledRule, ua, otherwise string) string {
+	for _, rule := range rules {
+		if rule.regex.MatchString(ua) {
+			return rule.name
+		}
+	}
+	return otherwise
+}
+
+var defaultParser atomic.Value
+
+func init() {
+	p, err := New(Config{})
+	if err != nil {
+		panic(err)
+	}
+	defaultParser.Store(p)
+}
+
+// SetDefault replaces the Parser used by Parse.
+func SetDefault(p *Parser) {
+	defaultParser.Store(p)
+}
+
+// Parse parses a user agent string with the default Parser, which uses the embedded database
+// unless SetDefault has been called.
+func Parse(ua string) Agent {
+	return defaultParser.Load().(*Parser).Parse(ua)
+}
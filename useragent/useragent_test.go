@@ -0,0 +1,83 @@
+package useragent
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for ua, expected := range map[string]Agent{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.109 Safari/537.36": {
+			Browser: "Chrome", BrowserVersion: "120.0.6099.109", OS: "Windows", DeviceType: "desktop"},
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91": {
+			Browser: "Edge", BrowserVersion: "120.0.2210.91", OS: "Windows", DeviceType: "desktop"},
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Safari/605.1.15": {
+			Browser: "Safari", BrowserVersion: "17.2", OS: "macOS", DeviceType: "desktop"},
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1": {
+			Browser: "Safari", BrowserVersion: "17.2", OS: "iOS", DeviceType: "mobile"},
+		"Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/119.0.6045.169 Mobile/15E148 Safari/604.1": {
+			Browser: "Chrome", BrowserVersion: "119.0.6045.169", OS: "iOS", DeviceType: "tablet"},
+		"Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.144 Mobile Safari/537.36": {
+			Browser: "Chrome", BrowserVersion: "120.0.6099.144", OS: "Android", DeviceType: "mobile"},
+		"Mozilla/5.0 (Linux; Android 13; SM-X200) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.144 Safari/537.36": {
+			Browser: "Chrome", BrowserVersion: "120.0.6099.144", OS: "Android", DeviceType: "tablet"},
+		"Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0": {
+			Browser: "Firefox", BrowserVersion: "121.0", OS: "Linux", DeviceType: "desktop"},
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; Xbox; Xbox One) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/70.0.3538.102 Safari/537.36 Edge/18.19041": {
+			Browser: "Edge", BrowserVersion: "18.19041", OS: "Xbox", DeviceType: "console"},
+		"Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; rv:11.0) like Gecko": {
+			Browser: "Internet Explorer", BrowserVersion: "11.0", OS: "Windows", DeviceType: "desktop"},
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)": {
+			Browser: "Other", OS: "Other", DeviceType: "bot", Bot: true},
+		"curl/8.4.0": {
+			Browser: "Other", OS: "Other", DeviceType: "bot", Bot: true},
+		"": {
+			Browser: "Other", OS: "Other", DeviceType: "desktop"},
+	} {
+		assert.Equal(t, expected, Parse(ua), ua)
+	}
+}
+
+func TestCache(t *testing.T) {
+	p, err := New(Config{CacheSize: 2})
+	require.NoError(t, err)
+	p.Parse("a")
+	p.Parse("b")
+	assert.Equal(t, 2, p.generation.cache.Len())
+	p.Parse("a")
+	p.Parse("c")
+	assert.Equal(t, 2, p.generation.cache.Len())
+	_, err = p.generation.cache.Get("b")
+	assert.Error(t, err, "the least recently used user agent should be evicted")
+
+	bot := "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+	assert.Equal(t, p.Parse(bot), p.Parse(bot), "cached agents should be the same as parsed ones")
+}
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "useragent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "regexes.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"Browsers": [{"Regex": "Spade/(\\d+)", "Name": "Spade"}]}`), 0644))
+
+	p, err := New(Config{DatabasePath: path})
+	require.NoError(t, err)
+	assert.Equal(t, Agent{Browser: "Spade", BrowserVersion: "2", OS: "Other", DeviceType: "desktop"}, p.Parse("Spade/2"))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"Browsers": [{"Regex": "Spade", "Name": "Shovel"}]}`), 0644))
+	require.NoError(t, p.Reload())
+	assert.Equal(t, "Shovel", p.Parse("Spade/2").Browser)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"Browsers": [{"Regex": "(", "Name": "Broken"}]}`), 0644))
+	assert.Error(t, p.Reload())
+	assert.Equal(t, "Shovel", p.Parse("Spade/2").Browser)
+
+	_, err = New(Config{DatabasePath: filepath.Join(dir, "missing.json")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package writer
+
+import (
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/twitchscience/aws_utils/logger"
+	"github.com/twitchscience/aws_utils/uploader"
+	"github.com/twitchscience/spade/reporter"
+)
+
+// DeadLetterDir is the local subdirectory where requests which failed processing are written.
+var DeadLetterDir = "deadletter"
+
+// DeadLetter is a line in a dead letter file, recording a request which failed processing.
+type DeadLetter struct {
+	// Failure is the reporter.FailMode, as a string
+	Failure string `json:"failure"`
+	// Error is why the request failed, if known
+	Error    string `json:"error,omitempty"`
+	Category string `json:"category"`
+	UUID     string `json:"uuid"`
+	// Raw is what the request was made from: a glob, an edge event (a spade.Event as JSON),
+	// or the event and properties of a decoded event
+	Raw []byte `json:"raw"`
+	// StartedAt is when processing of the request started
+	StartedAt time.Time `json:"startedAt"`
+	// FailedAt is when the request was dead lettered
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// deadLetterWriter writes failed requests as gzipped JSON lines, one DeadLetter per line.
+type deadLetterWriter struct {
+	manager *writerManager
+}
+
+// NewDeadLetterWriter returns a SpadeWriter which writes the requests given to it to files
+// in folder's DeadLetterDir, uploading them with the given pool once rotated.
+func NewDeadLetterWriter(
+	folder string,
+	reporter reporter.Reporter,
+	uploaderPool *uploader.UploaderPool,
+	maxLogBytes int64,
+	maxLogAgeSecs int64,
+) SpadeWriter {
+	manager := newWriterManager(
+		&gzipWriterFactory{
+			path.Join(folder, DeadLetterDir),
+			reporter,
+			uploaderPool,
+			RotateConditions{
+				MaxLogSize:     maxLogBytes,
+				MaxTimeAllowed: time.Duration(maxLogAgeSecs) * time.Second,
+			},
+		},
+		"deadletter",
+	)
+	logger.Go(manager.Listen)
+	return &deadLetterWriter{manager: manager}
+}
+
+// Write queues the request to be written as a DeadLetter.
+func (w *deadLetterWriter) Write(req *WriteRequest) {
+	line, err := json.Marshal(&DeadLetter{
+		Failure:   req.Failure.String(),
+		Error:     req.Error,
+		Category:  req.Category,
+		UUID:      req.UUID,
+		Raw:       req.Raw,
+		StartedAt: req.Pstart,
+		FailedAt:  time.Now(),
+	})
+	if err != nil {
+		logger.WithError(err).WithField("uuid", req.UUID).Error("Failed to marshal dead letter")
+		req.Token.Release()
+		return
+	}
+	// Other SpadeWriters may be reading req, so write a copy.
+	deadLetter := *req
+	deadLetter.Line = string(line)
+	w.manager.Write(&deadLetter)
+}
+
+func (w *deadLetterWriter) Close() error {
+	return w.manager.Close()
+}
+
+func (w *deadLetterWriter) Rotate() (bool, error) {
+	return w.manager.Rotate()
+}
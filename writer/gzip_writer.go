@@ -2,6 +2,7 @@ package writer
 
 import (
 	"compress/gzip"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"sync"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/aws_utils/uploader"
+	"github.com/twitchscience/spade/ack"
 	"github.com/twitchscience/spade/gzpool"
 	"github.com/twitchscience/spade/reporter"
 )
@@ -43,7 +45,8 @@ func newGzipWriter(
 		uploader:         uploader,
 		RotateConditions: rotateOn,
 
-		in: make(chan *WriteRequest),
+		in:     make(chan *WriteRequest),
+		failed: make(chan struct{}),
 	}
 	writer.Add(1)
 	logger.Go(writer.Listen)
@@ -60,10 +63,23 @@ type gzipFileWriter struct {
 	RotateConditions RotateConditions
 
 	in chan *WriteRequest
+	// tokens are released once the file is closed and queued for upload
+	tokens []*ack.Token
+	// failed is closed once a write has failed, after which err is set and no tokens are
+	// released
+	failed chan struct{}
+	err    error
 }
 
-// Rotate rotates the logs if necessary. This must be called at a regular interval.
+// Rotate rotates the logs if necessary. This must be called at a regular interval. It fails
+// once a write has failed, so that the writer is stopped.
 func (w *gzipFileWriter) Rotate() (bool, error) {
+	select {
+	case <-w.failed:
+		return false, w.err
+	default:
+	}
+
 	inode, err := w.File.Stat()
 	if err != nil {
 		return false, err
@@ -80,24 +96,43 @@ func (w *gzipFileWriter) Rotate() (bool, error) {
 	return false, nil
 }
 
-// Close closes the input channel, flushes all inputs, then flushes all state.
+// Close closes the input channel, flushes all inputs, then flushes all state. The tokens of
+// the requests written are only released if the file is safely on disk; otherwise the
+// records they came from will be consumed again after a restart. If a write failed, the
+// file is removed rather than uploaded, since none of its records were acked.
 func (w *gzipFileWriter) Close() error {
 	defer gzPool.Put(w.GzWriter)
 	close(w.in)
 	w.Wait()
 
+	if w.err != nil {
+		_ = w.File.Close()
+		if removeErr := os.Remove(w.File.Name()); removeErr != nil {
+			logger.WithError(removeErr).WithField("file", w.File.Name()).Error(
+				"Failed to remove file after failed write")
+		}
+		return w.err
+	}
+
 	if gzCloseErr := w.GzWriter.Close(); gzCloseErr != nil {
 		return gzCloseErr
 	}
 
+	if syncErr := w.File.Sync(); syncErr != nil {
+		return syncErr
+	}
+
 	if closeErr := w.File.Close(); closeErr != nil {
 		return closeErr
 	}
 
+	// Files left on disk are uploaded at startup, so the events are durable from here.
 	w.uploader.Upload(&uploader.UploadRequest{
 		Filename: w.File.Name(),
 		FileType: uploader.Gzip,
 	})
+	ack.ReleaseAll(w.tokens)
+	w.tokens = nil
 	return nil
 }
 
@@ -107,6 +142,8 @@ func (w *gzipFileWriter) Write(req *WriteRequest) {
 }
 
 // Listen is a blocking method that processes input and reports the result of writing it.
+// After a failed write, the gzip stream is unusable, so later requests aren't written and
+// none of the writer's tokens are released.
 func (w *gzipFileWriter) Listen() {
 	defer w.Done()
 	for {
@@ -114,19 +151,37 @@ func (w *gzipFileWriter) Listen() {
 		if !ok {
 			return
 		}
+		if w.err != nil {
+			w.recordFailure(req)
+			continue
+		}
 		_, err := w.GzWriter.Write([]byte(req.Line + "\n"))
 		if err != nil {
 			logger.WithError(err).Error("Failed to write to gzip")
-			w.Reporter.Record(&reporter.Result{
-				Failure:    reporter.FailedWrite,
-				UUID:       req.UUID,
-				Line:       req.Line,
-				Category:   req.Category,
-				FinishedAt: time.Now(),
-				Duration:   time.Since(req.Pstart),
-			})
-		} else {
+			w.recordFailure(req)
+			w.err = fmt.Errorf("writing %s: %v", w.File.Name(), err)
+			close(w.failed)
+			continue
+		}
+		if !req.IsSample() {
 			w.Reporter.Record(req.GetResult())
 		}
+		if req.Token != nil {
+			w.tokens = append(w.tokens, req.Token)
+		}
 	}
 }
+
+func (w *gzipFileWriter) recordFailure(req *WriteRequest) {
+	if req.IsSample() {
+		return
+	}
+	w.Reporter.Record(&reporter.Result{
+		Failure:    reporter.FailedWrite,
+		UUID:       req.UUID,
+		Line:       req.Line,
+		Category:   req.Category,
+		FinishedAt: time.Now(),
+		Duration:   time.Since(req.Pstart),
+	})
+}
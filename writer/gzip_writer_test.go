@@ -0,0 +1,96 @@
+package writer
+
+import (
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twitchscience/spade/ack"
+	"github.com/twitchscience/spade/reporter"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+type resultsReporter struct {
+	results []*reporter.Result
+}
+
+func (r *resultsReporter) Record(result *reporter.Result) {
+	r.results = append(r.results, result)
+}
+
+func (r *resultsReporter) Report() map[string]int {
+	return nil
+}
+
+func TestGzipWriterFailedWriteHoldsTokens(t *testing.T) {
+	file, err := ioutil.TempFile("", "gzip_writer_test.")
+	require.NoError(t, err)
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	results := &resultsReporter{}
+	w := &gzipFileWriter{
+		File:             file,
+		GzWriter:         gzip.NewWriter(failingWriter{}),
+		Reporter:         results,
+		RotateConditions: RotateConditions{MaxLogSize: 1 << 20, MaxTimeAllowed: time.Hour},
+		in:               make(chan *WriteRequest),
+		failed:           make(chan struct{}),
+	}
+	w.Add(1)
+	go w.Listen()
+
+	released := 0
+	for i := 0; i < 2; i++ {
+		w.Write(&WriteRequest{
+			Category: "test",
+			Line:     "line",
+			Token:    ack.New(func() { released++ }),
+			Pstart:   time.Now(),
+		})
+	}
+
+	_, err = w.Rotate()
+	assert.Error(t, err, "rotating a failed writer should fail")
+	assert.Error(t, w.Close())
+	assert.Equal(t, 0, released, "tokens of a failed writer should not be released")
+	require.Len(t, results.results, 2)
+	for _, result := range results.results {
+		assert.Equal(t, reporter.FailedWrite, result.Failure)
+	}
+	_, err = os.Stat(file.Name())
+	assert.True(t, os.IsNotExist(err), "a failed writer's file should be removed")
+}
+
+func TestGzipWriterDoesNotReportSamples(t *testing.T) {
+	for _, gz := range []*gzip.Writer{gzip.NewWriter(ioutil.Discard), gzip.NewWriter(failingWriter{})} {
+		file, err := ioutil.TempFile("", "gzip_writer_test.")
+		require.NoError(t, err)
+		defer func() { _ = os.Remove(file.Name()) }()
+		results := &resultsReporter{}
+		w := &gzipFileWriter{
+			File:     file,
+			GzWriter: gz,
+			Reporter: results,
+			in:       make(chan *WriteRequest),
+			failed:   make(chan struct{}),
+		}
+		w.Add(1)
+		go w.Listen()
+		w.Write(&WriteRequest{Category: "test", Line: "line", Pstart: time.Now()})
+		w.Write(&WriteRequest{Category: "test", Line: "sample", Failure: reporter.UnmappedPropertiesSample, Pstart: time.Now()})
+		close(w.in)
+		w.Wait()
+		require.Len(t, results.results, 1, "only the event should be reported, not its sample")
+		assert.NotEqual(t, reporter.UnmappedPropertiesSample, results.results[0].Failure)
+	}
+}
@@ -22,8 +22,11 @@ import (
 	"github.com/myesui/uuid"
 	"github.com/twitchscience/aws_utils/logger"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
+	"github.com/twitchscience/spade/ack"
 	"github.com/twitchscience/spade/batcher"
 	"github.com/twitchscience/spade/globber"
+	"github.com/twitchscience/spade/pii"
+	"github.com/twitchscience/spade/reporter"
 )
 
 const (
@@ -56,9 +59,10 @@ func (w *Statter) IncStat(stat int, amount int64) {
 	}
 }
 
-// EventForwarder receives events and forwards them to Kinesis or another EventForwarder.
+// EventForwarder receives events and forwards them to Kinesis or another EventForwarder,
+// taking over the caller's holds on the given tokens.
 type EventForwarder interface {
-	Submit([]byte)
+	Submit([]byte, ...*ack.Token)
 	Close()
 }
 
@@ -118,16 +122,25 @@ type FirehoseBatchWriter struct {
 	limiter *taskRateLimiter
 }
 
-// KinesisWriter is a writer that writes events to kinesis
+// batch is a batch of records to send, with the tokens of the events in it.
+type batch struct {
+	records [][]byte
+	tokens  []*ack.Token
+}
+
+// KinesisWriter is a writer that writes events to kinesis. Tokens are released once the
+// events have been sent, or dropped after the configured number of attempts.
 type KinesisWriter struct {
 	incoming      chan *WriteRequest
-	batches       chan [][]byte
+	batches       chan batch
 	globber       EventForwarder
 	batcher       EventForwarder
 	config        scoop_protocol.KinesisWriterConfig
 	defaultFilter scoop_protocol.EventFilterFunc
 	batchWriter   BatchWriter
 	limiter       *taskRateLimiter
+	// fieldTransforms are the pii transforms for each event's fields.
+	fieldTransforms map[string]map[string]pii.Func
 
 	sync.WaitGroup
 }
@@ -224,6 +237,17 @@ func NewKinesisWriter(
 	if err := sConfig.Validate(config.CommonFilters); err != nil {
 		return nil, err
 	}
+	fieldTransforms := make(map[string]map[string]pii.Func)
+	for name, event := range sConfig.Events {
+		if len(event.FieldTransforms) == 0 {
+			continue
+		}
+		transforms, err := pii.GetAll(event.FieldTransforms)
+		if err != nil {
+			return nil, fmt.Errorf("building field transforms for %s: %v", name, err)
+		}
+		fieldTransforms[name] = transforms
+	}
 	var batchWriter BatchWriter
 	wStatter := NewStatter(statter, sConfig.StreamName)
 	limiter := newTaskRateLimiter(errorsBeforeThrottling, secondsPerError)
@@ -237,22 +261,24 @@ func NewKinesisWriter(
 	}
 	w := &KinesisWriter{
 		incoming:      make(chan *WriteRequest, sConfig.BufferSize),
-		batches:       make(chan [][]byte),
+		batches:       make(chan batch),
 		config:        sConfig,
 		batchWriter:   batchWriter,
 		defaultFilter: config.DefaultFilter,
+
+		fieldTransforms: fieldTransforms,
 	}
 
 	var err error
-	w.batcher, err = batcher.New(sConfig.Batcher, func(b [][]byte) {
-		w.batches <- b
+	w.batcher, err = batcher.New(sConfig.Batcher, func(b [][]byte, tokens []*ack.Token) {
+		w.batches <- batch{records: b, tokens: tokens}
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	w.globber, err = globber.New(sConfig.Globber, func(b []byte) {
-		w.batcher.Submit(b)
+	w.globber, err = globber.New(sConfig.Globber, func(b []byte, tokens []*ack.Token) {
+		w.batcher.Submit(b, tokens...)
 	})
 	if err != nil {
 		return nil, err
@@ -274,30 +300,36 @@ func (w *KinesisWriter) Write(req *WriteRequest) {
 	w.incoming <- req
 }
 
-func (w *KinesisWriter) submit(name string, columns map[string]string) {
+// submit forwards the event to the globber or batcher, releasing the token if it is
+// filtered out.
+func (w *KinesisWriter) submit(name string, columns map[string]string, token *ack.Token) {
 	event, ok := w.config.Events[name]
 	if !ok {
+		token.Release()
 		return
 	}
 	if event.FilterFunc != nil && !event.FilterFunc(columns) {
+		token.Release()
 		return
 	}
 	if !event.SkipDefaultFilter && !w.defaultFilter(columns) {
+		token.Release()
 		return
 	}
 
+	transforms := w.fieldTransforms[name]
 	pruned := make(map[string]string)
 	if w.config.EventNameTargetField != "" {
 		pruned[w.config.EventNameTargetField] = name
 	}
 	if event.AllFields {
 		for name, value := range columns {
-			pruned[name] = value
+			pruned[name] = protect(transforms, name, value)
 		}
 	} else {
 		for field, outField := range event.FullFieldMap {
 			if val, ok := columns[field]; ok && val != "" {
-				pruned[outField] = val
+				pruned[outField] = protect(transforms, field, val)
 			} else if !w.config.ExcludeEmptyFields {
 				pruned[outField] = ""
 			}
@@ -317,9 +349,10 @@ func (w *KinesisWriter) submit(name string, columns map[string]string) {
 					logger.WithError(err).WithField("name", name).Error(
 						"Failed to marshal Kinesis entry for globber")
 				})
+				token.Release()
 				return
 			}
-			w.globber.Submit(b)
+			w.globber.Submit(b, token)
 		} else {
 			e, err := json.Marshal(pruned)
 			if err != nil {
@@ -327,11 +360,28 @@ func (w *KinesisWriter) submit(name string, columns map[string]string) {
 					logger.WithError(err).WithField("name", name).Error(
 						"Failed to marshal Kinesis entry for batcher")
 				})
+				token.Release()
 				return
 			}
-			w.batcher.Submit(e)
+			w.batcher.Submit(e, token)
 		}
+	} else {
+		token.Release()
+	}
+}
+
+// protect applies the field's pii transform, if it has one, to its value. If the transform
+// fails, the value is dropped rather than written as it is.
+func protect(transforms map[string]pii.Func, field, value string) string {
+	transform, ok := transforms[field]
+	if !ok || value == "" {
+		return value
 	}
+	protected, err := transform(value)
+	if err != nil {
+		return ""
+	}
+	return protected
 }
 
 func (w *KinesisWriter) incomingWorker() {
@@ -353,7 +403,12 @@ func (w *KinesisWriter) incomingWorker() {
 		if !ok {
 			return
 		}
-		w.submit(req.Category, req.Record)
+		// Failed events, and samples for Blueprint, have no columns to send.
+		if req.Failure != reporter.None && req.Failure != reporter.SkippedColumn {
+			req.Token.Release()
+			continue
+		}
+		w.submit(req.Category, req.Record, req.Token)
 	}
 }
 
@@ -361,14 +416,15 @@ func (w *KinesisWriter) sendWorker() {
 	defer w.Done()
 
 	for {
-		batch, ok := <-w.batches
+		b, ok := <-w.batches
 		if !ok {
 			return
 		}
 		w.Add(1)
 		logger.Go(func() {
 			defer w.Done()
-			w.batchWriter.SendBatch(batch)
+			w.batchWriter.SendBatch(b.records)
+			ack.ReleaseAll(b.tokens)
 		})
 	}
 }
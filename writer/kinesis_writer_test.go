@@ -14,6 +14,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/twitchscience/scoop_protocol/scoop_protocol"
+	"github.com/twitchscience/spade/ack"
+	"github.com/twitchscience/spade/pii"
 )
 
 var FirehoseRedshiftStreamTestConfig = []byte(`
@@ -144,8 +146,9 @@ type forwarderMock struct {
 	received [][]byte
 }
 
-func (f *forwarderMock) Submit(e []byte) {
+func (f *forwarderMock) Submit(e []byte, tokens ...*ack.Token) {
 	f.received = append(f.received, e)
+	ack.ReleaseAll(tokens)
 }
 
 func (f *forwarderMock) Close() {}
@@ -164,7 +167,7 @@ func TestSubmitCompressed(t *testing.T) {
 		config:        config,
 		defaultFilter: scoop_protocol.NoopFilter,
 	}
-	k.submit("minute-watched", map[string]string{"country": "US", "something": "xx"})
+	k.submit("minute-watched", map[string]string{"country": "US", "something": "xx"}, nil)
 	assert.Len(t, batcher.received, 0)
 	require.Len(t, globber.received, 1)
 	assert.Equal(t, `{"Name":"minute-watched","Fields":{"country":"US","device_id":""}}`,
@@ -183,7 +186,7 @@ func TestSubmitUncompressed(t *testing.T) {
 		config:        config,
 		defaultFilter: scoop_protocol.NoopFilter,
 	}
-	k.submit("minute-watched", map[string]string{"country": "US", "something": "xx"})
+	k.submit("minute-watched", map[string]string{"country": "US", "something": "xx"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 1)
 	assert.Equal(t, `{"country":"US","device_id":""}`, string(batcher.received[0]))
@@ -202,7 +205,7 @@ func TestSubmitUncompressedEventName(t *testing.T) {
 		config:        config,
 		defaultFilter: scoop_protocol.NoopFilter,
 	}
-	k.submit("minute-watched", map[string]string{"country": "US", "something": "xx"})
+	k.submit("minute-watched", map[string]string{"country": "US", "something": "xx"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 1)
 	assert.Equal(t, `{"country":"US","device_id":"","event":"minute-watched"}`,
@@ -222,7 +225,7 @@ func TestSubmitUncompressedExcludeEmpty(t *testing.T) {
 		config:        config,
 		defaultFilter: scoop_protocol.NoopFilter,
 	}
-	k.submit("video-play", map[string]string{"country": "US", "device_id": "", "something": "xx"})
+	k.submit("video-play", map[string]string{"country": "US", "device_id": "", "something": "xx"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 1)
 	assert.Equal(t, `{"country":"US"}`, string(batcher.received[0]))
@@ -240,7 +243,7 @@ func TestSubmitRename(t *testing.T) {
 		config:        config,
 		defaultFilter: scoop_protocol.NoopFilter,
 	}
-	k.submit("remapped", map[string]string{"unremapped": "US", "remap": "xx"})
+	k.submit("remapped", map[string]string{"unremapped": "US", "remap": "xx"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 1)
 	assert.Equal(t, `{"remapped_name":"xx","unremapped":"US"}`, string(batcher.received[0]))
@@ -258,8 +261,8 @@ func TestSubmitAllFields(t *testing.T) {
 		config:        config,
 		defaultFilter: scoop_protocol.NoopFilter,
 	}
-	k.submit("all-fields", map[string]string{"somefield": "US"})
-	k.submit("all-fields", map[string]string{"someotherfield": "1"})
+	k.submit("all-fields", map[string]string{"somefield": "US"}, nil)
+	k.submit("all-fields", map[string]string{"someotherfield": "1"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 2)
 	assert.Equal(t, `{"somefield":"US"}`, string(batcher.received[0]))
@@ -288,8 +291,8 @@ func TestSubmitFiltered(t *testing.T) {
 		config:        config,
 		defaultFilter: scoop_protocol.NoopFilter,
 	}
-	k.submit("video-play", map[string]string{"country": "CA", "game": "OK"})
-	k.submit("video-play", map[string]string{"country": "US", "game": "OK"})
+	k.submit("video-play", map[string]string{"country": "CA", "game": "OK"}, nil)
+	k.submit("video-play", map[string]string{"country": "US", "game": "OK"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 1)
 	assert.Equal(t, `{"country":"US","device_id":"","game":"OK"}`, string(batcher.received[0]))
@@ -316,8 +319,8 @@ func TestSubmitDefaultFiltered(t *testing.T) {
 		config:        config,
 		defaultFilter: filter,
 	}
-	k.submit("video-play", map[string]string{"country": "CA", "game": "OK"})
-	k.submit("video-play", map[string]string{"country": "US", "game": "OK"})
+	k.submit("video-play", map[string]string{"country": "CA", "game": "OK"}, nil)
+	k.submit("video-play", map[string]string{"country": "US", "game": "OK"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 1)
 	assert.Equal(t, `{"country":"US","device_id":"","game":"OK"}`, string(batcher.received[0]))
@@ -345,7 +348,7 @@ func TestSubmitSkipDefaultFilter(t *testing.T) {
 		config:        config,
 		defaultFilter: filter,
 	}
-	k.submit("video-play", map[string]string{"country": "CA", "game": "OK"})
+	k.submit("video-play", map[string]string{"country": "CA", "game": "OK"}, nil)
 	assert.Len(t, globber.received, 0)
 	require.Len(t, batcher.received, 1)
 	assert.Equal(t, `{"country":"CA","device_id":"","game":"OK"}`, string(batcher.received[0]))
@@ -496,3 +499,29 @@ func TestRedshiftStreamStatting(t *testing.T) {
 	assert.Equal(t, "kinesiswriter.stream.records_failed.unknown_reason 1 ", stats[3].String())
 	assert.Equal(t, "kinesiswriter.stream.records_dropped 2 ", stats[4].String())
 }
+
+func TestSubmitFieldTransforms(t *testing.T) {
+	config := scoop_protocol.KinesisWriterConfig{}
+	_ = json.Unmarshal(FirehoseRedshiftStreamTestConfig, &config)
+	require.NoError(t, config.Validate(nil))
+	globber := forwarderMock{}
+	batcher := forwarderMock{}
+	k := KinesisWriter{
+		globber:       &globber,
+		batcher:       &batcher,
+		config:        config,
+		defaultFilter: scoop_protocol.NoopFilter,
+		fieldTransforms: map[string]map[string]pii.Func{
+			"remapped":   {"remap": pii.AnonymizeIP, "unremapped": pii.Get("mask")},
+			"all-fields": {"ip": pii.AnonymizeIP},
+		},
+	}
+	k.submit("remapped", map[string]string{"unremapped": "US", "remap": "10.1.2.3"}, nil)
+	k.submit("remapped", map[string]string{"unremapped": "", "remap": "not an ip"}, nil)
+	k.submit("all-fields", map[string]string{"ip": "10.1.2.3", "country": "US"}, nil)
+	assert.Len(t, globber.received, 0)
+	require.Len(t, batcher.received, 3)
+	assert.Equal(t, `{"remapped_name":"10.1.2.0","unremapped":"**"}`, string(batcher.received[0]))
+	assert.Equal(t, `{"remapped_name":"","unremapped":""}`, string(batcher.received[1]))
+	assert.Equal(t, `{"country":"US","ip":"10.1.2.0"}`, string(batcher.received[2]))
+}
@@ -85,14 +85,17 @@ func (t *Multee) Replace(key string, newWriter SpadeWriter) {
 	logger.WithField("key", key).Info("Done replacing writer")
 }
 
-// Write forwards a writerequest to multiple targets
+// Write forwards a writerequest to multiple targets, each with its own hold on the request's
+// Token
 func (t *Multee) Write(r *WriteRequest) {
 	t.RLock()
 	defer t.RUnlock()
 
 	for _, writer := range t.targets {
+		r.Token.Hold()
 		writer.Write(r)
 	}
+	r.Token.Release()
 }
 
 // Rotate forwards a rotation request to multiple targets
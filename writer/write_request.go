@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/twitchscience/spade/ack"
 	"github.com/twitchscience/spade/reporter"
 )
 
@@ -21,6 +22,12 @@ type WriteRequest struct {
 	Source  json.RawMessage
 	Failure reporter.FailMode
 	Pstart  time.Time
+	// Error says why the request failed, if it did
+	Error string
+	// Raw is what the request was made from, kept for requests which failed
+	Raw []byte
+	// Token is released by the SpadeWriter once the request has been durably written
+	Token *ack.Token
 }
 
 // GetStartTime returns when procesing of the event started.
@@ -38,6 +45,12 @@ func (r *WriteRequest) GetMessage() string {
 	return string(r.Source)
 }
 
+// IsSample returns whether the request is a sample of an event which is also written to its
+// table, so that the reporter counts the event once.
+func (r *WriteRequest) IsSample() bool {
+	return r.Failure == reporter.UnmappedPropertiesSample
+}
+
 // GetResult returns timing and metadata of the event.
 func (r *WriteRequest) GetResult() *reporter.Result {
 	return &reporter.Result{
@@ -26,6 +26,8 @@ var (
 
 // SpadeWriter is an interface for writing to external sinks, like S3 or Kinesis.
 type SpadeWriter interface {
+	// Write takes over the caller's hold on the request's Token, releasing it once the
+	// request has been durably written (or deliberately dropped).
 	Write(*WriteRequest)
 	Close() error
 
@@ -50,6 +52,8 @@ type writerController struct {
 	blueprintUploader *uploader.UploaderPool
 	// The writer for the untracked events.
 	NonTrackedWriter SpadeWriter
+	// The writer for events which failed processing; if nil they are only reported.
+	DeadLetterWriter SpadeWriter
 
 	// WriteRequests are sent to this channel if they need a new writerManager.
 	newWriterChan chan *WriteRequest
@@ -131,6 +135,8 @@ func (w *writerManager) Listen() {
 				if err != nil {
 					logger.WithError(err).WithField("writerType", w.writerType).Error(
 						"Error creating writer")
+					// The token isn't released, so the record is consumed again after
+					// a restart.
 					continue
 				}
 			}
@@ -178,12 +184,15 @@ func (g *gzipWriterFactory) newWriter(writerType string) (SpadeWriter, error) {
 // writes across a number of workers.
 // Each worker owns and operates one file. There are several sets of workers.
 // Each set corresponds to a event type. Thus if we are processing a log
-// file with 2 types of events we should produce (nWriters * 2) files
+// file with 2 types of events we should produce (nWriters * 2) files.
+// Events which failed processing are written to deadLetterWriter, if it isn't nil, and
+// nontracked events are only kept if blueprintUploaderPool isn't nil.
 func NewWriterController(
 	folder string,
 	reporter reporter.Reporter,
 	spadeUploaderPool *uploader.UploaderPool,
 	blueprintUploaderPool *uploader.UploaderPool,
+	deadLetterWriter SpadeWriter,
 	maxLogBytes int64,
 	maxLogAgeSecs int64,
 	nontrackedMaxLogAgeSecs int64,
@@ -194,6 +203,7 @@ func NewWriterController(
 		Reporter:          reporter,
 		redshiftUploader:  spadeUploaderPool,
 		blueprintUploader: blueprintUploaderPool,
+		DeadLetterWriter:  deadLetterWriter,
 
 		newWriterChan: make(chan *WriteRequest, 200),
 		closeChan:     make(chan error),
@@ -203,7 +213,9 @@ func NewWriterController(
 		maxLogAgeSecs:           maxLogAgeSecs,
 		nontrackedMaxLogAgeSecs: nontrackedMaxLogAgeSecs,
 	}
-	c.initNonTrackedWriter()
+	if blueprintUploaderPool != nil {
+		c.initNonTrackedWriter()
+	}
 	c.writerFactory = &gzipWriterFactory{
 		path.Join(folder, EventsDir),
 		reporter,
@@ -256,13 +268,27 @@ func (c *writerController) Write(req *WriteRequest) {
 			c.newWriterChan <- req
 		}
 
-	// Log non tracking events for blueprint
-	case reporter.NonTrackingEvent:
-		c.NonTrackedWriter.Write(req)
+	// Log non tracking events, and samples of unmapped properties, for blueprint. Samples
+	// aren't reported, since their events are reported when written to their tables.
+	case reporter.NonTrackingEvent, reporter.UnmappedPropertiesSample:
+		if c.NonTrackedWriter != nil {
+			c.NonTrackedWriter.Write(req)
+			return
+		}
+		if !req.IsSample() {
+			c.Reporter.Record(req.GetResult())
+		}
+		req.Token.Release()
 
-	// Otherwise tell the reporter that we got the event but it failed somewhere.
+	// Otherwise keep the event as a dead letter, or just tell the reporter that we got
+	// the event but it failed somewhere.
 	default:
+		if c.DeadLetterWriter != nil {
+			c.DeadLetterWriter.Write(req)
+			return
+		}
 		c.Reporter.Record(req.GetResult())
+		req.Token.Release()
 	}
 }
 
@@ -305,7 +331,15 @@ func (c *writerController) close() error {
 		}
 	}
 
-	return c.NonTrackedWriter.Close()
+	if c.DeadLetterWriter != nil {
+		if err := c.DeadLetterWriter.Close(); err != nil {
+			return err
+		}
+	}
+	if c.NonTrackedWriter != nil {
+		return c.NonTrackedWriter.Close()
+	}
+	return nil
 }
 
 func (c *writerController) Rotate() (bool, error) {
@@ -330,12 +364,22 @@ func (c *writerController) rotate() rotateResult {
 		}
 	}
 
-	ntRotated, err := c.NonTrackedWriter.Rotate()
-	if err != nil {
-		return rotateResult{false, err}
+	if c.NonTrackedWriter != nil {
+		ntRotated, err := c.NonTrackedWriter.Rotate()
+		if err != nil {
+			return rotateResult{false, err}
+		}
+		allRotated = allRotated && ntRotated
+	}
+	if c.DeadLetterWriter != nil {
+		dlRotated, err := c.DeadLetterWriter.Rotate()
+		if err != nil {
+			return rotateResult{false, err}
+		}
+		allRotated = allRotated && dlRotated
 	}
 
-	return rotateResult{ntRotated && allRotated, err}
+	return rotateResult{allRotated, nil}
 }
 
 // MakeErrorRequest returns a WriteRequest indicating panic happened during processing.
@@ -347,5 +391,7 @@ func MakeErrorRequest(e *parser.MixpanelEvent, err interface{}) *WriteRequest {
 		Source:   e.Properties,
 		Failure:  reporter.PanickedInProcessing,
 		Pstart:   e.Pstart,
+		Error:    fmt.Sprint(err),
+		Raw:      e.Original(),
 	}
 }